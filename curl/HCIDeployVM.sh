@@ -1 +1 @@
-curl -X POST 'http://ind-south.api.qa-greenlake.hpe.com/virtualization/v1beta1/virtual-machines' --header 'Content-Type: application/json' --header 'Authorization: bearer test' --data '{"destination":{"clusterId":"/DC0/host/DC0_C0","folderId":"folder-uuid-456","hostId":"/DC0/host/DC0_C0/DC0_C0_H1","resourcePoolId":"/DC0/host/DC0_H0/Resources"},"imageSource":{"imageId":"ubuntu","imageName":"http://global.api.greenlake.hpe.com/api/images/ubuntu-22.04.ova","imageSourceType":"HYPERVISOR_IMAGE_LIBRARY"},"networkConfig":{"ipAllocationPolicy":"DHCP_POLICY","networkMapping":[{"name":"VM Network","network":"network-uuid-313"}]},"storageConfig":{"defaultDatastoreId":"LocalDS_0","provisioningType":"THIN"},"vmConfig":{"acceptEula":true,"annotation":"This is a sample VM deployed via API.","locale":"en-US","name":"alphaVM","numberOfVms":1,"powerOn":true,"propertyConfig":[{"key":"guestinfo.hostname","value":"my-vm"}]},"vmPolicy":[{"id":"policy-uuid-515","type":"VM_PROVISIONING_POLICY"}]}'
\ No newline at end of file
+curl -X POST 'http://ind-south.api.qa-greenlake.hpe.com/virtualization/v1beta1/virtual-machines' --header 'Content-Type: application/json' --header 'Authorization: bearer test' --header 'Idempotency-Key: deploy-alphaVM-001' --data '{"destination":{"clusterId":"/DC0/host/DC0_C0","folderId":"folder-uuid-456","hostId":"/DC0/host/DC0_C0/DC0_C0_H1","resourcePoolId":"/DC0/host/DC0_H0/Resources"},"imageSource":{"imageId":"ubuntu","imageName":"http://global.api.greenlake.hpe.com/api/images/ubuntu-22.04.ova","imageSourceType":"HYPERVISOR_IMAGE_LIBRARY"},"networkConfig":{"ipAllocationPolicy":"DHCP_POLICY","networkMapping":[{"name":"VM Network","network":"network-uuid-313"}]},"storageConfig":{"defaultDatastoreId":"LocalDS_0","provisioningType":"THIN"},"vmConfig":{"acceptEula":true,"annotation":"This is a sample VM deployed via API.","locale":"en-US","name":"alphaVM","numberOfVms":1,"powerOn":true,"propertyConfig":[{"key":"guestinfo.hostname","value":"my-vm"}]},"vmPolicy":[{"id":"policy-uuid-515","type":"VM_PROVISIONING_POLICY"}]}'
\ No newline at end of file
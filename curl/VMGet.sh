@@ -0,0 +1,4 @@
+curl -i -X GET \
+   http://ind-south.api.qa-greenlake.hpe.com/virtualization/v1beta1/virtual-machines/{vmId} \
+  -H "Accept: application/json" \
+  -H "Authorization: Bearer YOUR_TOKEN"
\ No newline at end of file
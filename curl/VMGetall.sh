@@ -1,4 +1,4 @@
 curl -i -X GET \
-   http://ind-south.api.qa-greenlake.hpe.com/virtualization/v1beta1/virtual-machines-request \
+   "http://ind-south.api.qa-greenlake.hpe.com/virtualization/v1beta1/virtual-machines-request?limit=50&offset=0&status=Failure&sort=desc" \
   -H "Accept: application/json" \
   -H "Authorization: Bearer YOUR_TOKEN"
\ No newline at end of file
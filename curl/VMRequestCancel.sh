@@ -0,0 +1,4 @@
+curl -i -X POST \
+   http://ind-south.api.qa-greenlake.hpe.com/virtualization/v1beta1/virtual-machines-request/{requestId}/cancel \
+  -H "Accept: application/json" \
+  -H "Authorization: Bearer YOUR_TOKEN"
\ No newline at end of file
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"time"
+
+	"vm/pkg/db"
+	"vm/pkg/dependency"
+	"vm/pkg/health"
+)
+
+// newHealthChecker builds the /readyz checks with the criticality the config gives them.
+func newHealthChecker(deps *dependency.Dependency) (*health.Checker, error) {
+	cfg := deps.Config.App
+	checks := []struct {
+		configured string
+		fallback   health.Criticality
+		check      func(health.Criticality) health.Check
+	}{
+		{cfg.Health.Database, health.Critical, func(c health.Criticality) health.Check {
+			return health.DatabaseCheck(deps.Database, c)
+		}},
+		{cfg.Health.Migrations, health.Critical, func(c health.Criticality) health.Check {
+			return health.MigrationsCheck(db.NewMigrateAllTables(deps.Database, deps.Logger), c)
+		}},
+		{cfg.Health.ImageManager, health.Off, func(c health.Criticality) health.Check {
+			return health.ServiceCheck("image-manager", cfg.Application.ImageManagerServiceName, c)
+		}},
+		{cfg.Health.InfraMonitor, health.Off, func(c health.Criticality) health.Check {
+			return health.ServiceCheck("infra-monitor", cfg.Application.InfraMonitorServiceName, c)
+		}},
+		{cfg.Health.VmMonitor, health.Off, func(c health.Criticality) health.Check {
+			return health.ServiceCheck("vm-monitor", cfg.Application.VmMonitorServiceName, c)
+		}},
+	}
+
+	var enabled []health.Check
+	for _, c := range checks {
+		criticality, err := health.ParseCriticality(c.configured, c.fallback)
+		if err != nil {
+			return nil, err
+		}
+		enabled = append(enabled, c.check(criticality))
+	}
+	return health.NewChecker(enabled, time.Duration(cfg.Health.Timeout)*time.Second, deps.Logger), nil
+}
@@ -5,32 +5,48 @@ import (
 	imagemanager "vm/internal/client/image_manager"
 	inframonitor "vm/internal/client/infra_monitor"
 	vmmonitor "vm/internal/client/vm_monitor"
-	"vm/pkg/constants"
 )
 
 // ImageManagerSecuritySource implements security for the image-manager client.
-type ImageManagerSecuritySource struct{}
+type ImageManagerSecuritySource struct {
+	// Tokens provides the token; without it the caller's token is forwarded.
+	Tokens TokenProvider
+}
 
 // Bearer returns a bearer token for image-manager.
 func (s *ImageManagerSecuritySource) Bearer(ctx context.Context, operationName imagemanager.OperationName) (imagemanager.Bearer, error) {
-	token, _ := ctx.Value(constants.BearerTokenKey).(string)
-	return imagemanager.Bearer{Token: token}, nil
+	token, err := tokens(s.Tokens).Token(ctx)
+	return imagemanager.Bearer{Token: token}, err
 }
 
 // InfraMonitorSecuritySource implements security for the infra-monitor client.
-type InfraMonitorSecuritySource struct{}
+type InfraMonitorSecuritySource struct {
+	// Tokens provides the token; without it the caller's token is forwarded.
+	Tokens TokenProvider
+}
 
 // Bearer returns a bearer token for infra-monitor.
 func (s *InfraMonitorSecuritySource) Bearer(ctx context.Context, operationName inframonitor.OperationName) (inframonitor.Bearer, error) {
-	token, _ := ctx.Value(constants.BearerTokenKey).(string)
-	return inframonitor.Bearer{Token: token}, nil
+	token, err := tokens(s.Tokens).Token(ctx)
+	return inframonitor.Bearer{Token: token}, err
 }
 
 // VmMonitorSecuritySource implements security for the vm-monitor client.
-type VmMonitorSecuritySource struct{}
+type VmMonitorSecuritySource struct {
+	// Tokens provides the token; without it the caller's token is forwarded.
+	Tokens TokenProvider
+}
 
 // Bearer returns a bearer token for vm-monitor.
 func (s *VmMonitorSecuritySource) Bearer(ctx context.Context, operationName vmmonitor.OperationName) (vmmonitor.Bearer, error) {
-	token, _ := ctx.Value(constants.BearerTokenKey).(string)
-	return vmmonitor.Bearer{Token: token}, nil
+	token, err := tokens(s.Tokens).Token(ctx)
+	return vmmonitor.Bearer{Token: token}, err
+}
+
+// tokens returns p, or a passthrough provider when p is nil.
+func tokens(p TokenProvider) TokenProvider {
+	if p == nil {
+		return passthroughProvider{}
+	}
+	return p
 }
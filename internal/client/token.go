@@ -0,0 +1,215 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/constants"
+)
+
+const (
+	ModePassthrough = "passthrough"
+	ModeStatic      = "static"
+	ModeOAuth2      = "oauth2"
+)
+
+const (
+	tokenFetchTimeout = 10 * time.Second
+	// tokenExpiryMargin is how long before it expires a cached token is replaced, so that it
+	// does not expire on its way to the downstream service.
+	tokenExpiryMargin = 30 * time.Second
+	// tokenDefaultLifetime is how long a token returned without expires_in is kept.
+	tokenDefaultLifetime = 5 * time.Minute
+)
+
+// TokenProvider returns the bearer token a call to a downstream service carries.
+type TokenProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// NewTokenProvider builds the TokenProvider selected by the mode of auth(). auth is called
+// again for every token, so that rotated secrets apply without a restart; the mode and the
+// OAuth2 endpoint are fixed when the provider is built.
+func NewTokenProvider(name string, auth func() configmanager.Client) (TokenProvider, error) {
+	cfg := auth()
+	switch cfg.Mode {
+	case ModePassthrough, "":
+		return passthroughProvider{}, nil
+	case ModeStatic:
+		if cfg.Token == "" {
+			return nil, fmt.Errorf("client: %s: static mode requires a token", name)
+		}
+		return staticProvider{token: func() configmanager.Secret { return auth().Token }}, nil
+	case ModeOAuth2:
+		if cfg.TokenURL == "" || cfg.ClientID == "" || cfg.ClientSecret == "" {
+			return nil, fmt.Errorf("client: %s: oauth2 mode requires a token URL, client ID and client secret", name)
+		}
+		return &clientCredentialsProvider{
+			name:       name,
+			tokenURL:   cfg.TokenURL,
+			clientID:   cfg.ClientID,
+			scopes:     cfg.Scopes,
+			secret:     func() configmanager.Secret { return auth().ClientSecret },
+			httpClient: &http.Client{Timeout: tokenFetchTimeout},
+			now:        time.Now,
+		}, nil
+	default:
+		return nil, fmt.Errorf("client: %s: unknown auth mode %q", name, cfg.Mode)
+	}
+}
+
+// passthroughProvider forwards the token the caller authenticated with. Background work has
+// no caller, so its calls carry no token.
+type passthroughProvider struct{}
+
+func (passthroughProvider) Token(ctx context.Context) (string, error) {
+	token, _ := ctx.Value(constants.BearerTokenKey).(string)
+	return token, nil
+}
+
+// staticProvider sends a configured token.
+type staticProvider struct {
+	token func() configmanager.Secret
+}
+
+func (p staticProvider) Token(ctx context.Context) (string, error) {
+	return string(p.token()), nil
+}
+
+// clientCredentialsProvider obtains tokens with the OAuth2 client-credentials grant and
+// caches each until shortly before it expires.
+type clientCredentialsProvider struct {
+	name       string
+	tokenURL   string
+	clientID   string
+	scopes     []string
+	secret     func() configmanager.Secret
+	httpClient *http.Client
+	now        func() time.Time
+
+	// mu is held while a token is fetched, so that concurrent calls share one fetch.
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// tokenResponse is the successful response of a token endpoint (RFC 6749, section 5.1).
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func (p *clientCredentialsProvider) Token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != "" && p.now().Before(p.expiry.Add(-tokenExpiryMargin)) {
+		return p.token, nil
+	}
+	token, expiresIn, err := p.fetch(ctx)
+	if err != nil {
+		return "", fmt.Errorf("client: %s: fetching token: %w", p.name, err)
+	}
+	if expiresIn <= 0 {
+		expiresIn = tokenDefaultLifetime
+	}
+	p.token, p.expiry = token, p.now().Add(expiresIn)
+	return p.token, nil
+}
+
+// Invalidate drops token from the cache, so that the next call fetches a new one. A token
+// fetched since token was sent is kept.
+func (p *clientCredentialsProvider) Invalidate(token string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token == token {
+		p.token, p.expiry = "", time.Time{}
+	}
+}
+
+func (p *clientCredentialsProvider) fetch(ctx context.Context) (string, time.Duration, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(p.scopes) > 0 {
+		form.Set("scope", strings.Join(p.scopes, " "))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(p.clientID), url.QueryEscape(string(p.secret())))
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("token endpoint returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", 0, err
+	}
+	if token.AccessToken == "" {
+		return "", 0, errors.New("token endpoint returned no access token")
+	}
+	if token.TokenType != "" && !strings.EqualFold(token.TokenType, "bearer") {
+		return "", 0, fmt.Errorf("token endpoint returned a %s token, not a bearer token", token.TokenType)
+	}
+	return token.AccessToken, time.Duration(token.ExpiresIn) * time.Second, nil
+}
+
+// invalidator is implemented by the TokenProviders that cache tokens.
+type invalidator interface {
+	Invalidate(token string)
+}
+
+// InvalidateOnUnauthorized returns httpClient changed so that a 401 from the service drops the
+// cached token the call was sent with: a token revoked before it expires is then replaced by
+// the next call instead of failing every call until it expires.
+func InvalidateOnUnauthorized(httpClient *http.Client, tokens TokenProvider) *http.Client {
+	cache, ok := tokens.(invalidator)
+	if !ok {
+		return httpClient
+	}
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	changed := *httpClient
+	changed.Transport = &unauthorizedTransport{base: base, tokens: cache}
+	return &changed
+}
+
+type unauthorizedTransport struct {
+	base   http.RoundTripper
+	tokens invalidator
+}
+
+func (t *unauthorizedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		if token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer "); ok {
+			t.tokens.Invalidate(token)
+		}
+	}
+	return resp, err
+}
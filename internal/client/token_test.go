@@ -0,0 +1,204 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vm/internal/client"
+	vmmonitor "vm/internal/client/vm_monitor"
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/constants"
+)
+
+func provider(t *testing.T, auth configmanager.Client) client.TokenProvider {
+	tokens, err := client.NewTokenProvider("vm-monitor", func() configmanager.Client { return auth })
+	require.NoError(t, err)
+	return tokens
+}
+
+// tokenServer is an OAuth2 token endpoint that issues token-1, token-2 and so on.
+func tokenServer(t *testing.T, expiresIn int) (*httptest.Server, *atomic.Int32) {
+	var issued atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "vm" || secret != "s3cret" {
+			http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+			return
+		}
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "vm.read vm.write", r.PostForm.Get("scope"))
+
+		n := issued.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": fmt.Sprintf("token-%d", n),
+			"token_type":   "Bearer",
+			"expires_in":   expiresIn,
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server, &issued
+}
+
+func oauth2Auth(tokenURL string) configmanager.Client {
+	return configmanager.Client{
+		Mode:         client.ModeOAuth2,
+		TokenURL:     tokenURL,
+		ClientID:     "vm",
+		ClientSecret: "s3cret",
+		Scopes:       []string{"vm.read", "vm.write"},
+	}
+}
+
+func TestTokenProvider(t *testing.T) {
+	userCtx := context.WithValue(context.Background(), constants.BearerTokenKey, "user-token")
+
+	t.Run("Passthrough forwards the caller's token", func(t *testing.T) {
+		tokens := provider(t, configmanager.Client{Mode: client.ModePassthrough})
+
+		token, err := tokens.Token(userCtx)
+		require.NoError(t, err)
+		assert.Equal(t, "user-token", token)
+
+		token, err = tokens.Token(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, token)
+	})
+
+	t.Run("Static sends the configured token, rotated or not", func(t *testing.T) {
+		auth := configmanager.Client{Mode: client.ModeStatic, Token: "first"}
+		tokens, err := client.NewTokenProvider("vm-monitor", func() configmanager.Client { return auth })
+		require.NoError(t, err)
+
+		token, _ := tokens.Token(userCtx)
+		assert.Equal(t, "first", token)
+		auth.Token = "second"
+		token, _ = tokens.Token(context.Background())
+		assert.Equal(t, "second", token)
+	})
+
+	t.Run("OAuth2 caches the token until it is about to expire", func(t *testing.T) {
+		server, issued := tokenServer(t, 3600)
+		tokens := provider(t, oauth2Auth(server.URL))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				token, err := tokens.Token(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, "token-1", token)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), issued.Load())
+	})
+
+	t.Run("OAuth2 fetches a new token when the cached one expires", func(t *testing.T) {
+		// Tokens that live no longer than the expiry margin are never reused.
+		server, issued := tokenServer(t, 10)
+		tokens := provider(t, oauth2Auth(server.URL))
+
+		first, err := tokens.Token(context.Background())
+		require.NoError(t, err)
+		second, err := tokens.Token(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, "token-1", first)
+		assert.Equal(t, "token-2", second)
+		assert.Equal(t, int32(2), issued.Load())
+	})
+
+	t.Run("OAuth2 keeps a token without expires_in for a bounded time", func(t *testing.T) {
+		server, issued := tokenServer(t, 0)
+		tokens := provider(t, oauth2Auth(server.URL))
+
+		first, err := tokens.Token(context.Background())
+		require.NoError(t, err)
+		second, err := tokens.Token(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, "token-1", first)
+		assert.Equal(t, "token-1", second)
+		assert.Equal(t, int32(1), issued.Load())
+	})
+
+	t.Run("OAuth2 reports a rejected client", func(t *testing.T) {
+		server, _ := tokenServer(t, 3600)
+		auth := oauth2Auth(server.URL)
+		auth.ClientSecret = "wrong"
+
+		_, err := provider(t, auth).Token(context.Background())
+
+		assert.ErrorContains(t, err, "client: vm-monitor: fetching token: token endpoint returned 401 Unauthorized")
+	})
+
+	t.Run("Incomplete modes are rejected", func(t *testing.T) {
+		for _, auth := range []configmanager.Client{
+			{Mode: client.ModeStatic},
+			{Mode: client.ModeOAuth2, TokenURL: "https://idp.example/token"},
+			{Mode: "mtls"},
+		} {
+			_, err := client.NewTokenProvider("vm-monitor", func() configmanager.Client { return auth })
+			assert.Error(t, err, auth.Mode)
+		}
+	})
+}
+
+func TestSecuritySource(t *testing.T) {
+	server, _ := tokenServer(t, 3600)
+	var authorization string
+	vmMonitor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer vmMonitor.Close()
+
+	vmClient, err := vmmonitor.NewClient(vmMonitor.URL, &client.VmMonitorSecuritySource{Tokens: provider(t, oauth2Auth(server.URL))})
+	require.NoError(t, err)
+
+	// Background work has no caller token, and still authenticates.
+	_, _ = vmClient.GetVmMetrics(context.Background(), vmmonitor.GetVmMetricsParams{VMID: "vm-1"})
+
+	assert.Equal(t, "Bearer token-1", authorization)
+}
+
+func TestInvalidateOnUnauthorized(t *testing.T) {
+	server, issued := tokenServer(t, 3600)
+	var authorizations []string
+	vmMonitor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		// token-1 is revoked before it expires.
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer vmMonitor.Close()
+
+	tokens := provider(t, oauth2Auth(server.URL))
+	vmClient, err := vmmonitor.NewClient(vmMonitor.URL, &client.VmMonitorSecuritySource{Tokens: tokens},
+		vmmonitor.WithClient(client.InvalidateOnUnauthorized(&http.Client{}, tokens)))
+	require.NoError(t, err)
+
+	_, _ = vmClient.GetVmMetrics(context.Background(), vmmonitor.GetVmMetricsParams{VMID: "vm-1"})
+	_, _ = vmClient.GetVmMetrics(context.Background(), vmmonitor.GetVmMetricsParams{VMID: "vm-1"})
+
+	assert.Equal(t, []string{"Bearer token-1", "Bearer token-2"}, authorizations)
+	assert.Equal(t, int32(2), issued.Load())
+}
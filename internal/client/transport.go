@@ -0,0 +1,308 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"vm/pkg/cinterface"
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/constants"
+)
+
+const (
+	retryBaseDelay = 100 * time.Millisecond
+	retryMaxDelay  = 2 * time.Second
+)
+
+// ErrCircuitOpen is returned, without calling the service, while its circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// Outcomes of a call, as recorded in the metrics.
+const (
+	outcomeOK          = "ok"
+	outcomeServerError = "server_error"
+	outcomeError       = "error"
+	outcomeCircuitOpen = "circuit_open"
+	// outcomeCancelled is a call its caller gave up on, which says nothing about the service.
+	outcomeCancelled = "cancelled"
+)
+
+// transport calls a downstream service with timeouts, retries of failed GETs, and a circuit
+// breaker that stops calling a service that keeps failing.
+type transport struct {
+	name     string
+	settings func() configmanager.Client
+	base     http.RoundTripper
+	breaker  breaker
+	logger   cinterface.Logger
+	// sleep waits between retries; tests replace it.
+	sleep func(ctx context.Context, d time.Duration) error
+
+	requests    metric.Int64Counter
+	duration    metric.Float64Histogram
+	retries     metric.Int64Counter
+	transitions metric.Int64Counter
+}
+
+// NewHTTPClient returns the HTTP client of the downstream service name. settings is called for
+// every call, so that changed timeouts, retries and breaker settings apply without a restart.
+func NewHTTPClient(name string, settings func() configmanager.Client, logger cinterface.Logger) *http.Client {
+	t := &transport{
+		name:     name,
+		settings: settings,
+		breaker:  breaker{state: breakerClosed, now: time.Now},
+		logger:   logger,
+		sleep:    sleep,
+	}
+
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	base.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		dialer := &net.Dialer{Timeout: seconds(t.settings().ConnectTimeout), KeepAlive: 30 * time.Second}
+		return dialer.DialContext(ctx, network, address)
+	}
+	t.base = base
+
+	meter := otel.Meter("vm/internal/client")
+	t.requests, _ = meter.Int64Counter("vm.client.requests",
+		metric.WithDescription("Calls to downstream services, by client and outcome"))
+	t.duration, _ = meter.Float64Histogram("vm.client.request.duration",
+		metric.WithDescription("Duration of calls to downstream services, retries included"),
+		metric.WithUnit("s"))
+	t.retries, _ = meter.Int64Counter("vm.client.retries",
+		metric.WithDescription("Retried calls to downstream services, by client"))
+	t.transitions, _ = meter.Int64Counter("vm.client.circuit.transitions",
+		metric.WithDescription("Circuit breaker state changes, by client and new state"))
+
+	return &http.Client{Transport: t}
+}
+
+// ServiceURL returns the URL of a downstream service configured as host:port or as a URL.
+func ServiceURL(address string) string {
+	if strings.Contains(address, "://") {
+		return address
+	}
+	return "http://" + address
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	cfg := t.settings()
+	start := time.Now()
+
+	if !t.breaker.allow(seconds(cfg.BreakerCooldown)) {
+		t.record(req.Context(), outcomeCircuitOpen, start)
+		return nil, fmt.Errorf("%s: %w", t.name, ErrCircuitOpen)
+	}
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+	if cfg.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(req.Context(), seconds(cfg.Timeout))
+	} else {
+		ctx, cancel = context.WithCancel(req.Context())
+	}
+	attempts := 1
+	if req.Method == http.MethodGet && req.Body == nil {
+		attempts += cfg.Retries
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.base.RoundTrip(req.WithContext(ctx))
+		if attempt < attempts && retryable(resp, err) && ctx.Err() == nil {
+			if resp != nil {
+				_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+				resp.Body.Close()
+			}
+			t.retries.Add(ctx, 1, metric.WithAttributes(attribute.String("client", t.name)))
+			if sleepErr := t.sleep(ctx, backoff(attempt)); sleepErr == nil {
+				continue
+			}
+			// The call timed out while waiting: report the last failure.
+			resp, err = nil, ctx.Err()
+		}
+
+		outcome := outcomeOK
+		switch {
+		case err != nil:
+			outcome = outcomeError
+		case resp.StatusCode >= http.StatusInternalServerError:
+			outcome = outcomeServerError
+		}
+		if outcome != outcomeOK && req.Context().Err() != nil {
+			outcome = outcomeCancelled
+			t.breaker.release()
+		} else {
+			t.recordBreaker(outcome == outcomeOK, cfg.BreakerThreshold)
+		}
+		t.record(req.Context(), outcome, start)
+
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		// The timeout covers reading the body too.
+		resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+		return resp, nil
+	}
+}
+
+// retryable reports whether a GET that got resp or err may succeed if made again.
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns a random delay before retry attempt, up to a limit that doubles with each
+// attempt, so that clients retrying together spread out.
+func backoff(attempt int) time.Duration {
+	limit := retryBaseDelay << (attempt - 1)
+	if limit <= 0 || limit > retryMaxDelay {
+		limit = retryMaxDelay
+	}
+	return rand.N(limit) + 1
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (t *transport) record(ctx context.Context, outcome string, start time.Time) {
+	attrs := metric.WithAttributes(attribute.String("client", t.name), attribute.String("outcome", outcome))
+	t.requests.Add(ctx, 1, attrs)
+	t.duration.Record(ctx, time.Since(start).Seconds(), attrs)
+}
+
+func (t *transport) recordBreaker(success bool, threshold int) {
+	from, to := t.breaker.record(success, threshold)
+	if from == to {
+		return
+	}
+	t.transitions.Add(context.Background(), 1, metric.WithAttributes(attribute.String("client", t.name), attribute.String("state", string(to))))
+	extra := map[constants.ExtraKey]interface{}{"client": t.name, "from": string(from), "to": string(to)}
+	if to == breakerOpen {
+		t.logger.Warn(constants.General, constants.ExternalService, "Circuit breaker opened; calls fail until it cools down", extra)
+	} else {
+		t.logger.Info(constants.General, constants.ExternalService, "Circuit breaker state changed", extra)
+	}
+}
+
+// seconds converts a setting in seconds to a duration.
+func seconds(n int) time.Duration {
+	return time.Duration(n) * time.Second
+}
+
+// cancelOnClose releases the context of a call once its response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
+
+type breakerState string
+
+const (
+	breakerClosed   breakerState = "closed"
+	breakerOpen     breakerState = "open"
+	breakerHalfOpen breakerState = "half_open"
+)
+
+// breaker is a circuit breaker. It opens after consecutive failed calls, and once it has
+// cooled down lets a single probe call through: the probe closes it by succeeding, or opens it
+// again by failing.
+type breaker struct {
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+	now      func() time.Time
+}
+
+// allow reports whether a call may be made.
+func (b *breaker) allow(cooldown time.Duration) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < cooldown {
+			return false
+		}
+		b.state, b.probing = breakerHalfOpen, true
+		return true
+	case breakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// release ends a call without counting it, so that a cancelled probe lets the next call
+// probe instead.
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen {
+		b.probing = false
+	}
+}
+
+// record counts the result of a call and returns the state before and after it.
+func (b *breaker) record(success bool, threshold int) (from, to breakerState) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	from = b.state
+	switch {
+	case success, threshold <= 0:
+		b.state, b.failures = breakerClosed, 0
+	case from == breakerClosed:
+		b.failures++
+		if b.failures >= threshold {
+			b.state, b.openedAt = breakerOpen, b.now()
+		}
+	case from == breakerHalfOpen:
+		b.state, b.openedAt = breakerOpen, b.now()
+	}
+	// Otherwise a call made before the breaker opened failed, which leaves it open.
+	if from == breakerHalfOpen {
+		b.probing = false
+	}
+	return from, b.state
+}
@@ -0,0 +1,272 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	configmanager "vm/pkg/config-manager"
+	mock_logger "vm/pkg/logger/mock"
+)
+
+var testSettings = configmanager.Client{ConnectTimeout: 1, Timeout: 5, Retries: 2, BreakerThreshold: 2, BreakerCooldown: 30}
+
+// server answers with the given status codes in turn, repeating the last one, and counts the
+// requests it gets.
+func server(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		if n > len(statuses) {
+			n = len(statuses)
+		}
+		w.WriteHeader(statuses[n-1])
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+// newTestClient returns a client with settings that does not wait between retries, and its
+// transport.
+func newTestClient(settings configmanager.Client) (*http.Client, *transport) {
+	httpClient := NewHTTPClient("vm-monitor", func() configmanager.Client { return settings }, &mock_logger.StubLogger{})
+	t := httpClient.Transport.(*transport)
+	t.sleep = func(context.Context, time.Duration) error { return nil }
+	return httpClient, t
+}
+
+func call(t *testing.T, httpClient *http.Client, method, url string) (*http.Response, error) {
+	req, err := http.NewRequest(method, url, nil)
+	require.NoError(t, err)
+	resp, err := httpClient.Do(req)
+	if err == nil {
+		resp.Body.Close()
+	}
+	return resp, err
+}
+
+func TestTransportRetries(t *testing.T) {
+	t.Run("GET is retried until it succeeds", func(t *testing.T) {
+		srv, calls := server(t, http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK)
+		httpClient, _ := newTestClient(testSettings)
+
+		resp, err := call(t, httpClient, http.MethodGet, srv.URL)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("Retries are bounded", func(t *testing.T) {
+		srv, calls := server(t, http.StatusServiceUnavailable)
+		httpClient, _ := newTestClient(testSettings)
+
+		resp, err := call(t, httpClient, http.MethodGet, srv.URL)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("Other methods and statuses are not retried", func(t *testing.T) {
+		for _, tt := range []struct {
+			method string
+			status int
+		}{
+			{http.MethodPost, http.StatusServiceUnavailable},
+			{http.MethodGet, http.StatusInternalServerError},
+			{http.MethodGet, http.StatusNotFound},
+		} {
+			srv, calls := server(t, tt.status)
+			httpClient, _ := newTestClient(testSettings)
+
+			resp, err := call(t, httpClient, tt.method, srv.URL)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, resp.StatusCode)
+			assert.Equal(t, int32(1), calls.Load(), "%s %d", tt.method, tt.status)
+		}
+	})
+
+	t.Run("Unreachable service is retried", func(t *testing.T) {
+		srv, _ := server(t, http.StatusOK)
+		srv.Close()
+		httpClient, tr := newTestClient(testSettings)
+		var sleeps int
+		tr.sleep = func(context.Context, time.Duration) error { sleeps++; return nil }
+
+		_, err := call(t, httpClient, http.MethodGet, srv.URL)
+
+		assert.Error(t, err)
+		assert.Equal(t, 2, sleeps)
+	})
+}
+
+func TestTransportTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+	settings := testSettings
+	settings.Timeout, settings.Retries = 1, 0
+	httpClient, _ := newTestClient(settings)
+
+	start := time.Now()
+	_, err := call(t, httpClient, http.MethodGet, srv.URL)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 3*time.Second)
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt := 1; attempt < 10; attempt++ {
+		limit := min(retryBaseDelay<<(attempt-1), retryMaxDelay)
+		for i := 0; i < 20; i++ {
+			delay := backoff(attempt)
+			assert.Positive(t, delay)
+			assert.LessOrEqual(t, delay, limit)
+		}
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	srv, calls := server(t, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK)
+	httpClient, tr := newTestClient(testSettings)
+	now := time.Now()
+	tr.breaker.now = func() time.Time { return now }
+
+	// Two failed calls open the breaker.
+	for i := 0; i < 2; i++ {
+		_, err := call(t, httpClient, http.MethodGet, srv.URL)
+		require.NoError(t, err)
+	}
+	_, err := call(t, httpClient, http.MethodGet, srv.URL)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(2), calls.Load(), "an open breaker does not call the service")
+
+	// After the cooldown a probe is let through, and closes the breaker by succeeding.
+	now = now.Add(30 * time.Second)
+	resp, err := call(t, httpClient, http.MethodGet, srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, breakerClosed, tr.breaker.state)
+}
+
+func TestCircuitBreakerIgnoresCancelledCalls(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+	settings := testSettings
+	settings.BreakerThreshold = 1
+	httpClient, tr := newTestClient(settings)
+
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		require.NoError(t, err)
+		_, err = httpClient.Do(req)
+		cancel()
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	}
+	assert.Equal(t, breakerClosed, tr.breaker.state, "callers giving up say nothing about the service")
+}
+
+func TestBreaker(t *testing.T) {
+	now := time.Now()
+	b := breaker{state: breakerClosed, now: func() time.Time { return now }}
+
+	t.Run("Failed probe opens the breaker again", func(t *testing.T) {
+		b.record(false, 1)
+		now = now.Add(time.Minute)
+		require.True(t, b.allow(time.Second))
+		assert.False(t, b.allow(time.Second), "only one probe at a time")
+
+		from, to := b.record(false, 1)
+
+		assert.Equal(t, breakerHalfOpen, from)
+		assert.Equal(t, breakerOpen, to)
+		assert.False(t, b.allow(time.Second))
+	})
+
+	t.Run("Cancelled probe lets the next call probe", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		require.True(t, b.allow(time.Second))
+
+		b.release()
+
+		assert.True(t, b.allow(time.Second))
+		b.record(true, 1)
+	})
+
+	t.Run("Threshold of 0 disables the breaker", func(t *testing.T) {
+		b := breaker{state: breakerClosed, now: time.Now}
+		for i := 0; i < 10; i++ {
+			b.record(false, 0)
+		}
+		assert.True(t, b.allow(time.Minute))
+	})
+}
+
+func TestTransportMetrics(t *testing.T) {
+	srv, _ := server(t, http.StatusServiceUnavailable, http.StatusOK, http.StatusInternalServerError)
+	settings := testSettings
+	settings.BreakerThreshold = 1
+	httpClient, tr := newTestClient(settings)
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+	tr.requests, _ = meter.Int64Counter("vm.client.requests")
+	tr.duration, _ = meter.Float64Histogram("vm.client.request.duration")
+	tr.retries, _ = meter.Int64Counter("vm.client.retries")
+	tr.transitions, _ = meter.Int64Counter("vm.client.circuit.transitions")
+
+	call(t, httpClient, http.MethodGet, srv.URL)
+	call(t, httpClient, http.MethodPost, srv.URL)
+	call(t, httpClient, http.MethodGet, srv.URL)
+
+	var metrics metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &metrics))
+	counts := map[string]int64{}
+	for _, m := range metrics.ScopeMetrics[0].Metrics {
+		sum, ok := m.Data.(metricdata.Sum[int64])
+		if !ok {
+			continue
+		}
+		for _, point := range sum.DataPoints {
+			key := m.Name
+			for _, attr := range point.Attributes.ToSlice() {
+				if attr.Key != "client" {
+					key += " " + attr.Value.AsString()
+				}
+			}
+			counts[key] = point.Value
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"vm.client.requests ok":              1,
+		"vm.client.requests server_error":    1,
+		"vm.client.requests circuit_open":    1,
+		"vm.client.retries":                  1,
+		"vm.client.circuit.transitions open": 1,
+	}, counts)
+}
+
+func TestServiceURL(t *testing.T) {
+	assert.Equal(t, "http://vm-monitor:8083", ServiceURL("vm-monitor:8083"))
+	assert.Equal(t, "https://vm-monitor.example", ServiceURL("https://vm-monitor.example"))
+	assert.True(t, strings.HasPrefix(ServiceURL("10.0.0.5:8083"), "http://"))
+}
@@ -0,0 +1,223 @@
+package config
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"vm/pkg/cinterface"
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/constants"
+	"vm/pkg/logger"
+)
+
+// reloadable are the keys that take effect while the server runs, each with what applying it
+// takes beyond storing the new configuration. A change to any other key needs a restart.
+var reloadable = map[string]func(cfg *configmanager.ApplicationConfig){
+	"log.Level": func(cfg *configmanager.ApplicationConfig) {
+		// validate has already rejected unknown levels.
+		_ = logger.SetLevel(cfg.Log.Level)
+	},
+	"app.validate_client_request": nil,
+	// The token verifier reads the secret for every token.
+	"auth.hmacSecret": nil,
+	// New database connections log in with the current password; open ones stay logged in.
+	"database.password": nil,
+}
+
+func init() {
+	// The clients read their secrets, timeouts, retries and breaker settings for every call.
+	for _, c := range clients {
+		for _, key := range []string{"token", "clientSecret", "connectTimeout", "timeout", "retries", "breakerThreshold", "breakerCooldown"} {
+			reloadable["clients."+c.key+"."+key] = nil
+		}
+	}
+}
+
+// Holder holds the current configuration and replaces it when the profile's config file
+// changes. Readers call Get on every use instead of keeping the result.
+type Holder struct {
+	// mu serializes reloads, which both the config file and the secret files trigger.
+	mu      sync.Mutex
+	src     *source
+	current atomic.Pointer[configmanager.ApplicationConfig]
+	reloads metric.Int64Counter
+}
+
+// NewHolder loads the configuration the way Load does and holds it.
+func NewHolder(args []string) (*Holder, error) {
+	src, err := newSource(args)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := src.read()
+	if err != nil {
+		return nil, err
+	}
+
+	reloads, _ := otel.Meter("vm/internal/config").Int64Counter("vm.config.reloads",
+		metric.WithDescription("Reloads of the configuration file, by result"))
+	h := &Holder{src: src, reloads: reloads}
+	h.current.Store(cfg)
+	return h, nil
+}
+
+// Get returns the current configuration. It must not be modified.
+func (h *Holder) Get() *configmanager.ApplicationConfig {
+	return h.current.Load()
+}
+
+// Watch reloads the configuration whenever the profile's config file or a secret file
+// changes.
+func (h *Holder) Watch(log cinterface.Logger) {
+	if h.src.file != nil {
+		h.src.file.OnConfigChange(func(event fsnotify.Event) {
+			h.reload(log)
+		})
+		h.src.file.WatchConfig()
+		log.Info(constants.General, constants.Config, "Watching the config file for changes", map[constants.ExtraKey]interface{}{
+			"file": h.src.viper.ConfigFileUsed(),
+		})
+	}
+
+	dirs := h.src.secretDirs()
+	if len(dirs) > 0 {
+		if err := h.watchSecrets(dirs, log); err != nil {
+			log.Error(constants.General, constants.Config, "Failed to watch the secret files; rotated secrets need a restart", map[constants.ExtraKey]interface{}{
+				constants.ErrorMessage: err.Error(),
+			})
+		} else {
+			log.Info(constants.General, constants.Config, "Watching the secret files for rotation", map[constants.ExtraKey]interface{}{
+				"dirs": strings.Join(dirs, ", "),
+			})
+		}
+	}
+
+	if h.src.file == nil && len(dirs) == 0 {
+		log.Info(constants.General, constants.Config, "Profile has no config or secret files; configuration is not reloaded", map[constants.ExtraKey]interface{}{
+			"profile": h.src.profile,
+		})
+	}
+}
+
+// watchSecrets reloads the configuration on every change in dirs.
+func (h *Holder) watchSecrets(dirs []string, log cinterface.Logger) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return err
+		}
+	}
+	go func() {
+		for {
+			select {
+			case _, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				h.reload(log)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Warn(constants.General, constants.Config, "Error watching the secret files", map[constants.ExtraKey]interface{}{
+					constants.ErrorMessage: err.Error(),
+				})
+			}
+		}
+	}()
+	return nil
+}
+
+// reload reads the configuration again and applies the reloadable keys that changed. An
+// invalid configuration is rejected as a whole and the current one kept.
+func (h *Holder) reload(log cinterface.Logger) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	next, err := h.src.read()
+	if err != nil {
+		h.record("failure")
+		log.Error(constants.General, constants.Config, "Config file changed but could not be reloaded; keeping the current configuration", map[constants.ExtraKey]interface{}{
+			constants.ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	current := h.Get()
+	merged, applied, ignored := merge(current, next)
+	for _, key := range applied {
+		if apply := reloadable[key]; apply != nil {
+			apply(merged)
+		}
+	}
+	h.current.Store(merged)
+	h.record("success")
+
+	if len(ignored) > 0 {
+		log.Warn(constants.General, constants.Config, "Config file changed keys that need a restart; ignoring them", map[constants.ExtraKey]interface{}{
+			"keys": describeKeys(ignored),
+		})
+	}
+	if len(applied) > 0 {
+		log.Info(constants.General, constants.Config, "Configuration reloaded", map[constants.ExtraKey]interface{}{
+			"keys": describeKeys(applied),
+		})
+	}
+}
+
+func (h *Holder) record(result string) {
+	if h.reloads == nil {
+		return
+	}
+	h.reloads.Add(context.Background(), 1, metric.WithAttributes(attribute.String("result", result)))
+}
+
+// merge returns a copy of current with the reloadable keys that differ in next taken from
+// next, along with the changed keys it applied and those it ignored. Keys are named by their
+// mapstructure tags, the way the settings table names them.
+func merge(current, next *configmanager.ApplicationConfig) (merged *configmanager.ApplicationConfig, applied, ignored []string) {
+	merged = new(configmanager.ApplicationConfig)
+	*merged = *current
+
+	var walk func(prefix string, from, to reflect.Value)
+	walk = func(prefix string, from, to reflect.Value) {
+		for i := 0; i < from.NumField(); i++ {
+			key := prefix + from.Type().Field(i).Tag.Get("mapstructure")
+			if from.Field(i).Kind() == reflect.Struct {
+				walk(key+".", from.Field(i), to.Field(i))
+				continue
+			}
+			if reflect.DeepEqual(from.Field(i).Interface(), to.Field(i).Interface()) {
+				continue
+			}
+			if _, ok := reloadable[key]; ok {
+				from.Field(i).Set(to.Field(i))
+				applied = append(applied, key)
+			} else {
+				ignored = append(ignored, key)
+			}
+		}
+	}
+	walk("", reflect.ValueOf(merged).Elem(), reflect.ValueOf(next).Elem())
+	return merged, applied, ignored
+}
+
+func describeKeys(keys []string) string {
+	described := make([]string, len(keys))
+	for i, key := range keys {
+		described[i] = describeKey(key)
+	}
+	return strings.Join(described, ", ")
+}
@@ -0,0 +1,161 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	configmanager "vm/pkg/config-manager"
+	mock_logger "vm/pkg/logger/mock"
+)
+
+const profileConfig = `
+app:
+  validate_client_request: false
+database:
+  host: db
+log:
+  Level: info
+`
+
+// newWatchedHolder returns a holder of the dev profile and the path of its config file.
+func newWatchedHolder(t *testing.T) (*Holder, string) {
+	path := filepath.Join(t.TempDir(), "dev", "vm", "config.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(profileConfig), 0o600))
+	t.Setenv("CONFIG_DIR", filepath.Dir(filepath.Dir(filepath.Dir(path))))
+	t.Setenv("DB_USER", "vm")
+	t.Setenv("DB_PASS", "secret")
+	t.Setenv("AUTH_HMAC_SECRET", "hmac-secret")
+	t.Setenv("EXECUTOR_BACKEND", "fake")
+	t.Cleanup(func() { zerolog.SetGlobalLevel(zerolog.TraceLevel) })
+
+	holder, err := NewHolder(nil)
+	require.NoError(t, err)
+	return holder, path
+}
+
+func TestReload(t *testing.T) {
+	t.Run("Reloadable keys apply and others are ignored", func(t *testing.T) {
+		holder, path := newWatchedHolder(t)
+		before := holder.Get()
+		require.NoError(t, os.WriteFile(path, []byte(`
+app:
+  validate_client_request: true
+database:
+  host: other-db
+log:
+  Level: warn
+`), 0o600))
+		require.NoError(t, holder.src.viper.ReadInConfig())
+
+		holder.reload(&mock_logger.StubLogger{})
+
+		after := holder.Get()
+		assert.True(t, after.Application.ValidateClientRequest)
+		assert.Equal(t, "warn", after.Log.Level)
+		assert.Equal(t, zerolog.WarnLevel, zerolog.GlobalLevel())
+		assert.Equal(t, "db", after.Database.Host)
+		assert.False(t, before.Application.ValidateClientRequest, "the previous configuration is not modified")
+	})
+
+	t.Run("Invalid configuration is rejected as a whole", func(t *testing.T) {
+		holder, path := newWatchedHolder(t)
+		require.NoError(t, os.WriteFile(path, []byte(`
+app:
+  validate_client_request: true
+log:
+  Level: loud
+`), 0o600))
+		require.NoError(t, holder.src.viper.ReadInConfig())
+
+		holder.reload(&mock_logger.StubLogger{})
+
+		assert.False(t, holder.Get().Application.ValidateClientRequest)
+		assert.Equal(t, "info", holder.Get().Log.Level)
+	})
+
+	t.Run("Reloads are counted by result", func(t *testing.T) {
+		reader := sdkmetric.NewManualReader()
+		reloads, err := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test").Int64Counter("vm.config.reloads")
+		require.NoError(t, err)
+		holder, path := newWatchedHolder(t)
+		holder.reloads = reloads
+
+		holder.reload(&mock_logger.StubLogger{})
+		require.NoError(t, os.WriteFile(path, []byte("log:\n  Level: loud\n"), 0o600))
+		require.NoError(t, holder.src.viper.ReadInConfig())
+		holder.reload(&mock_logger.StubLogger{})
+
+		var metrics metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(context.Background(), &metrics))
+		sum := metrics.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+		counts := map[string]int64{}
+		for _, point := range sum.DataPoints {
+			result, _ := point.Attributes.Value("result")
+			counts[result.AsString()] = point.Value
+		}
+		assert.Equal(t, map[string]int64{"success": 1, "failure": 1}, counts)
+	})
+}
+
+func TestWatch(t *testing.T) {
+	holder, path := newWatchedHolder(t)
+	holder.Watch(&mock_logger.StubLogger{})
+
+	require.NoError(t, os.WriteFile(path, []byte("app:\n  validate_client_request: true\n"), 0o600))
+
+	assert.Eventually(t, func() bool {
+		return holder.Get().Application.ValidateClientRequest
+	}, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestMerge(t *testing.T) {
+	current := &configmanager.ApplicationConfig{
+		Application: configmanager.Application{Port: "8080"},
+		Database:    configmanager.Database{Host: "db", Replicas: []string{"replica-a"}},
+		Log:         configmanager.Log{Level: "info"},
+	}
+	next := *current
+	next.Application.Port = "9090"
+	next.Application.ValidateClientRequest = true
+	next.Database.Replicas = []string{"replica-b"}
+	next.Database.Password = "rotated"
+
+	merged, applied, ignored := merge(current, &next)
+
+	assert.Equal(t, []string{"app.validate_client_request", "database.password"}, applied)
+	assert.Equal(t, []string{"app.port", "database.replicas"}, ignored)
+	assert.True(t, merged.Application.ValidateClientRequest)
+	assert.Equal(t, "8080", merged.Application.Port)
+	assert.Equal(t, []string{"replica-a"}, merged.Database.Replicas)
+	assert.Equal(t, configmanager.Secret("rotated"), merged.Database.Password)
+	assert.False(t, current.Application.ValidateClientRequest)
+}
+
+func TestWatchSecrets(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "AUTH_HMAC_SECRET"), []byte("first"), 0o600))
+	t.Setenv("CONFIG_DIR", t.TempDir())
+	t.Setenv("SECRETS_DIR", dir)
+	t.Setenv("DB_USER", "vm")
+	t.Setenv("DB_PASS", "secret")
+	t.Setenv("EXECUTOR_BACKEND", "fake")
+	holder, err := NewHolder(nil)
+	require.NoError(t, err)
+	holder.Watch(&mock_logger.StubLogger{})
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "AUTH_HMAC_SECRET"), []byte("second"), 0o600))
+
+	assert.Eventually(t, func() bool {
+		return holder.Get().Auth.HMACSecret == "second"
+	}, 5*time.Second, 20*time.Millisecond)
+}
@@ -1,63 +1,246 @@
 package config
 
-// import (
-// 	"vm/pkg/configmanager"
-// 	"fmt"
-// 	"github.com/fsnotify/fsnotify"
-// 	"sync/atomic"
-// )
-
-
-
-// type Holder struct {
-// 	AppCfg         atomic.Value // holds *configmanager.ApplicationConfigModal
-// }
-
-// var (
-// 	configHolder = &Holder{}
-// 	configLock   = &configmanager.ConfigLocker{}
-// )
-
-// func Load() error {
-
-// 	// Initialize application config
-// 	appConfigViper, err := configmanager.CreateViperInstance("config", "json", "dev", "app", ".")
-// 	if err != nil {
-// 		return fmt.Errorf("failed to create app config: %w", err)
-// 	}
-
-// 	appCfg := &configmanager.ApplicationConfigModal{}
-// 	if err := configLock.UnmarshallViper(appConfigViper.GetViper(), appCfg, "config"); err != nil {
-// 		return fmt.Errorf("failed to unmarshal app config: %w", err)
-// 	}
-// 	configHolder.AppCfg.Store(appCfg)
-
-// 	appConfigViper.OnConfigChange(func(e fsnotify.Event) {
-// 		if err := configLock.UnmarshallViper(appConfigViper.GetViper(), appCfg, "config"); err != nil {
-// 			panic("failed to unmarshal app config : " + err.Error())
-// 		}
-// 		configHolder.AppCfg.Store(appCfg)
-// 	})
-// 	appConfigViper.WatchConfig()
-
-
-
-// 	return nil
-// }
-
-// // Getter methods
-
-// func GetAppConfig() *configmanager.ApplicationConfigModal {
-// 	return configHolder.AppCfg.Load().(*configmanager.ApplicationConfigModal)
-// }
-
-// func GetConfig() *configmanager.Config {
-// 	err := Load()
-// 	if err != nil {
-// 		panic("failed to load config: " + err.Error())
-// 	}
-// 	config := configmanager.Config{
-// 		App:         configHolder.AppCfg.Load().(*configmanager.ApplicationConfigModal),
-// 	}
-// 	return &config
-// }
\ No newline at end of file
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/db"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const serviceName = "vm"
+
+// devProfile is the profile of local development, the only one allowed the fake executor
+// backend.
+const devProfile = "dev"
+
+// setting is one configuration key: its default, and the environment variable and flag that
+// override it. Keys follow the mapstructure tags of configmanager.ApplicationConfig, and the
+// flag of a key is the key itself, e.g. --database.host.
+type setting struct {
+	key string
+	env string
+	// value is the default. Secrets and the executor backend have none, so they must be
+	// configured.
+	value interface{}
+}
+
+var settings = []setting{
+	{"app.name", "", serviceName},
+	{"app.port", "APP_PORT", "8080"},
+	{"app.image_manager_service_name", "IMAGE_MANAGER_SERVICE_NAME", "image-manager:8081"},
+	{"app.infra_monitor_service_name", "INFRA_MONITOR_SERVICE_NAME", "infra-monitor:8082"},
+	{"app.vm_monitor_service_name", "VM_MONITOR_SERVICE_NAME", "vm-monitor:8083"},
+	{"app.validate_client_request", "VALIDATE_CLIENT_REQUEST", false},
+
+	{"database.driver", "DB_DRIVER", db.DriverMySQL},
+	{"database.host", "DB_HOST", "localhost"},
+	// The port defaults to the one of the driver once the driver is known.
+	{"database.port", "DB_PORT", 0},
+	{"database.dbName", "DB_NAME", "vmdb"},
+	{"database.username", "DB_USER", nil},
+	{"database.password", "DB_PASS", nil},
+	{"database.sslMode", "DB_SSL_MODE", "disable"},
+	{"database.maxIdleConnection", "DB_MAX_IDLE", 10},
+	{"database.maxOpenConnection", "DB_MAX_OPEN", 100},
+	{"database.MaxConnectionLifeTime", "DB_CONN_LIFETIME", 60},
+	// DB_REPLICAS is a comma-separated list of host[:port] read replicas.
+	{"database.replicas", "DB_REPLICAS", []string{}},
+	{"database.replicaMaxLag", "DB_REPLICA_MAX_LAG", 5},
+	{"database.replicaCheckInterval", "DB_REPLICA_CHECK_INTERVAL", 10},
+	{"database.healthCheckInterval", "DB_HEALTH_CHECK_INTERVAL", 5},
+	{"database.reconnectMaxBackoff", "DB_RECONNECT_MAX_BACKOFF", 30},
+	{"database.healthCheckFailures", "DB_HEALTH_CHECK_FAILURES", 3},
+
+	{"log.Level", "LOG_LEVEL", "debug"},
+	{"log.FilePath", "LOG_FILE_PATH", ""},
+	{"log.FileName", "LOG_FILE_NAME", ""},
+	{"log.Encoding", "LOG_ENCODING", ""},
+	{"log.EnableConsole", "LOG_ENABLE_CONSOLE", true},
+	{"log.EnableFile", "LOG_ENABLE_FILE", false},
+
+	{"executor.enabled", "EXECUTOR_ENABLED", true},
+	// The backend has no default, so that a deployment never runs the fake one by accident.
+	{"executor.backend", "EXECUTOR_BACKEND", nil},
+	{"executor.pollInterval", "EXECUTOR_POLL_INTERVAL", 5},
+	{"executor.batchSize", "EXECUTOR_BATCH_SIZE", 10},
+	{"executor.workers", "EXECUTOR_WORKERS", 4},
+	{"executor.claimTimeout", "EXECUTOR_CLAIM_TIMEOUT", 300},
+
+	{"inventory.syncEnabled", "INVENTORY_SYNC_ENABLED", true},
+	{"inventory.syncInterval", "INVENTORY_SYNC_INTERVAL", 60},
+	{"inventory.batchSize", "INVENTORY_SYNC_BATCH_SIZE", 50},
+	{"inventory.workers", "INVENTORY_SYNC_WORKERS", 4},
+
+	{"auth.mode", "AUTH_MODE", "hmac"},
+	{"auth.hmacSecret", "AUTH_HMAC_SECRET", nil},
+	{"auth.publicKeyFile", "AUTH_PUBLIC_KEY_FILE", ""},
+	{"auth.jwksUrl", "AUTH_JWKS_URL", ""},
+	{"auth.jwksFile", "AUTH_JWKS_FILE", ""},
+	{"auth.jwksRefreshInterval", "AUTH_JWKS_REFRESH_INTERVAL", 900},
+	{"auth.issuer", "AUTH_ISSUER", ""},
+	{"auth.audience", "AUTH_AUDIENCE", ""},
+	{"auth.leewaySeconds", "AUTH_LEEWAY", 30},
+	{"auth.policyFile", "AUTH_POLICY_FILE", "config/auth-policy.yaml"},
+
+	// Health checks: critical, optional or off
+	{"health.timeout", "HEALTH_TIMEOUT", 2},
+	{"health.database", "HEALTH_DATABASE", "critical"},
+	{"health.migrations", "HEALTH_MIGRATIONS", "critical"},
+	{"health.imageManager", "HEALTH_IMAGE_MANAGER", "off"},
+	{"health.infraMonitor", "HEALTH_INFRA_MONITOR", "off"},
+	{"health.vmMonitor", "HEALTH_VM_MONITOR", "off"},
+}
+
+// clients are the downstream services, by their key under clients and the prefix of their
+// environment variables.
+var clients = []struct{ key, env string }{
+	{"imageManager", "IMAGE_MANAGER"},
+	{"infraMonitor", "INFRA_MONITOR"},
+	{"vmMonitor", "VM_MONITOR"},
+}
+
+func init() {
+	for _, c := range clients {
+		prefix := "clients." + c.key + "."
+		settings = append(settings,
+			setting{prefix + "mode", c.env + "_AUTH_MODE", "passthrough"},
+			setting{prefix + "token", c.env + "_TOKEN", nil},
+			setting{prefix + "tokenUrl", c.env + "_TOKEN_URL", ""},
+			setting{prefix + "clientId", c.env + "_CLIENT_ID", ""},
+			setting{prefix + "clientSecret", c.env + "_CLIENT_SECRET", nil},
+			// A comma-separated list.
+			setting{prefix + "scopes", c.env + "_SCOPES", []string{}},
+			setting{prefix + "connectTimeout", c.env + "_CONNECT_TIMEOUT", 2},
+			setting{prefix + "timeout", c.env + "_TIMEOUT", 10},
+			setting{prefix + "retries", c.env + "_RETRIES", 2},
+			setting{prefix + "breakerThreshold", c.env + "_BREAKER_THRESHOLD", 5},
+			setting{prefix + "breakerCooldown", c.env + "_BREAKER_COOLDOWN", 30},
+		)
+	}
+}
+
+// Load builds the application configuration from, in increasing precedence, the defaults
+// above, the profile's config file, the environment and the command-line flags in args.
+//
+// The profile (--profile or APP_PROFILE, default dev) selects the file
+// <config dir>/<profile>/vm/config.{yaml,yml,json}, where the config dir is --config-dir or
+// CONFIG_DIR, default config. A profile without a file is configured by the environment and
+// flags alone. Secrets can also be read from files; see readSecrets. Every invalid setting is
+// reported in the returned error, not just the first.
+func Load(args []string) (*configmanager.ApplicationConfig, error) {
+	src, err := newSource(args)
+	if err != nil {
+		return nil, err
+	}
+	return src.read()
+}
+
+// source is the layered configuration of one profile, read again whenever its file or a
+// secret file changes.
+type source struct {
+	profile string
+	viper   *viper.Viper
+	flags   *pflag.FlagSet
+	// file is the profile's config file, or nil when the profile has none.
+	file *configmanager.ConfigViper
+	// secretsDir holds a file per secret, named after its environment variable.
+	secretsDir string
+	locker     configmanager.ConfigLocker
+}
+
+func newSource(args []string) (*source, error) {
+	flags := pflag.NewFlagSet(serviceName, pflag.ContinueOnError)
+	profile := flags.String("profile", envOr("APP_PROFILE", devProfile), "configuration profile")
+	configDir := flags.String("config-dir", envOr("CONFIG_DIR", "config"), "directory holding the profile directories")
+	secretsDir := flags.String("secrets-dir", os.Getenv("SECRETS_DIR"), "directory holding a file per secret, such as a mounted Kubernetes secret")
+	for _, s := range settings {
+		flags.String(strings.ToLower(s.key), "", "overrides "+describe(s))
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	file, err := readProfile(*configDir, *profile)
+	if err != nil {
+		return nil, err
+	}
+	src := &source{profile: *profile, viper: viper.New(), flags: flags, file: file, secretsDir: *secretsDir}
+	if file != nil {
+		src.viper = file.GetViper()
+	}
+	for _, s := range settings {
+		if s.value != nil {
+			src.viper.SetDefault(s.key, s.value)
+		}
+		if s.env != "" {
+			if err := src.viper.BindEnv(s.key, s.env); err != nil {
+				return nil, err
+			}
+		}
+		if err := src.viper.BindPFlag(s.key, flags.Lookup(strings.ToLower(s.key))); err != nil {
+			return nil, err
+		}
+	}
+	return src, nil
+}
+
+// read unmarshals and validates the configuration as it stands.
+func (s *source) read() (*configmanager.ApplicationConfig, error) {
+	cfg := &configmanager.ApplicationConfig{}
+	if err := s.locker.UnmarshallViper(s.viper, cfg, serviceName); err != nil {
+		return nil, err
+	}
+	cfg.Application.Profile = s.profile
+	if cfg.Database.Port == 0 && cfg.Database.Driver != db.DriverSQLite {
+		cfg.Database.Port = db.DefaultPort(cfg.Database.Driver)
+	}
+
+	if err := errors.Join(s.readSecrets(cfg), validate(cfg)); err != nil {
+		return nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	return cfg, nil
+}
+
+// readProfile reads the config file of profile, or returns nil when it has none.
+func readProfile(dir, profile string) (*configmanager.ConfigViper, error) {
+	for _, ext := range []string{"yaml", "yml", "json"} {
+		_, err := os.Stat(filepath.Join(dir, profile, serviceName, "config."+ext))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		return configmanager.CreateViperInstance("config", ext, profile, serviceName, dir)
+	}
+	return nil, nil
+}
+
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// describe names a setting the way an operator sets it, for flag usage and error messages.
+func describe(s setting) string {
+	if s.env == "" {
+		return s.key
+	}
+	return s.key + " (" + s.env + ")"
+}
+
+// describeKey is describe for the setting with key.
+func describeKey(key string) string {
+	for _, s := range settings {
+		if s.key == key {
+			return describe(s)
+		}
+	}
+	return key
+}
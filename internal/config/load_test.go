@@ -0,0 +1,179 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vm/internal/config"
+)
+
+// writeProfile writes a config file for profile under dir and returns dir.
+func writeProfile(t *testing.T, dir, profile, name, content string) string {
+	path := filepath.Join(dir, profile, "vm")
+	require.NoError(t, os.MkdirAll(path, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(path, name), []byte(content), 0o600))
+	return dir
+}
+
+// secrets sets the settings that have no default.
+func secrets(t *testing.T) {
+	t.Setenv("DB_USER", "vm")
+	t.Setenv("DB_PASS", "secret")
+	t.Setenv("AUTH_HMAC_SECRET", "hmac-secret")
+	t.Setenv("EXECUTOR_BACKEND", "fake")
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("Flags override env, which overrides the profile file", func(t *testing.T) {
+		dir := writeProfile(t, t.TempDir(), "staging", "config.yaml", `
+app:
+  port: "9000"
+database:
+  host: file-host
+  port: 3307
+  replicas: [file-replica]
+executor:
+  enabled: false
+  workers: 8
+`)
+		secrets(t)
+		t.Setenv("CONFIG_DIR", dir)
+		t.Setenv("DB_HOST", "env-host")
+		t.Setenv("DB_REPLICAS", "replica-a,replica-b:3307")
+
+		cfg, err := config.Load([]string{"--profile", "staging", "--app.port", "9100"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "staging", cfg.Application.Profile)
+		assert.Equal(t, "9100", cfg.Application.Port)
+		assert.Equal(t, "env-host", cfg.Database.Host)
+		assert.Equal(t, 3307, cfg.Database.Port)
+		assert.Equal(t, []string{"replica-a", "replica-b:3307"}, cfg.Database.Replicas)
+		assert.Equal(t, 8, cfg.Executor.Workers)
+		assert.Equal(t, 100, cfg.Database.MaxOpenConnection)
+		assert.Equal(t, "secret", string(cfg.Database.Password))
+	})
+
+	t.Run("Profile file can be JSON", func(t *testing.T) {
+		dir := writeProfile(t, t.TempDir(), "dev", "config.json", `{"database": {"driver": "postgres", "dbName": "vms"}}`)
+		secrets(t)
+		t.Setenv("CONFIG_DIR", dir)
+
+		cfg, err := config.Load(nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "vms", cfg.Database.DBName)
+		assert.Equal(t, 5432, cfg.Database.Port)
+	})
+
+	t.Run("Profile without a file uses env and defaults", func(t *testing.T) {
+		secrets(t)
+		t.Setenv("CONFIG_DIR", t.TempDir())
+		t.Setenv("EXECUTOR_ENABLED", "false")
+
+		cfg, err := config.Load(nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "dev", cfg.Application.Profile)
+		assert.Equal(t, "localhost", cfg.Database.Host)
+		assert.Equal(t, 3306, cfg.Database.Port)
+		assert.False(t, cfg.Executor.Enabled)
+		assert.Equal(t, "critical", cfg.Health.Database)
+	})
+
+	t.Run("Secrets have no defaults", func(t *testing.T) {
+		t.Setenv("CONFIG_DIR", t.TempDir())
+
+		_, err := config.Load(nil)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "database.username (DB_USER): is required")
+		assert.Contains(t, err.Error(), "database.password (DB_PASS): is required")
+		assert.Contains(t, err.Error(), "auth.hmacSecret (AUTH_HMAC_SECRET): is required")
+		assert.Contains(t, err.Error(), "executor.backend (EXECUTOR_BACKEND): is required")
+	})
+
+	t.Run("Fake executor backend is refused outside the dev profile", func(t *testing.T) {
+		secrets(t)
+		t.Setenv("CONFIG_DIR", t.TempDir())
+
+		_, err := config.Load([]string{"--profile", "prod"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `executor.backend (EXECUTOR_BACKEND): "fake" is only allowed in the dev profile, got profile "prod"`)
+	})
+
+	t.Run("Every invalid setting is reported", func(t *testing.T) {
+		secrets(t)
+		t.Setenv("CONFIG_DIR", t.TempDir())
+		t.Setenv("APP_PORT", "http")
+		t.Setenv("DB_DRIVER", "oracle")
+		t.Setenv("AUTH_MODE", "basic")
+		t.Setenv("HEALTH_VM_MONITOR", "sometimes")
+
+		_, err := config.Load([]string{"--executor.workers", "-1"})
+
+		require.Error(t, err)
+		for _, want := range []string{
+			`app.port (APP_PORT): must be a port number, got "http"`,
+			`database.driver (DB_DRIVER): must be mysql, postgres or sqlite, got "oracle"`,
+			`auth.mode (AUTH_MODE): must be hmac, rsa, ecdsa or jwks, got "basic"`,
+			`health.vmMonitor (HEALTH_VM_MONITOR): unknown health check criticality "sometimes"`,
+			`executor.workers (EXECUTOR_WORKERS): must not be negative, got -1`,
+		} {
+			assert.Contains(t, err.Error(), want)
+		}
+	})
+
+	t.Run("Clients authenticate per service", func(t *testing.T) {
+		secrets(t)
+		t.Setenv("CONFIG_DIR", t.TempDir())
+		t.Setenv("VM_MONITOR_AUTH_MODE", "oauth2")
+		t.Setenv("VM_MONITOR_TOKEN_URL", "https://idp.example/token")
+		t.Setenv("VM_MONITOR_CLIENT_ID", "vm")
+		t.Setenv("VM_MONITOR_CLIENT_SECRET", "client-secret")
+		t.Setenv("VM_MONITOR_SCOPES", "vm.read,vm.write")
+		t.Setenv("IMAGE_MANAGER_AUTH_MODE", "static")
+		t.Setenv("IMAGE_MANAGER_TOKEN", "image-token")
+
+		cfg, err := config.Load(nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "passthrough", cfg.Clients.InfraMonitor.Mode)
+		assert.Equal(t, "image-token", string(cfg.Clients.ImageManager.Token))
+		assert.Equal(t, "oauth2", cfg.Clients.VmMonitor.Mode)
+		assert.Equal(t, "client-secret", string(cfg.Clients.VmMonitor.ClientSecret))
+		assert.Equal(t, []string{"vm.read", "vm.write"}, cfg.Clients.VmMonitor.Scopes)
+	})
+
+	t.Run("Incomplete client authentication is reported", func(t *testing.T) {
+		secrets(t)
+		t.Setenv("CONFIG_DIR", t.TempDir())
+		t.Setenv("VM_MONITOR_AUTH_MODE", "oauth2")
+		t.Setenv("IMAGE_MANAGER_AUTH_MODE", "static")
+		t.Setenv("INFRA_MONITOR_AUTH_MODE", "mtls")
+
+		_, err := config.Load(nil)
+
+		require.Error(t, err)
+		for _, want := range []string{
+			"clients.vmMonitor.clientId (VM_MONITOR_CLIENT_ID): is required",
+			"clients.vmMonitor.clientSecret (VM_MONITOR_CLIENT_SECRET): is required",
+			`clients.vmMonitor.tokenUrl (VM_MONITOR_TOKEN_URL): must be an http or https URL, got ""`,
+			"clients.imageManager.token (IMAGE_MANAGER_TOKEN): is required",
+			`clients.infraMonitor.mode (INFRA_MONITOR_AUTH_MODE): must be passthrough, static or oauth2, got "mtls"`,
+		} {
+			assert.Contains(t, err.Error(), want)
+		}
+	})
+
+	t.Run("Unknown flags are rejected", func(t *testing.T) {
+		_, err := config.Load([]string{"--databse.host", "db"})
+
+		assert.Error(t, err)
+	})
+}
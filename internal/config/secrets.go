@@ -0,0 +1,104 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	configmanager "vm/pkg/config-manager"
+)
+
+var secretType = reflect.TypeOf(configmanager.Secret(""))
+
+// secretSettings returns the settings whose field is a configmanager.Secret.
+func secretSettings() []setting {
+	var secrets []setting
+	for _, s := range settings {
+		if s.env != "" && field(&configmanager.ApplicationConfig{}, s.key).Type() == secretType {
+			secrets = append(secrets, s)
+		}
+	}
+	return secrets
+}
+
+// readSecrets sets the secrets that are kept in files. A secret named by a flag or by its
+// environment variable, say DB_PASS, keeps that value. Otherwise it is read from the file named
+// by DB_PASS_FILE, or else from the file DB_PASS in the secrets directory, the layout of a
+// mounted Kubernetes secret. A secret found in none of them keeps the profile file's value.
+func (s *source) readSecrets(cfg *configmanager.ApplicationConfig) error {
+	var errs []error
+	for _, secret := range secretSettings() {
+		if s.flags.Changed(strings.ToLower(secret.key)) {
+			continue
+		}
+		path := os.Getenv(secret.env + "_FILE")
+		if os.Getenv(secret.env) != "" {
+			if path != "" {
+				errs = append(errs, fmt.Errorf("%s: set only one of %s and %s_FILE", describe(secret), secret.env, secret.env))
+			}
+			continue
+		}
+		if path == "" && s.secretsDir != "" {
+			candidate := filepath.Join(s.secretsDir, secret.env)
+			if _, err := os.Stat(candidate); err == nil {
+				path = candidate
+			}
+		}
+		if path == "" {
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", describe(secret), err))
+			continue
+		}
+		value := strings.TrimRight(string(data), "\r\n")
+		field(cfg, secret.key).SetString(value)
+	}
+	return errors.Join(errs...)
+}
+
+// secretDirs returns the directories holding secret files, which are watched for rotation.
+// Directories rather than files are watched, because a mounted secret is rotated by swapping
+// a symlink next to its files.
+func (s *source) secretDirs() []string {
+	seen := map[string]bool{}
+	var dirs []string
+	add := func(dir string) {
+		if !seen[dir] {
+			seen[dir] = true
+			dirs = append(dirs, dir)
+		}
+	}
+	if s.secretsDir != "" {
+		add(s.secretsDir)
+	}
+	for _, secret := range secretSettings() {
+		if path := os.Getenv(secret.env + "_FILE"); path != "" {
+			add(filepath.Dir(path))
+		}
+	}
+	return dirs
+}
+
+// field returns the field of cfg that key names by its mapstructure tags.
+func field(cfg *configmanager.ApplicationConfig, key string) reflect.Value {
+	value := reflect.ValueOf(cfg).Elem()
+	for _, name := range strings.Split(key, ".") {
+		found := false
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).Tag.Get("mapstructure") == name {
+				value, found = value.Field(i), true
+				break
+			}
+		}
+		if !found {
+			panic("config: no field for key " + key)
+		}
+	}
+	return value
+}
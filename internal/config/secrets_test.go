@@ -0,0 +1,84 @@
+package config_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vm/internal/config"
+)
+
+func writeSecret(t *testing.T, dir, name, value string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(value), 0o600))
+	return path
+}
+
+func TestLoadSecrets(t *testing.T) {
+	t.Run("Secrets are read from _FILE variables and the secrets directory", func(t *testing.T) {
+		dir := t.TempDir()
+		t.Setenv("CONFIG_DIR", t.TempDir())
+		t.Setenv("DB_USER", "vm")
+		t.Setenv("EXECUTOR_BACKEND", "fake")
+		t.Setenv("DB_PASS_FILE", writeSecret(t, t.TempDir(), "password", "from-file\n"))
+		writeSecret(t, dir, "DB_PASS", "from-dir")
+		writeSecret(t, dir, "AUTH_HMAC_SECRET", "hmac-from-dir\r\n")
+
+		cfg, err := config.Load([]string{"--secrets-dir", dir})
+
+		require.NoError(t, err)
+		assert.Equal(t, "from-file", string(cfg.Database.Password))
+		assert.Equal(t, "hmac-from-dir", string(cfg.Auth.HMACSecret))
+	})
+
+	t.Run("Env and flags take precedence over secret files", func(t *testing.T) {
+		dir := t.TempDir()
+		t.Setenv("CONFIG_DIR", t.TempDir())
+		t.Setenv("SECRETS_DIR", dir)
+		t.Setenv("DB_USER", "vm")
+		t.Setenv("EXECUTOR_BACKEND", "fake")
+		t.Setenv("DB_PASS", "from-env")
+		writeSecret(t, dir, "DB_PASS", "from-dir")
+		writeSecret(t, dir, "AUTH_HMAC_SECRET", "hmac-from-dir")
+
+		cfg, err := config.Load([]string{"--auth.hmacsecret", "hmac-from-flag"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "from-env", string(cfg.Database.Password))
+		assert.Equal(t, "hmac-from-flag", string(cfg.Auth.HMACSecret))
+	})
+
+	t.Run("Secret set twice or in a missing file is reported", func(t *testing.T) {
+		t.Setenv("CONFIG_DIR", t.TempDir())
+		t.Setenv("DB_USER", "vm")
+		t.Setenv("DB_PASS", "from-env")
+		t.Setenv("DB_PASS_FILE", writeSecret(t, t.TempDir(), "password", "from-file"))
+		t.Setenv("AUTH_HMAC_SECRET_FILE", filepath.Join(t.TempDir(), "missing"))
+
+		_, err := config.Load(nil)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "database.password (DB_PASS): set only one of DB_PASS and DB_PASS_FILE")
+		assert.Contains(t, err.Error(), "auth.hmacSecret (AUTH_HMAC_SECRET): open")
+	})
+
+	t.Run("Secrets are redacted when the configuration is printed", func(t *testing.T) {
+		secrets(t)
+		t.Setenv("CONFIG_DIR", t.TempDir())
+
+		cfg, err := config.Load(nil)
+		require.NoError(t, err)
+		dumped, err := json.Marshal(cfg)
+		require.NoError(t, err)
+
+		for _, printed := range []string{fmt.Sprintf("%v", cfg), fmt.Sprintf("%+v", *cfg), fmt.Sprintf("%#v", *cfg), string(dumped)} {
+			assert.NotContains(t, printed, "secret")
+			assert.Contains(t, printed, "[REDACTED]")
+		}
+	})
+}
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"vm/internal/client"
+	"vm/internal/executor"
+	"vm/pkg/auth"
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/db"
+	"vm/pkg/health"
+	"vm/pkg/logger"
+)
+
+// validate checks cfg and returns every problem it finds joined into one error.
+func validate(cfg *configmanager.ApplicationConfig) error {
+	var errs []error
+	fail := func(key, format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%s: %s", describeKey(key), fmt.Sprintf(format, args...)))
+	}
+	required := func(key, value string) {
+		if value == "" {
+			fail(key, "is required")
+		}
+	}
+	notNegative := func(key string, value int) {
+		if value < 0 {
+			fail(key, "must not be negative, got %d", value)
+		}
+	}
+	atLeastOne := func(key string, value int) {
+		if value < 1 {
+			fail(key, "must be at least 1, got %d", value)
+		}
+	}
+
+	if port, err := strconv.Atoi(cfg.Application.Port); err != nil || port < 1 || port > 65535 {
+		fail("app.port", "must be a port number, got %q", cfg.Application.Port)
+	}
+
+	database := cfg.Database
+	switch database.Driver {
+	case db.DriverMySQL, db.DriverPostgres:
+		required("database.host", database.Host)
+		required("database.username", database.Username)
+		required("database.password", string(database.Password))
+		if database.Port < 1 || database.Port > 65535 {
+			fail("database.port", "must be a port number, got %d", database.Port)
+		}
+	case db.DriverSQLite:
+		if len(database.Replicas) > 0 {
+			fail("database.replicas", "are not supported by %s", db.DriverSQLite)
+		}
+	default:
+		fail("database.driver", "must be %s, %s or %s, got %q", db.DriverMySQL, db.DriverPostgres, db.DriverSQLite, database.Driver)
+	}
+	required("database.dbName", database.DBName)
+	atLeastOne("database.maxOpenConnection", database.MaxOpenConnection)
+	notNegative("database.maxIdleConnection", database.MaxIdleConnection)
+	notNegative("database.MaxConnectionLifeTime", database.MaxConnectionLifeTime)
+	notNegative("database.replicaMaxLag", database.ReplicaMaxLag)
+	notNegative("database.replicaCheckInterval", database.ReplicaCheckInterval)
+	notNegative("database.healthCheckInterval", database.HealthCheckInterval)
+	notNegative("database.reconnectMaxBackoff", database.ReconnectMaxBackoff)
+	notNegative("database.healthCheckFailures", database.HealthCheckFailures)
+
+	if !logger.ValidLevel(cfg.Log.Level) {
+		fail("log.Level", "unknown log level %q", cfg.Log.Level)
+	}
+
+	if cfg.Executor.Enabled {
+		required("executor.backend", cfg.Executor.Backend)
+		// The fake backend only pretends to run requests.
+		if cfg.Executor.Backend == executor.BackendFake && cfg.Application.Profile != devProfile {
+			fail("executor.backend", "%q is only allowed in the %s profile, got profile %q", executor.BackendFake, devProfile, cfg.Application.Profile)
+		}
+	}
+	notNegative("executor.pollInterval", cfg.Executor.PollInterval)
+	notNegative("executor.batchSize", cfg.Executor.BatchSize)
+	notNegative("executor.workers", cfg.Executor.Workers)
+	notNegative("executor.claimTimeout", cfg.Executor.ClaimTimeout)
+	notNegative("inventory.syncInterval", cfg.Inventory.SyncInterval)
+	notNegative("inventory.batchSize", cfg.Inventory.BatchSize)
+	notNegative("inventory.workers", cfg.Inventory.Workers)
+
+	authCfg := cfg.Auth
+	switch authCfg.Mode {
+	case auth.ModeHMAC:
+		required("auth.hmacSecret", string(authCfg.HMACSecret))
+	case auth.ModeRSA, auth.ModeECDSA:
+		required("auth.publicKeyFile", authCfg.PublicKeyFile)
+	case auth.ModeJWKS:
+		if (authCfg.JWKSURL == "") == (authCfg.JWKSFile == "") {
+			fail("auth.jwksUrl", "exactly one of it and %s is required", describeKey("auth.jwksFile"))
+		}
+	default:
+		fail("auth.mode", "must be %s, %s, %s or %s, got %q", auth.ModeHMAC, auth.ModeRSA, auth.ModeECDSA, auth.ModeJWKS, authCfg.Mode)
+	}
+	required("auth.policyFile", authCfg.PolicyFile)
+	notNegative("auth.leewaySeconds", authCfg.LeewaySeconds)
+
+	notNegative("health.timeout", cfg.Health.Timeout)
+	for key, value := range map[string]string{
+		"health.database":     cfg.Health.Database,
+		"health.migrations":   cfg.Health.Migrations,
+		"health.imageManager": cfg.Health.ImageManager,
+		"health.infraMonitor": cfg.Health.InfraMonitor,
+		"health.vmMonitor":    cfg.Health.VmMonitor,
+	} {
+		if _, err := health.ParseCriticality(value, health.Off); err != nil {
+			fail(key, "%v", err)
+		}
+	}
+
+	for _, c := range clients {
+		prefix := "clients." + c.key + "."
+		clientCfg := field(cfg, "clients."+c.key).Interface().(configmanager.Client)
+		switch clientCfg.Mode {
+		case client.ModePassthrough:
+		case client.ModeStatic:
+			required(prefix+"token", string(clientCfg.Token))
+		case client.ModeOAuth2:
+			required(prefix+"clientId", clientCfg.ClientID)
+			required(prefix+"clientSecret", string(clientCfg.ClientSecret))
+			if u, err := url.Parse(clientCfg.TokenURL); err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+				fail(prefix+"tokenUrl", "must be an http or https URL, got %q", clientCfg.TokenURL)
+			}
+		default:
+			fail(prefix+"mode", "must be %s, %s or %s, got %q", client.ModePassthrough, client.ModeStatic, client.ModeOAuth2, clientCfg.Mode)
+		}
+		atLeastOne(prefix+"connectTimeout", clientCfg.ConnectTimeout)
+		atLeastOne(prefix+"timeout", clientCfg.Timeout)
+		notNegative(prefix+"retries", clientCfg.Retries)
+		notNegative(prefix+"breakerThreshold", clientCfg.BreakerThreshold)
+		if clientCfg.BreakerThreshold > 0 {
+			atLeastOne(prefix+"breakerCooldown", clientCfg.BreakerCooldown)
+		}
+	}
+
+	return errors.Join(errs...)
+}
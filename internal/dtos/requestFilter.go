@@ -0,0 +1,15 @@
+package dto
+
+import "time"
+
+// VMRequestFilter selects one page of VM requests. Zero-valued fields do not filter.
+type VMRequestFilter struct {
+	Operation     string
+	Status        string
+	WorkspaceID   string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Ascending     bool
+	Limit         int
+	Offset        int
+}
@@ -0,0 +1,16 @@
+package dto
+
+import api "vm/internal/gen"
+
+// VMTargetMetadata is the request metadata stored for operations on an existing VM.
+type VMTargetMetadata struct {
+	VMID string `json:"VMID"`
+	// Force lets a delete power the VM off first instead of requiring it to be off.
+	Force bool `json:"Force,omitempty"`
+}
+
+// EditVMMetadata is the request metadata stored for a reconfigure request.
+type EditVMMetadata struct {
+	VMID string      `json:"VMID"`
+	Spec *api.EditVM `json:"spec"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+import "vm/internal/modals"
+
+// VMRecord is what is known locally about a VM deployed by this service.
+type VMRecord struct {
+	// Instance is the deploy instance that produced the VM.
+	Instance *modals.VMDeployInstance
+	// Requests are the later requests that targeted the VM, newest first.
+	Requests []*modals.VMRequest
+}
@@ -0,0 +1,31 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+
+	api "vm/internal/gen"
+)
+
+// Backend performs VM operations against a hypervisor.
+type Backend interface {
+	DeployVM(ctx context.Context, spec *api.HCIDeployVM, vmName string) (string, error)
+	PowerOn(ctx context.Context, vmID string) error
+	PowerOff(ctx context.Context, vmID string) error
+	Reset(ctx context.Context, vmID string) error
+	Refresh(ctx context.Context, vmID string) error
+	RestartGuestOS(ctx context.Context, vmID string) error
+	ShutdownGuestOS(ctx context.Context, vmID string) error
+	Reconfigure(ctx context.Context, vmID string, spec *api.EditVM) error
+	DeleteVM(ctx context.Context, vmID string) error
+}
+
+// NewBackend returns the backend registered under name.
+func NewBackend(name string) (Backend, error) {
+	switch name {
+	case BackendFake:
+		return NewFakeBackend(), nil
+	default:
+		return nil, fmt.Errorf("unknown executor backend %q", name)
+	}
+}
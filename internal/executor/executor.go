@@ -0,0 +1,502 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	dto "vm/internal/dtos"
+	api "vm/internal/gen"
+	"vm/internal/modals"
+	"vm/internal/repo"
+	"vm/pkg/cinterface"
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/constants"
+	"vm/pkg/db"
+	"vm/pkg/utils"
+)
+
+const (
+	defaultPollInterval = 5 * time.Second
+	defaultBatchSize    = 10
+	defaultWorkers      = 4
+	defaultClaimTimeout = 5 * time.Minute
+)
+
+// Executor claims New VM requests and drives them to a terminal state.
+type Executor interface {
+	Start(ctx context.Context)
+	Stop()
+	RunOnce(ctx context.Context) int
+	ReapExpiredClaims(ctx context.Context) int
+}
+
+// errCancelled is returned by an operation that stopped because the request was cancelled.
+var errCancelled = errors.New("request cancelled")
+
+type operationFunc func(ctx context.Context, req *modals.VMRequest) error
+
+// inventoryFunc records the effect of a successful operation on vmID in the VM inventory.
+type inventoryFunc func(ctx context.Context, vmID string) *dto.ApiResponseError
+
+// executor implements the Executor interface.
+type executor struct {
+	vmRepo   repo.VMRepository
+	backend  Backend
+	logger   cinterface.Logger
+	interval time.Duration
+	batch    int
+	workers  int
+	handlers map[constants.OperationType]operationFunc
+
+	// owner identifies this executor on the requests it claims, and claimTimeout is how long
+	// a claim lasts unless renewed.
+	owner        string
+	claimTimeout time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewExecutor creates a new Executor.
+func NewExecutor(vmRepo repo.VMRepository, backend Backend, cfg configmanager.Executor, logger cinterface.Logger) Executor {
+	e := &executor{
+		vmRepo:   vmRepo,
+		backend:  backend,
+		logger:   logger,
+		interval: time.Duration(cfg.PollInterval) * time.Second,
+		batch:    cfg.BatchSize,
+		workers:  cfg.Workers,
+
+		owner:        claimOwner(),
+		claimTimeout: time.Duration(cfg.ClaimTimeout) * time.Second,
+	}
+	if e.interval <= 0 {
+		e.interval = defaultPollInterval
+	}
+	if e.batch <= 0 {
+		e.batch = defaultBatchSize
+	}
+	if e.workers <= 0 {
+		e.workers = defaultWorkers
+	}
+	if e.claimTimeout <= 0 {
+		e.claimTimeout = defaultClaimTimeout
+	}
+
+	e.handlers = map[constants.OperationType]operationFunc{
+		constants.VMDeploy:          e.deploy,
+		constants.VMPowerOn:         e.onVM(backend.PowerOn, e.powerState(PowerStateOn)),
+		constants.VMPowerOff:        e.onVM(backend.PowerOff, e.powerState(PowerStateOff)),
+		constants.VMReset:           e.onVM(backend.Reset, e.powerState(PowerStateOn)),
+		constants.VMRefresh:         e.onVM(backend.Refresh, nil),
+		constants.VMRestartGuestOS:  e.onVM(backend.RestartGuestOS, e.powerState(PowerStateOn)),
+		constants.VMShutdownGuestOS: e.onVM(backend.ShutdownGuestOS, e.powerState(PowerStateOff)),
+		constants.VMDelete:          e.delete,
+		constants.VMReconfigure:     e.reconfigure,
+	}
+	return e
+}
+
+// Start polls for New requests in the background until Stop is called.
+func (e *executor) Start(ctx context.Context) {
+	ctx, e.cancel = context.WithCancel(ctx)
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		e.logger.Info(constants.Internal, constants.Executor, "Request executor started", map[constants.ExtraKey]interface{}{
+			"interval": e.interval.String(),
+			"batch":    e.batch,
+			"workers":  e.workers,
+			"owner":    e.owner,
+		})
+
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+		for {
+			e.ReapExpiredClaims(ctx)
+			// A full batch usually means more work is queued, so poll again right away.
+			for e.RunOnce(ctx) == e.batch && ctx.Err() == nil {
+			}
+			select {
+			case <-ctx.Done():
+				e.logger.Info(constants.Internal, constants.Executor, "Request executor stopped", nil)
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops polling and waits for in-flight requests to finish.
+func (e *executor) Stop() {
+	if e.cancel != nil {
+		e.cancel()
+	}
+	e.wg.Wait()
+}
+
+// RunOnce claims one batch of New requests, executes them and returns how many were claimed.
+func (e *executor) RunOnce(ctx context.Context) int {
+	if ctx.Err() != nil {
+		return 0
+	}
+	requests, err := e.vmRepo.ClaimNewVMRequests(ctx, e.owner, e.batch)
+	if err != nil {
+		e.logger.Error(constants.Internal, constants.Executor, "Failed to claim VM requests", map[constants.ExtraKey]interface{}{
+			"error": err.Message,
+		})
+	}
+	if len(requests) == 0 {
+		return 0
+	}
+
+	// Claimed requests are always run to completion so none is left Inprogress on shutdown.
+	runCtx := context.WithoutCancel(ctx)
+	stopRenewing := e.renewClaims(runCtx, requests)
+	defer stopRenewing()
+	sem := make(chan struct{}, e.workers)
+	var wg sync.WaitGroup
+	for _, req := range requests {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(req *modals.VMRequest) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			e.execute(runCtx, req)
+		}(req)
+	}
+	wg.Wait()
+	return len(requests)
+}
+
+// renewClaims renews the claims on requests in the background, so other executors do not
+// take them for abandoned, until the returned function is called.
+func (e *executor) renewClaims(ctx context.Context, requests []*modals.VMRequest) func() {
+	ids := make([]string, len(requests))
+	for i, req := range requests {
+		ids[i] = req.RequestID
+	}
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(e.claimTimeout / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			if err := e.vmRepo.RenewVMRequestClaims(ctx, e.owner, ids); err != nil {
+				e.logger.Warn(constants.Internal, constants.Executor, "Failed to renew VM request claims", map[constants.ExtraKey]interface{}{
+					"owner": e.owner,
+					"error": err.Message,
+				})
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// ReapExpiredClaims fails Inprogress requests whose executor stopped renewing its claim,
+// which releases the VMs they locked, and returns how many were failed. A failed request
+// can be retried.
+func (e *executor) ReapExpiredClaims(ctx context.Context) int {
+	if ctx.Err() != nil {
+		return 0
+	}
+	requests, err := e.vmRepo.FailExpiredVMRequestClaims(ctx, time.Now().UTC().Add(-e.claimTimeout), e.batch)
+	if err != nil {
+		e.logger.Error(constants.Internal, constants.Executor, "Failed to fail expired VM request claims", map[constants.ExtraKey]interface{}{
+			"error": err.Message,
+		})
+	}
+	for _, req := range requests {
+		e.logger.Warn(constants.Internal, constants.Executor, "VM request failed after its claim expired", map[constants.ExtraKey]interface{}{
+			"requestID": req.RequestID,
+			"operation": req.Operation,
+			"claimedBy": req.ClaimedBy,
+		})
+	}
+	return len(requests)
+}
+
+func (e *executor) execute(ctx context.Context, req *modals.VMRequest) {
+	// Repository calls are scoped to the workspace that owns the request, and read from the
+	// primary: the request may have been created a moment ago, and the executor reads back
+	// the instances and VMs it updates.
+	ctx = db.WithPrimary(context.WithValue(ctx, utils.WorkspaceIDKey, req.WorkspaceId))
+	e.logger.Info(constants.Internal, constants.Executor, "Executing VM request", map[constants.ExtraKey]interface{}{
+		"requestID": req.RequestID,
+		"operation": req.Operation,
+	})
+
+	status := constants.StatusSuccess
+	handler, ok := e.handlers[constants.OperationType(req.Operation)]
+	if !ok {
+		status = constants.StatusFailure
+		e.logger.Error(constants.Internal, constants.Executor, "Unsupported VM request operation", map[constants.ExtraKey]interface{}{
+			"requestID": req.RequestID,
+			"operation": req.Operation,
+		})
+	} else if err := e.run(ctx, handler, req); errors.Is(err, errCancelled) {
+		status = constants.StatusCancelled
+		e.logger.Info(constants.Internal, constants.Executor, "VM request cancelled", map[constants.ExtraKey]interface{}{
+			"requestID": req.RequestID,
+			"operation": req.Operation,
+		})
+	} else if err != nil {
+		status = constants.StatusFailure
+		e.logger.Error(constants.Internal, constants.Executor, "VM request failed", map[constants.ExtraKey]interface{}{
+			"requestID": req.RequestID,
+			"operation": req.Operation,
+			"error":     err.Error(),
+		})
+	}
+
+	completedAt := time.Now().UTC()
+	if status == constants.StatusCancelled {
+		if err := e.vmRepo.CancelPendingVMDeployInstances(ctx, req.RequestID, completedAt); err != nil {
+			e.logger.Error(constants.Internal, constants.Executor, "Failed to cancel pending deploy instances", map[constants.ExtraKey]interface{}{
+				"requestID": req.RequestID,
+				"error":     err.Message,
+			})
+		}
+	}
+	if err := e.vmRepo.UpdateVMRequestStatus(ctx, req.RequestID, status, &completedAt); err != nil {
+		e.logger.Error(constants.Internal, constants.Executor, "Failed to update VM request status", map[constants.ExtraKey]interface{}{
+			"requestID": req.RequestID,
+			"status":    status,
+			"error":     err.Message,
+		})
+		return
+	}
+	req.RequestStatus = string(status)
+	req.CompletedAt = &completedAt
+
+	e.logger.Info(constants.Internal, constants.Executor, "VM request completed", map[constants.ExtraKey]interface{}{
+		"requestID": req.RequestID,
+		"status":    status,
+	})
+}
+
+// run calls handler and turns a backend panic into a request failure.
+func (e *executor) run(ctx context.Context, handler operationFunc, req *modals.VMRequest) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("panic: %v", rec)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// checkCancelled returns errCancelled once a cancel has been requested for req.
+// A failed lookup is logged and treated as not cancelled so the request keeps going.
+func (e *executor) checkCancelled(ctx context.Context, req *modals.VMRequest) error {
+	cancelled, apiErr := e.vmRepo.IsVMRequestCancelRequested(ctx, req.RequestID)
+	if apiErr != nil {
+		e.logger.Warn(constants.Internal, constants.Executor, "Failed to check VM request cancellation", map[constants.ExtraKey]interface{}{
+			"requestID": req.RequestID,
+			"error":     apiErr.Message,
+		})
+		return nil
+	}
+	if cancelled {
+		return errCancelled
+	}
+	return nil
+}
+
+func (e *executor) deploy(ctx context.Context, req *modals.VMRequest) error {
+	var spec api.HCIDeployVM
+	if err := json.Unmarshal([]byte(req.RequestMetadata), &spec); err != nil {
+		return fmt.Errorf("invalid deploy metadata: %w", err)
+	}
+
+	instances, apiErr := e.vmRepo.GetVMDeployInstances(ctx, req.RequestID)
+	if apiErr != nil {
+		return errors.New(apiErr.Message)
+	}
+	if len(instances) == 0 {
+		return errors.New("no deploy instances recorded for request")
+	}
+
+	failed := 0
+	for _, inst := range instances {
+		// Instances not started yet are marked Cancelled by execute.
+		if err := e.checkCancelled(ctx, req); err != nil {
+			return err
+		}
+		inst.VMStatus = string(constants.StatusInProgress)
+		if apiErr := e.vmRepo.UpdateVMDeployInstance(ctx, inst); apiErr != nil {
+			return errors.New(apiErr.Message)
+		}
+
+		vmID, err := e.backend.DeployVM(ctx, &spec, inst.VMName)
+		completedAt := time.Now().UTC()
+		inst.CompletedAt = &completedAt
+		if err != nil {
+			failed++
+			inst.VMStatus = string(constants.StatusFailure)
+			inst.VMStateMessage = err.Error()
+		} else {
+			inst.VMID = vmID
+			inst.VMStatus = string(constants.StatusSuccess)
+			inst.VMStateMessage = ""
+		}
+		if apiErr := e.vmRepo.UpdateVMDeployInstance(ctx, inst); apiErr != nil {
+			return errors.New(apiErr.Message)
+		}
+		if err == nil {
+			e.recordInventory(ctx, req, vmID, func(ctx context.Context, vmID string) *dto.ApiResponseError {
+				return e.vmRepo.UpsertVirtualMachine(ctx, &modals.VirtualMachine{
+					VMID:       vmID,
+					VMName:     inst.VMName,
+					RequestID:  req.RequestID,
+					State:      string(constants.VMStateActive),
+					PowerState: deployPowerState(&spec),
+				})
+			})
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d VM deployments failed", failed, len(instances))
+	}
+	return nil
+}
+
+func (e *executor) reconfigure(ctx context.Context, req *modals.VMRequest) error {
+	var metadata dto.EditVMMetadata
+	if err := json.Unmarshal([]byte(req.RequestMetadata), &metadata); err != nil {
+		return fmt.Errorf("invalid reconfigure metadata: %w", err)
+	}
+	if metadata.VMID == "" {
+		return errors.New("request metadata has no VM id")
+	}
+	if metadata.Spec == nil {
+		return errors.New("request metadata has no reconfigure spec")
+	}
+	if err := e.checkCancelled(ctx, req); err != nil {
+		return err
+	}
+	return e.backend.Reconfigure(ctx, metadata.VMID, metadata.Spec)
+}
+
+// onVM adapts a single-VM backend call to an operationFunc. record, when set, updates the
+// inventory after the call succeeds.
+func (e *executor) onVM(call func(ctx context.Context, vmID string) error, record inventoryFunc) operationFunc {
+	return func(ctx context.Context, req *modals.VMRequest) error {
+		vmID, err := targetVMID(req)
+		if err != nil {
+			return err
+		}
+		if err := e.checkCancelled(ctx, req); err != nil {
+			return err
+		}
+		if err := call(ctx, vmID); err != nil {
+			return err
+		}
+		if record != nil {
+			e.recordInventory(ctx, req, vmID, record)
+		}
+		return nil
+	}
+}
+
+// recordInventory applies record to vmID. The operation itself already succeeded, so a
+// failed inventory write is only logged and left for the reconciler to repair.
+func (e *executor) recordInventory(ctx context.Context, req *modals.VMRequest, vmID string, record inventoryFunc) {
+	if apiErr := record(ctx, vmID); apiErr != nil {
+		e.logger.Warn(constants.Internal, constants.Executor, "Failed to update VM inventory", map[constants.ExtraKey]interface{}{
+			"requestID": req.RequestID,
+			"vmID":      vmID,
+			"error":     apiErr.Message,
+		})
+	}
+}
+
+// powerState returns an inventoryFunc that records the power state an operation leaves a VM in.
+func (e *executor) powerState(state string) inventoryFunc {
+	return func(ctx context.Context, vmID string) *dto.ApiResponseError {
+		return e.vmRepo.UpdateVirtualMachinePowerState(ctx, vmID, state)
+	}
+}
+
+func (e *executor) markDeleted(ctx context.Context, vmID string) *dto.ApiResponseError {
+	return e.vmRepo.MarkVirtualMachineDeleted(ctx, vmID, time.Now().UTC())
+}
+
+// deployPowerState is the power state a VM deployed from spec starts in.
+func deployPowerState(spec *api.HCIDeployVM) string {
+	if spec.VmConfig.PowerOn.Value {
+		return PowerStateOn
+	}
+	return PowerStateOff
+}
+
+// delete deletes the target VM. A forced delete powers the VM off first.
+func (e *executor) delete(ctx context.Context, req *modals.VMRequest) error {
+	target, err := targetMetadata(req)
+	if err != nil {
+		return err
+	}
+	if err := e.checkCancelled(ctx, req); err != nil {
+		return err
+	}
+	if target.Force {
+		// A VM that is already off may refuse to power off, so only the delete decides the outcome.
+		if err := e.backend.PowerOff(ctx, target.VMID); err != nil {
+			e.logger.Warn(constants.Internal, constants.Executor, "Failed to power off VM before forced delete", map[constants.ExtraKey]interface{}{
+				"requestID": req.RequestID,
+				"vmID":      target.VMID,
+				"error":     err.Error(),
+			})
+		}
+	}
+	if err := e.backend.DeleteVM(ctx, target.VMID); err != nil {
+		return err
+	}
+	e.recordInventory(ctx, req, target.VMID, e.markDeleted)
+	return nil
+}
+
+// claimOwner names this executor on the requests it claims. The random suffix keeps
+// executors apart that restart with the same host name.
+func claimOwner() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "executor"
+	}
+	return host + "-" + uuid.NewString()[:8]
+}
+
+func targetVMID(req *modals.VMRequest) (string, error) {
+	target, err := targetMetadata(req)
+	if err != nil {
+		return "", err
+	}
+	return target.VMID, nil
+}
+
+func targetMetadata(req *modals.VMRequest) (*dto.VMTargetMetadata, error) {
+	var target dto.VMTargetMetadata
+	if err := json.Unmarshal([]byte(req.RequestMetadata), &target); err != nil {
+		return nil, fmt.Errorf("invalid request metadata: %w", err)
+	}
+	if target.VMID == "" {
+		return nil, errors.New("request metadata has no VM id")
+	}
+	return &target, nil
+}
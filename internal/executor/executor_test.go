@@ -0,0 +1,634 @@
+package executor_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	dto "vm/internal/dtos"
+	"vm/internal/executor"
+	api "vm/internal/gen"
+	"vm/internal/modals"
+	mock_repo "vm/internal/repo/mock"
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/constants"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+func deployMetadata(t *testing.T, name string, numVMs int) string {
+	t.Helper()
+	req := api.HCIDeployVM{
+		StorageConfig: api.HCIDeployVMStorageConfig{DefaultDatastoreId: "datastore-uuid-414"},
+		VmConfig: api.HCIDeployVMVmConfig{
+			AcceptEula:  true,
+			Name:        name,
+			NumberOfVms: api.OptInt{Value: numVMs, Set: true},
+			PowerOn:     api.OptBool{Value: true, Set: true},
+		},
+	}
+	metadata, err := json.Marshal(&req)
+	assert.NoError(t, err)
+	return string(metadata)
+}
+
+func vmMetadata(t *testing.T, vmID string) string {
+	t.Helper()
+	metadata, err := json.Marshal(api.VMPowerOnParams{VMID: api.ID(vmID)})
+	assert.NoError(t, err)
+	return string(metadata)
+}
+
+// newMockRepo returns a repository mock whose requests are never cancelled.
+func newMockRepo(ctrl *gomock.Controller) *mock_repo.MockVMRepository {
+	mockRepo := mock_repo.NewMockVMRepository(ctrl)
+	mockRepo.EXPECT().IsVMRequestCancelRequested(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	stubInventory(mockRepo)
+	return mockRepo
+}
+
+// stubInventory accepts every inventory write, for tests that do not check them.
+func stubInventory(mockRepo *mock_repo.MockVMRepository) {
+	mockRepo.EXPECT().UpsertVirtualMachine(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().UpdateVirtualMachinePowerState(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockRepo.EXPECT().MarkVirtualMachineDeleted(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
+func TestNewBackend(t *testing.T) {
+	backend, err := executor.NewBackend(executor.BackendFake)
+	assert.NoError(t, err)
+	assert.NotNil(t, backend)
+
+	for _, name := range []string{"", "vsphere"} {
+		_, err := executor.NewBackend(name)
+		assert.Error(t, err, "backend %q", name)
+	}
+}
+
+func TestExecutor_RunOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := &mock_logger.StubLogger{}
+	ctx := context.Background()
+	cfg := configmanager.Executor{BatchSize: 10, Workers: 2}
+
+	t.Run("No new requests", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		exec := executor.NewExecutor(mockRepo, executor.NewFakeBackend(), cfg, logger)
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{}, nil)
+
+		assert.Equal(t, 0, exec.RunOnce(ctx))
+	})
+
+	t.Run("Claim failure", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		exec := executor.NewExecutor(mockRepo, executor.NewFakeBackend(), cfg, logger)
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).
+			Return(nil, &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "db error"})
+
+		assert.Equal(t, 0, exec.RunOnce(ctx))
+	})
+
+	t.Run("Deploy succeeds for every instance", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		backend := executor.NewFakeBackend()
+		exec := executor.NewExecutor(mockRepo, backend, cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-001",
+			Operation:       string(constants.VMDeploy),
+			RequestStatus:   string(constants.StatusInProgress),
+			RequestMetadata: deployMetadata(t, "web", 2),
+		}
+		instances := []*modals.VMDeployInstance{
+			{RequestID: "req-001", VMName: "web_1", VMStatus: string(constants.StatusNew)},
+			{RequestID: "req-001", VMName: "web_2", VMStatus: string(constants.StatusNew)},
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().GetVMDeployInstances(gomock.Any(), "req-001").Return(instances, nil)
+		mockRepo.EXPECT().UpdateVMDeployInstance(gomock.Any(), gomock.Any()).Return(nil).Times(4)
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-001", constants.StatusSuccess, gomock.Not(gomock.Nil())).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+		assert.Equal(t, string(constants.StatusSuccess), req.RequestStatus)
+		assert.NotNil(t, req.CompletedAt)
+		for _, inst := range instances {
+			assert.Equal(t, string(constants.StatusSuccess), inst.VMStatus)
+			assert.NotNil(t, inst.CompletedAt)
+			vm, ok := backend.VM(inst.VMID)
+			assert.True(t, ok)
+			assert.Equal(t, inst.VMName, vm.Name)
+			assert.Equal(t, executor.PowerStateOn, vm.PowerState)
+		}
+	})
+
+	t.Run("Deploy fails when any instance fails", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		backend := executor.NewFakeBackend()
+		backend.InjectFailure(constants.VMDeploy, "web_2", errors.New("datastore full"))
+		exec := executor.NewExecutor(mockRepo, backend, cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-002",
+			Operation:       string(constants.VMDeploy),
+			RequestMetadata: deployMetadata(t, "web", 2),
+		}
+		instances := []*modals.VMDeployInstance{
+			{RequestID: "req-002", VMName: "web_1", VMStatus: string(constants.StatusNew)},
+			{RequestID: "req-002", VMName: "web_2", VMStatus: string(constants.StatusNew)},
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().GetVMDeployInstances(gomock.Any(), "req-002").Return(instances, nil)
+		mockRepo.EXPECT().UpdateVMDeployInstance(gomock.Any(), gomock.Any()).Return(nil).Times(4)
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-002", constants.StatusFailure, gomock.Any()).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+		assert.Equal(t, string(constants.StatusSuccess), instances[0].VMStatus)
+		assert.Equal(t, string(constants.StatusFailure), instances[1].VMStatus)
+		assert.Equal(t, "datastore full", instances[1].VMStateMessage)
+		assert.Empty(t, instances[1].VMID)
+	})
+
+	t.Run("Power on existing VM", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		backend := executor.NewFakeBackend()
+		vmID := backend.AddVM("db", executor.PowerStateOff)
+		exec := executor.NewExecutor(mockRepo, backend, cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-003",
+			Operation:       string(constants.VMPowerOn),
+			WorkspaceId:     "workspace-001",
+			RequestMetadata: vmMetadata(t, vmID),
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-003", constants.StatusSuccess, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ string, _ constants.RequestStatus, _ *time.Time) *dto.ApiResponseError {
+				// Updates run in the workspace that owns the request.
+				workspaceID, err := utils.GetWorkspaceIDFromContext(ctx)
+				assert.NoError(t, err)
+				assert.Equal(t, "workspace-001", workspaceID)
+				return nil
+			})
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+		vm, _ := backend.VM(vmID)
+		assert.Equal(t, executor.PowerStateOn, vm.PowerState)
+	})
+
+	t.Run("Delete removes the VM", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		backend := executor.NewFakeBackend()
+		vmID := backend.AddVM("db", executor.PowerStateOff)
+		exec := executor.NewExecutor(mockRepo, backend, cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-004",
+			Operation:       string(constants.VMDelete),
+			RequestMetadata: vmMetadata(t, vmID),
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-004", constants.StatusSuccess, gomock.Any()).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+		_, ok := backend.VM(vmID)
+		assert.False(t, ok)
+	})
+
+	t.Run("Forced delete goes ahead when power off fails", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		backend := executor.NewFakeBackend()
+		vmID := backend.AddVM("db", executor.PowerStateOn)
+		backend.InjectFailure(constants.VMPowerOff, vmID, errors.New("already off"))
+		exec := executor.NewExecutor(mockRepo, backend, cfg, logger)
+
+		metadata, err := json.Marshal(dto.VMTargetMetadata{VMID: vmID, Force: true})
+		assert.NoError(t, err)
+		req := &modals.VMRequest{
+			RequestID:       "req-009",
+			Operation:       string(constants.VMDelete),
+			RequestMetadata: string(metadata),
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-009", constants.StatusSuccess, gomock.Any()).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+		_, ok := backend.VM(vmID)
+		assert.False(t, ok)
+	})
+
+	t.Run("Reconfigure applies the stored spec", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		backend := executor.NewFakeBackend()
+		vmID := backend.AddVM("db", executor.PowerStateOff)
+		exec := executor.NewExecutor(mockRepo, backend, cfg, logger)
+
+		spec := api.EditVM{CpuMemConfig: api.NewOptEditVMCpuMemConfig(api.EditVMCpuMemConfig{
+			Memory: api.NewOptEditVMCpuMemConfigMemory(api.EditVMCpuMemConfigMemory{MemoryInMb: api.NewOptInt(4096)}),
+		})}
+		metadata, err := json.Marshal(dto.EditVMMetadata{VMID: vmID, Spec: &spec})
+		assert.NoError(t, err)
+		req := &modals.VMRequest{
+			RequestID:       "req-008",
+			Operation:       string(constants.VMReconfigure),
+			RequestMetadata: string(metadata),
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-008", constants.StatusSuccess, gomock.Any()).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+		vm, _ := backend.VM(vmID)
+		if assert.NotNil(t, vm.Spec) {
+			assert.Equal(t, 4096, vm.Spec.CpuMemConfig.Value.Memory.Value.MemoryInMb.Value)
+		}
+	})
+
+	t.Run("Power off unknown VM fails", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		exec := executor.NewExecutor(mockRepo, executor.NewFakeBackend(), cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-005",
+			Operation:       string(constants.VMPowerOff),
+			RequestMetadata: vmMetadata(t, "vm-missing"),
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-005", constants.StatusFailure, gomock.Any()).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+	})
+
+	t.Run("Unsupported operation fails", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		exec := executor.NewExecutor(mockRepo, executor.NewFakeBackend(), cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-006",
+			Operation:       "vmSnapshot",
+			RequestMetadata: `{}`,
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-006", constants.StatusFailure, gomock.Any()).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+	})
+
+	t.Run("Invalid metadata fails", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		exec := executor.NewExecutor(mockRepo, executor.NewFakeBackend(), cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-007",
+			Operation:       string(constants.VMReset),
+			RequestMetadata: `{"invalid_json":}`,
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-007", constants.StatusFailure, gomock.Any()).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+	})
+}
+
+func TestExecutor_Cancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := &mock_logger.StubLogger{}
+	ctx := context.Background()
+	cfg := configmanager.Executor{BatchSize: 10, Workers: 1}
+
+	t.Run("Deploy stops before the next instance", func(t *testing.T) {
+		mockRepo := mock_repo.NewMockVMRepository(ctrl)
+		stubInventory(mockRepo)
+		backend := executor.NewFakeBackend()
+		exec := executor.NewExecutor(mockRepo, backend, cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-001",
+			Operation:       string(constants.VMDeploy),
+			RequestMetadata: deployMetadata(t, "web", 2),
+		}
+		instances := []*modals.VMDeployInstance{
+			{RequestID: "req-001", VMName: "web_1", VMStatus: string(constants.StatusNew)},
+			{RequestID: "req-001", VMName: "web_2", VMStatus: string(constants.StatusNew)},
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().GetVMDeployInstances(gomock.Any(), "req-001").Return(instances, nil)
+		gomock.InOrder(
+			mockRepo.EXPECT().IsVMRequestCancelRequested(gomock.Any(), "req-001").Return(false, nil),
+			mockRepo.EXPECT().IsVMRequestCancelRequested(gomock.Any(), "req-001").Return(true, nil),
+		)
+		mockRepo.EXPECT().UpdateVMDeployInstance(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockRepo.EXPECT().CancelPendingVMDeployInstances(gomock.Any(), "req-001", gomock.Any()).Return(nil)
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-001", constants.StatusCancelled, gomock.Not(gomock.Nil())).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+		assert.Equal(t, string(constants.StatusCancelled), req.RequestStatus)
+		assert.Equal(t, string(constants.StatusSuccess), instances[0].VMStatus)
+		assert.Equal(t, string(constants.StatusNew), instances[1].VMStatus)
+	})
+
+	t.Run("Power operation is skipped", func(t *testing.T) {
+		mockRepo := mock_repo.NewMockVMRepository(ctrl)
+		stubInventory(mockRepo)
+		backend := executor.NewFakeBackend()
+		vmID := backend.AddVM("db", executor.PowerStateOn)
+		exec := executor.NewExecutor(mockRepo, backend, cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-002",
+			Operation:       string(constants.VMPowerOff),
+			RequestMetadata: vmMetadata(t, vmID),
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().IsVMRequestCancelRequested(gomock.Any(), "req-002").Return(true, nil)
+		mockRepo.EXPECT().CancelPendingVMDeployInstances(gomock.Any(), "req-002", gomock.Any()).Return(nil)
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-002", constants.StatusCancelled, gomock.Any()).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+		vm, _ := backend.VM(vmID)
+		assert.Equal(t, executor.PowerStateOn, vm.PowerState)
+	})
+
+	t.Run("Lookup failure keeps the request running", func(t *testing.T) {
+		mockRepo := mock_repo.NewMockVMRepository(ctrl)
+		stubInventory(mockRepo)
+		backend := executor.NewFakeBackend()
+		vmID := backend.AddVM("db", executor.PowerStateOn)
+		exec := executor.NewExecutor(mockRepo, backend, cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-003",
+			Operation:       string(constants.VMPowerOff),
+			RequestMetadata: vmMetadata(t, vmID),
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().IsVMRequestCancelRequested(gomock.Any(), "req-003").
+			Return(false, &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "db error"})
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-003", constants.StatusSuccess, gomock.Any()).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+		vm, _ := backend.VM(vmID)
+		assert.Equal(t, executor.PowerStateOff, vm.PowerState)
+	})
+}
+
+func TestExecutor_Inventory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := &mock_logger.StubLogger{}
+	ctx := context.Background()
+	cfg := configmanager.Executor{BatchSize: 10, Workers: 1}
+
+	newRepo := func() *mock_repo.MockVMRepository {
+		mockRepo := mock_repo.NewMockVMRepository(ctrl)
+		mockRepo.EXPECT().IsVMRequestCancelRequested(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+		return mockRepo
+	}
+
+	t.Run("Deploy records only the deployed instances", func(t *testing.T) {
+		mockRepo := newRepo()
+		backend := executor.NewFakeBackend()
+		backend.InjectFailure(constants.VMDeploy, "web_2", errors.New("datastore full"))
+		exec := executor.NewExecutor(mockRepo, backend, cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-001",
+			Operation:       string(constants.VMDeploy),
+			WorkspaceId:     "workspace-001",
+			RequestMetadata: deployMetadata(t, "web", 2),
+		}
+		instances := []*modals.VMDeployInstance{
+			{RequestID: "req-001", VMName: "web_1", VMStatus: string(constants.StatusNew)},
+			{RequestID: "req-001", VMName: "web_2", VMStatus: string(constants.StatusNew)},
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().GetVMDeployInstances(gomock.Any(), "req-001").Return(instances, nil)
+		mockRepo.EXPECT().UpdateVMDeployInstance(gomock.Any(), gomock.Any()).Return(nil).Times(4)
+		mockRepo.EXPECT().UpsertVirtualMachine(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, vm *modals.VirtualMachine) *dto.ApiResponseError {
+				workspaceID, err := utils.GetWorkspaceIDFromContext(ctx)
+				assert.NoError(t, err)
+				assert.Equal(t, "workspace-001", workspaceID)
+				assert.Equal(t, instances[0].VMID, vm.VMID)
+				assert.Equal(t, "web_1", vm.VMName)
+				assert.Equal(t, "req-001", vm.RequestID)
+				assert.Equal(t, string(constants.VMStateActive), vm.State)
+				assert.Equal(t, executor.PowerStateOn, vm.PowerState)
+				return nil
+			})
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-001", constants.StatusFailure, gomock.Any()).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+	})
+
+	t.Run("Power off records the power state", func(t *testing.T) {
+		mockRepo := newRepo()
+		backend := executor.NewFakeBackend()
+		vmID := backend.AddVM("db", executor.PowerStateOn)
+		exec := executor.NewExecutor(mockRepo, backend, cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-002",
+			Operation:       string(constants.VMPowerOff),
+			RequestMetadata: vmMetadata(t, vmID),
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().UpdateVirtualMachinePowerState(gomock.Any(), vmID, executor.PowerStateOff).Return(nil)
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-002", constants.StatusSuccess, gomock.Any()).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+	})
+
+	t.Run("Delete marks the VM deleted", func(t *testing.T) {
+		mockRepo := newRepo()
+		backend := executor.NewFakeBackend()
+		vmID := backend.AddVM("db", executor.PowerStateOff)
+		exec := executor.NewExecutor(mockRepo, backend, cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-003",
+			Operation:       string(constants.VMDelete),
+			RequestMetadata: vmMetadata(t, vmID),
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().MarkVirtualMachineDeleted(gomock.Any(), vmID, gomock.Any()).
+			Return(&dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "db error"})
+		// The VM is gone, so a failed inventory write does not fail the request.
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-003", constants.StatusSuccess, gomock.Any()).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+	})
+
+	t.Run("Failed operation leaves the inventory alone", func(t *testing.T) {
+		mockRepo := newRepo()
+		exec := executor.NewExecutor(mockRepo, executor.NewFakeBackend(), cfg, logger)
+
+		req := &modals.VMRequest{
+			RequestID:       "req-004",
+			Operation:       string(constants.VMDelete),
+			RequestMetadata: vmMetadata(t, "vm-missing"),
+		}
+
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).Return([]*modals.VMRequest{req}, nil)
+		mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-004", constants.StatusFailure, gomock.Any()).Return(nil)
+
+		assert.Equal(t, 1, exec.RunOnce(ctx))
+	})
+}
+
+func TestExecutor_StartStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := &mock_logger.StubLogger{}
+	mockRepo := newMockRepo(ctrl)
+	backend := executor.NewFakeBackend()
+	vmID := backend.AddVM("db", executor.PowerStateOn)
+	exec := executor.NewExecutor(mockRepo, backend, configmanager.Executor{PollInterval: 1, BatchSize: 1, Workers: 1}, logger)
+
+	req := &modals.VMRequest{
+		RequestID:       "req-001",
+		Operation:       string(constants.VMShutdownGuestOS),
+		RequestMetadata: vmMetadata(t, vmID),
+	}
+
+	done := make(chan struct{})
+	gomock.InOrder(
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 1).Return([]*modals.VMRequest{req}, nil),
+		mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 1).Return(nil, nil).AnyTimes(),
+	)
+	mockRepo.EXPECT().FailExpiredVMRequestClaims(gomock.Any(), gomock.Any(), 1).Return(nil, nil).AnyTimes()
+	mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-001", constants.StatusSuccess, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ constants.RequestStatus, _ *time.Time) *dto.ApiResponseError {
+			close(done)
+			return nil
+		})
+
+	exec.Start(context.Background())
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not executed")
+	}
+	exec.Stop()
+
+	vm, _ := backend.VM(vmID)
+	assert.Equal(t, executor.PowerStateOff, vm.PowerState)
+}
+
+func TestExecutor_RenewsClaims(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := newMockRepo(ctrl)
+	backend := executor.NewFakeBackend()
+	vmID := backend.AddVM("db", executor.PowerStateOff)
+	exec := executor.NewExecutor(mockRepo, backend, configmanager.Executor{BatchSize: 10, Workers: 1, ClaimTimeout: 1}, &mock_logger.StubLogger{})
+
+	req := &modals.VMRequest{
+		RequestID:       "req-001",
+		Operation:       string(constants.VMPowerOn),
+		RequestMetadata: vmMetadata(t, vmID),
+	}
+
+	var owner string
+	renewed := make(chan struct{})
+	mockRepo.EXPECT().ClaimNewVMRequests(gomock.Any(), gomock.Any(), 10).
+		DoAndReturn(func(_ context.Context, claimOwner string, _ int) ([]*modals.VMRequest, *dto.ApiResponseError) {
+			owner = claimOwner
+			return []*modals.VMRequest{req}, nil
+		})
+	mockRepo.EXPECT().RenewVMRequestClaims(gomock.Any(), gomock.Any(), []string{"req-001"}).
+		DoAndReturn(func(_ context.Context, claimOwner string, _ []string) *dto.ApiResponseError {
+			assert.Equal(t, owner, claimOwner)
+			select {
+			case <-renewed:
+			default:
+				close(renewed)
+			}
+			return nil
+		}).MinTimes(1)
+	// The request runs until its claim has been renewed at least once.
+	mockRepo.EXPECT().UpdateVMRequestStatus(gomock.Any(), "req-001", constants.StatusSuccess, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ constants.RequestStatus, _ *time.Time) *dto.ApiResponseError {
+			select {
+			case <-renewed:
+			case <-time.After(5 * time.Second):
+				t.Error("claim was not renewed")
+			}
+			return nil
+		})
+
+	assert.Equal(t, 1, exec.RunOnce(context.Background()))
+	assert.NotEmpty(t, owner)
+}
+
+func TestExecutor_ReapExpiredClaims(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := &mock_logger.StubLogger{}
+	ctx := context.Background()
+	cfg := configmanager.Executor{BatchSize: 10, ClaimTimeout: 60}
+
+	t.Run("Requests claimed before the timeout are failed", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		exec := executor.NewExecutor(mockRepo, executor.NewFakeBackend(), cfg, logger)
+
+		start := time.Now().UTC()
+		mockRepo.EXPECT().FailExpiredVMRequestClaims(gomock.Any(), gomock.Any(), 10).
+			DoAndReturn(func(_ context.Context, claimedBefore time.Time, _ int) ([]*modals.VMRequest, *dto.ApiResponseError) {
+				assert.WithinDuration(t, start.Add(-time.Minute), claimedBefore, 5*time.Second)
+				return []*modals.VMRequest{
+					{RequestID: "req-001", RequestStatus: string(constants.StatusFailure), ClaimedBy: "executor-1"},
+					{RequestID: "req-002", RequestStatus: string(constants.StatusFailure), ClaimedBy: "executor-1"},
+				}, nil
+			})
+
+		assert.Equal(t, 2, exec.ReapExpiredClaims(ctx))
+	})
+
+	t.Run("Failure is logged", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		exec := executor.NewExecutor(mockRepo, executor.NewFakeBackend(), cfg, logger)
+
+		mockRepo.EXPECT().FailExpiredVMRequestClaims(gomock.Any(), gomock.Any(), 10).
+			Return(nil, &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "db error"})
+
+		assert.Equal(t, 0, exec.ReapExpiredClaims(ctx))
+	})
+
+	t.Run("Cancelled context reaps nothing", func(t *testing.T) {
+		mockRepo := newMockRepo(ctrl)
+		exec := executor.NewExecutor(mockRepo, executor.NewFakeBackend(), cfg, logger)
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		assert.Equal(t, 0, exec.ReapExpiredClaims(cancelled))
+	})
+}
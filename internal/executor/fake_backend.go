@@ -0,0 +1,140 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	api "vm/internal/gen"
+	"vm/pkg/constants"
+
+	"github.com/google/uuid"
+)
+
+const BackendFake = "fake"
+
+const (
+	PowerStateOn  = "POWERED_ON"
+	PowerStateOff = "POWERED_OFF"
+)
+
+// FakeVM is the state the fake backend keeps for a VM.
+type FakeVM struct {
+	ID         string
+	Name       string
+	PowerState string
+	Spec       *api.EditVM
+}
+
+// FakeBackend is an in-memory Backend for local development and tests.
+type FakeBackend struct {
+	mu       sync.Mutex
+	vms      map[string]*FakeVM
+	failures map[constants.OperationType]map[string]error
+}
+
+// NewFakeBackend creates an empty FakeBackend.
+func NewFakeBackend() *FakeBackend {
+	return &FakeBackend{
+		vms:      map[string]*FakeVM{},
+		failures: map[constants.OperationType]map[string]error{},
+	}
+}
+
+// InjectFailure makes op fail with err for target, which is the VM name for
+// deploys and the VM id for every other operation.
+func (b *FakeBackend) InjectFailure(op constants.OperationType, target string, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures[op] == nil {
+		b.failures[op] = map[string]error{}
+	}
+	b.failures[op][target] = err
+}
+
+// AddVM registers an existing VM and returns its id.
+func (b *FakeBackend) AddVM(name, powerState string) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	id := uuid.New().String()
+	b.vms[id] = &FakeVM{ID: id, Name: name, PowerState: powerState}
+	return id
+}
+
+// VM returns a copy of the VM with the given id.
+func (b *FakeBackend) VM(vmID string) (FakeVM, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	vm, ok := b.vms[vmID]
+	if !ok {
+		return FakeVM{}, false
+	}
+	return *vm, true
+}
+
+func (b *FakeBackend) DeployVM(ctx context.Context, spec *api.HCIDeployVM, vmName string) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.failures[constants.VMDeploy][vmName]; err != nil {
+		return "", err
+	}
+	powerState := PowerStateOff
+	if spec != nil && spec.VmConfig.PowerOn.Value {
+		powerState = PowerStateOn
+	}
+	id := uuid.New().String()
+	b.vms[id] = &FakeVM{ID: id, Name: vmName, PowerState: powerState}
+	return id, nil
+}
+
+func (b *FakeBackend) PowerOn(ctx context.Context, vmID string) error {
+	return b.apply(constants.VMPowerOn, vmID, func(vm *FakeVM) { vm.PowerState = PowerStateOn })
+}
+
+func (b *FakeBackend) PowerOff(ctx context.Context, vmID string) error {
+	return b.apply(constants.VMPowerOff, vmID, func(vm *FakeVM) { vm.PowerState = PowerStateOff })
+}
+
+func (b *FakeBackend) Reset(ctx context.Context, vmID string) error {
+	return b.apply(constants.VMReset, vmID, func(vm *FakeVM) { vm.PowerState = PowerStateOn })
+}
+
+func (b *FakeBackend) Refresh(ctx context.Context, vmID string) error {
+	return b.apply(constants.VMRefresh, vmID, func(vm *FakeVM) {})
+}
+
+func (b *FakeBackend) RestartGuestOS(ctx context.Context, vmID string) error {
+	return b.apply(constants.VMRestartGuestOS, vmID, func(vm *FakeVM) { vm.PowerState = PowerStateOn })
+}
+
+func (b *FakeBackend) ShutdownGuestOS(ctx context.Context, vmID string) error {
+	return b.apply(constants.VMShutdownGuestOS, vmID, func(vm *FakeVM) { vm.PowerState = PowerStateOff })
+}
+
+func (b *FakeBackend) Reconfigure(ctx context.Context, vmID string, spec *api.EditVM) error {
+	return b.apply(constants.VMReconfigure, vmID, func(vm *FakeVM) { vm.Spec = spec })
+}
+
+func (b *FakeBackend) DeleteVM(ctx context.Context, vmID string) error {
+	if err := b.apply(constants.VMDelete, vmID, func(vm *FakeVM) {}); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.vms, vmID)
+	return nil
+}
+
+func (b *FakeBackend) apply(op constants.OperationType, vmID string, mutate func(vm *FakeVM)) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.failures[op][vmID]; err != nil {
+		return err
+	}
+	vm, ok := b.vms[vmID]
+	if !ok {
+		return fmt.Errorf("vm %s not found", vmID)
+	}
+	mutate(vm)
+	return nil
+}
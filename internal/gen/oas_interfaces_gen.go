@@ -1,10 +1,18 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type CancelVirtualMachineRequestRes interface {
+	cancelVirtualMachineRequestRes()
+}
+
 type EditVMRes interface {
 	editVMRes()
 }
 
+type GetVirtualMachineListRes interface {
+	getVirtualMachineListRes()
+}
+
 type GetVirtualMachineRequestListRes interface {
 	getVirtualMachineRequestListRes()
 }
@@ -13,10 +21,18 @@ type GetVirtualMachineRequestRes interface {
 	getVirtualMachineRequestRes()
 }
 
+type GetVirtualMachineRes interface {
+	getVirtualMachineRes()
+}
+
 type HCIDeployVMRes interface {
 	hCIDeployVMRes()
 }
 
+type RetryVirtualMachineRequestRes interface {
+	retryVirtualMachineRequestRes()
+}
+
 type VMDeleteRes interface {
 	vMDeleteRes()
 }
@@ -13,137 +13,93 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
-// Encode implements json.Marshaler.
-func (s *EditVM) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes CancelVirtualMachineRequestConflict as json.
+func (s *CancelVirtualMachineRequestConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *EditVM) encodeFields(e *jx.Encoder) {
-	{
-		if len(s.CpuMemConfig) != 0 {
-			e.FieldStart("cpuMemConfig")
-			e.Raw(s.CpuMemConfig)
-		}
-	}
-	{
-		if s.NetworkAdapters != nil {
-			e.FieldStart("networkAdapters")
-			e.ArrStart()
-			for _, elem := range s.NetworkAdapters {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+// Decode decodes CancelVirtualMachineRequestConflict from json.
+func (s *CancelVirtualMachineRequestConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CancelVirtualMachineRequestConflict to nil")
 	}
-	{
-		if s.VirtualDisks != nil {
-			e.FieldStart("virtualDisks")
-			e.ArrStart()
-			for _, elem := range s.VirtualDisks {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = CancelVirtualMachineRequestConflict(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfEditVM = [3]string{
-	0: "cpuMemConfig",
-	1: "networkAdapters",
-	2: "virtualDisks",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CancelVirtualMachineRequestConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes EditVM from json.
-func (s *EditVM) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CancelVirtualMachineRequestConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CancelVirtualMachineRequestForbidden as json.
+func (s *CancelVirtualMachineRequestForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes CancelVirtualMachineRequestForbidden from json.
+func (s *CancelVirtualMachineRequestForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EditVM to nil")
+		return errors.New("invalid: unable to decode CancelVirtualMachineRequestForbidden to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "cpuMemConfig":
-			if err := func() error {
-				v, err := d.RawAppend(nil)
-				s.CpuMemConfig = jx.Raw(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cpuMemConfig\"")
-			}
-		case "networkAdapters":
-			if err := func() error {
-				s.NetworkAdapters = make([]EditVMNetworkAdaptersItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem EditVMNetworkAdaptersItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.NetworkAdapters = append(s.NetworkAdapters, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"networkAdapters\"")
-			}
-		case "virtualDisks":
-			if err := func() error {
-				s.VirtualDisks = make([]EditVMVirtualDisksItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem EditVMVirtualDisksItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.VirtualDisks = append(s.VirtualDisks, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"virtualDisks\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode EditVM")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = CancelVirtualMachineRequestForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EditVM) MarshalJSON() ([]byte, error) {
+func (s *CancelVirtualMachineRequestForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVM) UnmarshalJSON(data []byte) error {
+func (s *CancelVirtualMachineRequestForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMBadRequest as json.
-func (s *EditVMBadRequest) Encode(e *jx.Encoder) {
+// Encode encodes CancelVirtualMachineRequestInternalServerError as json.
+func (s *CancelVirtualMachineRequestInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes EditVMBadRequest from json.
-func (s *EditVMBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CancelVirtualMachineRequestInternalServerError from json.
+func (s *CancelVirtualMachineRequestInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EditVMBadRequest to nil")
+		return errors.New("invalid: unable to decode CancelVirtualMachineRequestInternalServerError to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -154,34 +110,34 @@ func (s *EditVMBadRequest) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = EditVMBadRequest(unwrapped)
+	*s = CancelVirtualMachineRequestInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EditVMBadRequest) MarshalJSON() ([]byte, error) {
+func (s *CancelVirtualMachineRequestInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMBadRequest) UnmarshalJSON(data []byte) error {
+func (s *CancelVirtualMachineRequestInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMConflict as json.
-func (s *EditVMConflict) Encode(e *jx.Encoder) {
+// Encode encodes CancelVirtualMachineRequestNotFound as json.
+func (s *CancelVirtualMachineRequestNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes EditVMConflict from json.
-func (s *EditVMConflict) Decode(d *jx.Decoder) error {
+// Decode decodes CancelVirtualMachineRequestNotFound from json.
+func (s *CancelVirtualMachineRequestNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EditVMConflict to nil")
+		return errors.New("invalid: unable to decode CancelVirtualMachineRequestNotFound to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -192,34 +148,34 @@ func (s *EditVMConflict) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = EditVMConflict(unwrapped)
+	*s = CancelVirtualMachineRequestNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EditVMConflict) MarshalJSON() ([]byte, error) {
+func (s *CancelVirtualMachineRequestNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMConflict) UnmarshalJSON(data []byte) error {
+func (s *CancelVirtualMachineRequestNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMForbidden as json.
-func (s *EditVMForbidden) Encode(e *jx.Encoder) {
+// Encode encodes CancelVirtualMachineRequestServiceUnavailable as json.
+func (s *CancelVirtualMachineRequestServiceUnavailable) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes EditVMForbidden from json.
-func (s *EditVMForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes CancelVirtualMachineRequestServiceUnavailable from json.
+func (s *CancelVirtualMachineRequestServiceUnavailable) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EditVMForbidden to nil")
+		return errors.New("invalid: unable to decode CancelVirtualMachineRequestServiceUnavailable to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -230,34 +186,34 @@ func (s *EditVMForbidden) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = EditVMForbidden(unwrapped)
+	*s = CancelVirtualMachineRequestServiceUnavailable(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EditVMForbidden) MarshalJSON() ([]byte, error) {
+func (s *CancelVirtualMachineRequestServiceUnavailable) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMForbidden) UnmarshalJSON(data []byte) error {
+func (s *CancelVirtualMachineRequestServiceUnavailable) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMInternalServerError as json.
-func (s *EditVMInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes CancelVirtualMachineRequestUnauthorized as json.
+func (s *CancelVirtualMachineRequestUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes EditVMInternalServerError from json.
-func (s *EditVMInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes CancelVirtualMachineRequestUnauthorized from json.
+func (s *CancelVirtualMachineRequestUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EditVMInternalServerError to nil")
+		return errors.New("invalid: unable to decode CancelVirtualMachineRequestUnauthorized to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -268,36 +224,36 @@ func (s *EditVMInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = EditVMInternalServerError(unwrapped)
+	*s = CancelVirtualMachineRequestUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EditVMInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *CancelVirtualMachineRequestUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *CancelVirtualMachineRequestUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *EditVMNetworkAdaptersItem) Encode(e *jx.Encoder) {
+func (s *DataManagementJobInfo) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *EditVMNetworkAdaptersItem) encodeFields(e *jx.Encoder) {
+func (s *DataManagementJobInfo) encodeFields(e *jx.Encoder) {
 	{
-		if s.ConnectAtPowerOn.Set {
-			e.FieldStart("connectAtPowerOn")
-			s.ConnectAtPowerOn.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
 	}
 	{
@@ -307,15 +263,15 @@ func (s *EditVMNetworkAdaptersItem) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		if s.NetworkDetails.Set {
-			e.FieldStart("networkDetails")
-			s.NetworkDetails.Encode(e)
+		if s.ProtectionPolicyInfo.Set {
+			e.FieldStart("protectionPolicyInfo")
+			s.ProtectionPolicyInfo.Encode(e)
 		}
 	}
 	{
-		if s.Operation.Set {
-			e.FieldStart("operation")
-			s.Operation.Encode(e)
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
 		}
 	}
 	{
@@ -326,32 +282,31 @@ func (s *EditVMNetworkAdaptersItem) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfEditVMNetworkAdaptersItem = [5]string{
-	0: "connectAtPowerOn",
+var jsonFieldsNameOfDataManagementJobInfo = [5]string{
+	0: "id",
 	1: "name",
-	2: "networkDetails",
-	3: "operation",
+	2: "protectionPolicyInfo",
+	3: "resourceUri",
 	4: "type",
 }
 
-// Decode decodes EditVMNetworkAdaptersItem from json.
-func (s *EditVMNetworkAdaptersItem) Decode(d *jx.Decoder) error {
+// Decode decodes DataManagementJobInfo from json.
+func (s *DataManagementJobInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EditVMNetworkAdaptersItem to nil")
+		return errors.New("invalid: unable to decode DataManagementJobInfo to nil")
 	}
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "connectAtPowerOn":
+		case "id":
 			if err := func() error {
-				s.ConnectAtPowerOn.Reset()
-				if err := s.ConnectAtPowerOn.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"connectAtPowerOn\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
 		case "name":
 			if err := func() error {
@@ -363,25 +318,25 @@ func (s *EditVMNetworkAdaptersItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "networkDetails":
+		case "protectionPolicyInfo":
 			if err := func() error {
-				s.NetworkDetails.Reset()
-				if err := s.NetworkDetails.Decode(d); err != nil {
+				s.ProtectionPolicyInfo.Reset()
+				if err := s.ProtectionPolicyInfo.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"networkDetails\"")
+				return errors.Wrap(err, "decode field \"protectionPolicyInfo\"")
 			}
-		case "operation":
+		case "resourceUri":
 			if err := func() error {
-				s.Operation.Reset()
-				if err := s.Operation.Decode(d); err != nil {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"operation\"")
+				return errors.Wrap(err, "decode field \"resourceUri\"")
 			}
 		case "type":
 			if err := func() error {
@@ -398,40 +353,52 @@ func (s *EditVMNetworkAdaptersItem) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode EditVMNetworkAdaptersItem")
+		return errors.Wrap(err, "decode DataManagementJobInfo")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EditVMNetworkAdaptersItem) MarshalJSON() ([]byte, error) {
+func (s *DataManagementJobInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMNetworkAdaptersItem) UnmarshalJSON(data []byte) error {
+func (s *DataManagementJobInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *EditVMNetworkAdaptersItemNetworkDetails) Encode(e *jx.Encoder) {
+func (s *DataManagementTemplateInfo) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *EditVMNetworkAdaptersItemNetworkDetails) encodeFields(e *jx.Encoder) {
+func (s *DataManagementTemplateInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
 	{
 		if s.Name.Set {
 			e.FieldStart("name")
 			s.Name.Encode(e)
 		}
 	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
 	{
 		if s.Type.Set {
 			e.FieldStart("type")
@@ -440,20 +407,31 @@ func (s *EditVMNetworkAdaptersItemNetworkDetails) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfEditVMNetworkAdaptersItemNetworkDetails = [2]string{
-	0: "name",
-	1: "type",
+var jsonFieldsNameOfDataManagementTemplateInfo = [4]string{
+	0: "id",
+	1: "name",
+	2: "resourceUri",
+	3: "type",
 }
 
-// Decode decodes EditVMNetworkAdaptersItemNetworkDetails from json.
-func (s *EditVMNetworkAdaptersItemNetworkDetails) Decode(d *jx.Decoder) error {
+// Decode decodes DataManagementTemplateInfo from json.
+func (s *DataManagementTemplateInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EditVMNetworkAdaptersItemNetworkDetails to nil")
+		return errors.New("invalid: unable to decode DataManagementTemplateInfo to nil")
 	}
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
 		case "name":
 			if err := func() error {
 				s.Name.Reset()
@@ -464,6 +442,16 @@ func (s *EditVMNetworkAdaptersItemNetworkDetails) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
 		case "type":
 			if err := func() error {
 				s.Type.Reset()
@@ -479,164 +467,252 @@ func (s *EditVMNetworkAdaptersItemNetworkDetails) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode EditVMNetworkAdaptersItemNetworkDetails")
+		return errors.Wrap(err, "decode DataManagementTemplateInfo")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EditVMNetworkAdaptersItemNetworkDetails) MarshalJSON() ([]byte, error) {
+func (s *DataManagementTemplateInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMNetworkAdaptersItemNetworkDetails) UnmarshalJSON(data []byte) error {
+func (s *DataManagementTemplateInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMNetworkAdaptersItemNetworkDetailsType as json.
-func (s EditVMNetworkAdaptersItemNetworkDetailsType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *DatacenterInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes EditVMNetworkAdaptersItemNetworkDetailsType from json.
-func (s *EditVMNetworkAdaptersItemNetworkDetailsType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode EditVMNetworkAdaptersItemNetworkDetailsType to nil")
+// encodeFields encodes fields.
+func (s *DatacenterInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	{
+		if s.Moref.Set {
+			e.FieldStart("moref")
+			s.Moref.Encode(e)
+		}
 	}
-	// Try to use constant string.
-	switch EditVMNetworkAdaptersItemNetworkDetailsType(v) {
-	case EditVMNetworkAdaptersItemNetworkDetailsTypeSTANDARDPORTGROUP:
-		*s = EditVMNetworkAdaptersItemNetworkDetailsTypeSTANDARDPORTGROUP
-	default:
-		*s = EditVMNetworkAdaptersItemNetworkDetailsType(v)
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s EditVMNetworkAdaptersItemNetworkDetailsType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMNetworkAdaptersItemNetworkDetailsType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes EditVMNetworkAdaptersItemOperation as json.
-func (s EditVMNetworkAdaptersItemOperation) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+var jsonFieldsNameOfDatacenterInfo = [3]string{
+	0: "id",
+	1: "moref",
+	2: "name",
 }
 
-// Decode decodes EditVMNetworkAdaptersItemOperation from json.
-func (s *EditVMNetworkAdaptersItemOperation) Decode(d *jx.Decoder) error {
+// Decode decodes DatacenterInfo from json.
+func (s *DatacenterInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EditVMNetworkAdaptersItemOperation to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+		return errors.New("invalid: unable to decode DatacenterInfo to nil")
 	}
-	// Try to use constant string.
-	switch EditVMNetworkAdaptersItemOperation(v) {
-	case EditVMNetworkAdaptersItemOperationADD:
-		*s = EditVMNetworkAdaptersItemOperationADD
-	case EditVMNetworkAdaptersItemOperationEDIT:
-		*s = EditVMNetworkAdaptersItemOperationEDIT
-	case EditVMNetworkAdaptersItemOperationDELETE:
-		*s = EditVMNetworkAdaptersItemOperationDELETE
-	default:
-		*s = EditVMNetworkAdaptersItemOperation(v)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "moref":
+			if err := func() error {
+				s.Moref.Reset()
+				if err := s.Moref.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"moref\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DatacenterInfo")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s EditVMNetworkAdaptersItemOperation) MarshalJSON() ([]byte, error) {
+func (s *DatacenterInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMNetworkAdaptersItemOperation) UnmarshalJSON(data []byte) error {
+func (s *DatacenterInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMNetworkAdaptersItemType as json.
-func (s EditVMNetworkAdaptersItemType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *EditVM) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes EditVMNetworkAdaptersItemType from json.
-func (s *EditVMNetworkAdaptersItemType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode EditVMNetworkAdaptersItemType to nil")
+// encodeFields encodes fields.
+func (s *EditVM) encodeFields(e *jx.Encoder) {
+	{
+		if s.CpuMemConfig.Set {
+			e.FieldStart("cpuMemConfig")
+			s.CpuMemConfig.Encode(e)
+		}
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	{
+		if s.NetworkAdapters != nil {
+			e.FieldStart("networkAdapters")
+			e.ArrStart()
+			for _, elem := range s.NetworkAdapters {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-	// Try to use constant string.
-	switch EditVMNetworkAdaptersItemType(v) {
-	case EditVMNetworkAdaptersItemTypeE1000:
-		*s = EditVMNetworkAdaptersItemTypeE1000
-	case EditVMNetworkAdaptersItemTypeE1000E:
-		*s = EditVMNetworkAdaptersItemTypeE1000E
-	case EditVMNetworkAdaptersItemTypePCNET32:
-		*s = EditVMNetworkAdaptersItemTypePCNET32
-	case EditVMNetworkAdaptersItemTypeVMXNET:
-		*s = EditVMNetworkAdaptersItemTypeVMXNET
-	case EditVMNetworkAdaptersItemTypeVMXNET2:
-		*s = EditVMNetworkAdaptersItemTypeVMXNET2
-	case EditVMNetworkAdaptersItemTypeVMXNET3:
-		*s = EditVMNetworkAdaptersItemTypeVMXNET3
-	default:
-		*s = EditVMNetworkAdaptersItemType(v)
+	{
+		if s.VirtualDisks != nil {
+			e.FieldStart("virtualDisks")
+			e.ArrStart()
+			for _, elem := range s.VirtualDisks {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfEditVM = [3]string{
+	0: "cpuMemConfig",
+	1: "networkAdapters",
+	2: "virtualDisks",
+}
+
+// Decode decodes EditVM from json.
+func (s *EditVM) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EditVM to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "cpuMemConfig":
+			if err := func() error {
+				s.CpuMemConfig.Reset()
+				if err := s.CpuMemConfig.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cpuMemConfig\"")
+			}
+		case "networkAdapters":
+			if err := func() error {
+				s.NetworkAdapters = make([]EditVMNetworkAdaptersItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem EditVMNetworkAdaptersItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.NetworkAdapters = append(s.NetworkAdapters, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"networkAdapters\"")
+			}
+		case "virtualDisks":
+			if err := func() error {
+				s.VirtualDisks = make([]EditVMVirtualDisksItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem EditVMVirtualDisksItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.VirtualDisks = append(s.VirtualDisks, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"virtualDisks\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EditVM")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s EditVMNetworkAdaptersItemType) MarshalJSON() ([]byte, error) {
+func (s *EditVM) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMNetworkAdaptersItemType) UnmarshalJSON(data []byte) error {
+func (s *EditVM) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMNotFound as json.
-func (s *EditVMNotFound) Encode(e *jx.Encoder) {
+// Encode encodes EditVMBadRequest as json.
+func (s *EditVMBadRequest) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes EditVMNotFound from json.
-func (s *EditVMNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMBadRequest from json.
+func (s *EditVMBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EditVMNotFound to nil")
+		return errors.New("invalid: unable to decode EditVMBadRequest to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -647,34 +723,34 @@ func (s *EditVMNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = EditVMNotFound(unwrapped)
+	*s = EditVMBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EditVMNotFound) MarshalJSON() ([]byte, error) {
+func (s *EditVMBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMNotFound) UnmarshalJSON(data []byte) error {
+func (s *EditVMBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMServiceUnavailable as json.
-func (s *EditVMServiceUnavailable) Encode(e *jx.Encoder) {
+// Encode encodes EditVMConflict as json.
+func (s *EditVMConflict) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes EditVMServiceUnavailable from json.
-func (s *EditVMServiceUnavailable) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMConflict from json.
+func (s *EditVMConflict) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EditVMServiceUnavailable to nil")
+		return errors.New("invalid: unable to decode EditVMConflict to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -685,695 +761,674 @@ func (s *EditVMServiceUnavailable) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = EditVMServiceUnavailable(unwrapped)
+	*s = EditVMConflict(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EditVMServiceUnavailable) MarshalJSON() ([]byte, error) {
+func (s *EditVMConflict) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMServiceUnavailable) UnmarshalJSON(data []byte) error {
+func (s *EditVMConflict) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMUnauthorized as json.
-func (s *EditVMUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *EditVMCpuMemConfig) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes EditVMUnauthorized from json.
-func (s *EditVMUnauthorized) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode EditVMUnauthorized to nil")
-	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = EditVMUnauthorized(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *EditVMUnauthorized) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMUnauthorized) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *EditVMVirtualDisksItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *EditVMVirtualDisksItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.DiskConfig.Set {
-			e.FieldStart("diskConfig")
-			s.DiskConfig.Encode(e)
-		}
+// encodeFields encodes fields.
+func (s *EditVMCpuMemConfig) encodeFields(e *jx.Encoder) {
+	{
+		if s.CPU.Set {
+			e.FieldStart("cpu")
+			s.CPU.Encode(e)
+		}
 	}
 	{
-		if s.Operation.Set {
-			e.FieldStart("operation")
-			s.Operation.Encode(e)
+		if s.Memory.Set {
+			e.FieldStart("memory")
+			s.Memory.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfEditVMVirtualDisksItem = [2]string{
-	0: "diskConfig",
-	1: "operation",
+var jsonFieldsNameOfEditVMCpuMemConfig = [2]string{
+	0: "cpu",
+	1: "memory",
 }
 
-// Decode decodes EditVMVirtualDisksItem from json.
-func (s *EditVMVirtualDisksItem) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMCpuMemConfig from json.
+func (s *EditVMCpuMemConfig) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EditVMVirtualDisksItem to nil")
+		return errors.New("invalid: unable to decode EditVMCpuMemConfig to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "diskConfig":
+		case "cpu":
 			if err := func() error {
-				s.DiskConfig.Reset()
-				if err := s.DiskConfig.Decode(d); err != nil {
+				s.CPU.Reset()
+				if err := s.CPU.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"diskConfig\"")
+				return errors.Wrap(err, "decode field \"cpu\"")
 			}
-		case "operation":
+		case "memory":
 			if err := func() error {
-				s.Operation.Reset()
-				if err := s.Operation.Decode(d); err != nil {
+				s.Memory.Reset()
+				if err := s.Memory.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"operation\"")
+				return errors.Wrap(err, "decode field \"memory\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode EditVMVirtualDisksItem")
+		return errors.Wrap(err, "decode EditVMCpuMemConfig")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EditVMVirtualDisksItem) MarshalJSON() ([]byte, error) {
+func (s *EditVMCpuMemConfig) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMVirtualDisksItem) UnmarshalJSON(data []byte) error {
+func (s *EditVMCpuMemConfig) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *EditVMVirtualDisksItemDiskConfig) Encode(e *jx.Encoder) {
+func (s *EditVMCpuMemConfigCPU) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *EditVMVirtualDisksItemDiskConfig) encodeFields(e *jx.Encoder) {
-	{
-		if s.CapacityInMb.Set {
-			e.FieldStart("capacityInMb")
-			s.CapacityInMb.Encode(e)
-		}
-	}
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
+func (s *EditVMCpuMemConfigCPU) encodeFields(e *jx.Encoder) {
 	{
-		if s.RetainFiles.Set {
-			e.FieldStart("retainFiles")
-			s.RetainFiles.Encode(e)
+		if s.NumOfCoresPerSocket.Set {
+			e.FieldStart("numOfCoresPerSocket")
+			s.NumOfCoresPerSocket.Encode(e)
 		}
 	}
 	{
-		if s.Type.Set {
-			e.FieldStart("type")
-			s.Type.Encode(e)
+		if s.NumOfCpus.Set {
+			e.FieldStart("numOfCpus")
+			s.NumOfCpus.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfEditVMVirtualDisksItemDiskConfig = [4]string{
-	0: "capacityInMb",
-	1: "id",
-	2: "retainFiles",
-	3: "type",
+var jsonFieldsNameOfEditVMCpuMemConfigCPU = [2]string{
+	0: "numOfCoresPerSocket",
+	1: "numOfCpus",
 }
 
-// Decode decodes EditVMVirtualDisksItemDiskConfig from json.
-func (s *EditVMVirtualDisksItemDiskConfig) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMCpuMemConfigCPU from json.
+func (s *EditVMCpuMemConfigCPU) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EditVMVirtualDisksItemDiskConfig to nil")
+		return errors.New("invalid: unable to decode EditVMCpuMemConfigCPU to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "capacityInMb":
-			if err := func() error {
-				s.CapacityInMb.Reset()
-				if err := s.CapacityInMb.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"capacityInMb\"")
-			}
-		case "id":
-			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "retainFiles":
+		case "numOfCoresPerSocket":
 			if err := func() error {
-				s.RetainFiles.Reset()
-				if err := s.RetainFiles.Decode(d); err != nil {
+				s.NumOfCoresPerSocket.Reset()
+				if err := s.NumOfCoresPerSocket.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"retainFiles\"")
+				return errors.Wrap(err, "decode field \"numOfCoresPerSocket\"")
 			}
-		case "type":
+		case "numOfCpus":
 			if err := func() error {
-				s.Type.Reset()
-				if err := s.Type.Decode(d); err != nil {
+				s.NumOfCpus.Reset()
+				if err := s.NumOfCpus.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
+				return errors.Wrap(err, "decode field \"numOfCpus\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode EditVMVirtualDisksItemDiskConfig")
+		return errors.Wrap(err, "decode EditVMCpuMemConfigCPU")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EditVMVirtualDisksItemDiskConfig) MarshalJSON() ([]byte, error) {
+func (s *EditVMCpuMemConfigCPU) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMVirtualDisksItemDiskConfig) UnmarshalJSON(data []byte) error {
+func (s *EditVMCpuMemConfigCPU) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMVirtualDisksItemDiskConfigType as json.
-func (s EditVMVirtualDisksItemDiskConfigType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *EditVMCpuMemConfigMemory) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes EditVMVirtualDisksItemDiskConfigType from json.
-func (s *EditVMVirtualDisksItemDiskConfigType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode EditVMVirtualDisksItemDiskConfigType to nil")
+// encodeFields encodes fields.
+func (s *EditVMCpuMemConfigMemory) encodeFields(e *jx.Encoder) {
+	{
+		if s.MemoryInMb.Set {
+			e.FieldStart("memoryInMb")
+			s.MemoryInMb.Encode(e)
+		}
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+}
+
+var jsonFieldsNameOfEditVMCpuMemConfigMemory = [1]string{
+	0: "memoryInMb",
+}
+
+// Decode decodes EditVMCpuMemConfigMemory from json.
+func (s *EditVMCpuMemConfigMemory) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EditVMCpuMemConfigMemory to nil")
 	}
-	// Try to use constant string.
-	switch EditVMVirtualDisksItemDiskConfigType(v) {
-	case EditVMVirtualDisksItemDiskConfigTypeIDE:
-		*s = EditVMVirtualDisksItemDiskConfigTypeIDE
-	case EditVMVirtualDisksItemDiskConfigTypeSCSI:
-		*s = EditVMVirtualDisksItemDiskConfigTypeSCSI
-	case EditVMVirtualDisksItemDiskConfigTypeSATA:
-		*s = EditVMVirtualDisksItemDiskConfigTypeSATA
-	case EditVMVirtualDisksItemDiskConfigTypeNVME:
-		*s = EditVMVirtualDisksItemDiskConfigTypeNVME
-	default:
-		*s = EditVMVirtualDisksItemDiskConfigType(v)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "memoryInMb":
+			if err := func() error {
+				s.MemoryInMb.Reset()
+				if err := s.MemoryInMb.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"memoryInMb\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EditVMCpuMemConfigMemory")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s EditVMVirtualDisksItemDiskConfigType) MarshalJSON() ([]byte, error) {
+func (s *EditVMCpuMemConfigMemory) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMVirtualDisksItemDiskConfigType) UnmarshalJSON(data []byte) error {
+func (s *EditVMCpuMemConfigMemory) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMVirtualDisksItemOperation as json.
-func (s EditVMVirtualDisksItemOperation) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode encodes EditVMForbidden as json.
+func (s *EditVMForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes EditVMVirtualDisksItemOperation from json.
-func (s *EditVMVirtualDisksItemOperation) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMForbidden from json.
+func (s *EditVMForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EditVMVirtualDisksItemOperation to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+		return errors.New("invalid: unable to decode EditVMForbidden to nil")
 	}
-	// Try to use constant string.
-	switch EditVMVirtualDisksItemOperation(v) {
-	case EditVMVirtualDisksItemOperationADD:
-		*s = EditVMVirtualDisksItemOperationADD
-	case EditVMVirtualDisksItemOperationEDIT:
-		*s = EditVMVirtualDisksItemOperationEDIT
-	case EditVMVirtualDisksItemOperationDELETE:
-		*s = EditVMVirtualDisksItemOperationDELETE
-	default:
-		*s = EditVMVirtualDisksItemOperation(v)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = EditVMForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s EditVMVirtualDisksItemOperation) MarshalJSON() ([]byte, error) {
+func (s *EditVMForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EditVMVirtualDisksItemOperation) UnmarshalJSON(data []byte) error {
+func (s *EditVMForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *EmptyResponse) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
+// Encode encodes EditVMInternalServerError as json.
+func (s *EditVMInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
 
-// encodeFields encodes fields.
-func (s *EmptyResponse) encodeFields(e *jx.Encoder) {
+	unwrapped.Encode(e)
 }
 
-var jsonFieldsNameOfEmptyResponse = [0]string{}
-
-// Decode decodes EmptyResponse from json.
-func (s *EmptyResponse) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMInternalServerError from json.
+func (s *EditVMInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EmptyResponse to nil")
+		return errors.New("invalid: unable to decode EditVMInternalServerError to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		default:
-			return d.Skip()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
-	}); err != nil {
-		return errors.Wrap(err, "decode EmptyResponse")
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = EditVMInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EmptyResponse) MarshalJSON() ([]byte, error) {
+func (s *EditVMInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EmptyResponse) UnmarshalJSON(data []byte) error {
+func (s *EditVMInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorResponse) Encode(e *jx.Encoder) {
+func (s *EditVMNetworkAdaptersItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorResponse) encodeFields(e *jx.Encoder) {
+func (s *EditVMNetworkAdaptersItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("debugId")
-		e.Str(s.DebugId)
+		if s.ConnectAtPowerOn.Set {
+			e.FieldStart("connectAtPowerOn")
+			s.ConnectAtPowerOn.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("errorCode")
-		e.Str(s.ErrorCode)
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("httpStatusCode")
-		e.Int(s.HttpStatusCode)
+		if s.NetworkDetails.Set {
+			e.FieldStart("networkDetails")
+			s.NetworkDetails.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		if s.Operation.Set {
+			e.FieldStart("operation")
+			s.Operation.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfErrorResponse = [4]string{
-	0: "debugId",
-	1: "errorCode",
-	2: "httpStatusCode",
-	3: "message",
+var jsonFieldsNameOfEditVMNetworkAdaptersItem = [5]string{
+	0: "connectAtPowerOn",
+	1: "name",
+	2: "networkDetails",
+	3: "operation",
+	4: "type",
 }
 
-// Decode decodes ErrorResponse from json.
-func (s *ErrorResponse) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMNetworkAdaptersItem from json.
+func (s *EditVMNetworkAdaptersItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorResponse to nil")
+		return errors.New("invalid: unable to decode EditVMNetworkAdaptersItem to nil")
 	}
-	var requiredBitSet [1]uint8
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "debugId":
-			requiredBitSet[0] |= 1 << 0
+		case "connectAtPowerOn":
 			if err := func() error {
-				v, err := d.Str()
-				s.DebugId = string(v)
-				if err != nil {
+				s.ConnectAtPowerOn.Reset()
+				if err := s.ConnectAtPowerOn.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"debugId\"")
+				return errors.Wrap(err, "decode field \"connectAtPowerOn\"")
 			}
-		case "errorCode":
-			requiredBitSet[0] |= 1 << 1
+		case "name":
 			if err := func() error {
-				v, err := d.Str()
-				s.ErrorCode = string(v)
-				if err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"errorCode\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "httpStatusCode":
-			requiredBitSet[0] |= 1 << 2
+		case "networkDetails":
 			if err := func() error {
-				v, err := d.Int()
-				s.HttpStatusCode = int(v)
-				if err != nil {
+				s.NetworkDetails.Reset()
+				if err := s.NetworkDetails.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"httpStatusCode\"")
+				return errors.Wrap(err, "decode field \"networkDetails\"")
 			}
-		case "message":
-			requiredBitSet[0] |= 1 << 3
+		case "operation":
 			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
-				if err != nil {
+				s.Operation.Reset()
+				if err := s.Operation.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"operation\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorResponse")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfErrorResponse) {
-					name = jsonFieldsNameOfErrorResponse[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode EditVMNetworkAdaptersItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorResponse) MarshalJSON() ([]byte, error) {
+func (s *EditVMNetworkAdaptersItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorResponse) UnmarshalJSON(data []byte) error {
+func (s *EditVMNetworkAdaptersItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetVirtualMachineRequestForbidden as json.
-func (s *GetVirtualMachineRequestForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *EditVMNetworkAdaptersItemNetworkDetails) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *EditVMNetworkAdaptersItemNetworkDetails) encodeFields(e *jx.Encoder) {
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
 }
 
-// Decode decodes GetVirtualMachineRequestForbidden from json.
-func (s *GetVirtualMachineRequestForbidden) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfEditVMNetworkAdaptersItemNetworkDetails = [2]string{
+	0: "name",
+	1: "type",
+}
+
+// Decode decodes EditVMNetworkAdaptersItemNetworkDetails from json.
+func (s *EditVMNetworkAdaptersItemNetworkDetails) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetVirtualMachineRequestForbidden to nil")
+		return errors.New("invalid: unable to decode EditVMNetworkAdaptersItemNetworkDetails to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode EditVMNetworkAdaptersItemNetworkDetails")
 	}
-	*s = GetVirtualMachineRequestForbidden(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetVirtualMachineRequestForbidden) MarshalJSON() ([]byte, error) {
+func (s *EditVMNetworkAdaptersItemNetworkDetails) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetVirtualMachineRequestForbidden) UnmarshalJSON(data []byte) error {
+func (s *EditVMNetworkAdaptersItemNetworkDetails) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetVirtualMachineRequestInternalServerError as json.
-func (s *GetVirtualMachineRequestInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes EditVMNetworkAdaptersItemNetworkDetailsType as json.
+func (s EditVMNetworkAdaptersItemNetworkDetailsType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes GetVirtualMachineRequestInternalServerError from json.
-func (s *GetVirtualMachineRequestInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMNetworkAdaptersItemNetworkDetailsType from json.
+func (s *EditVMNetworkAdaptersItemNetworkDetailsType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetVirtualMachineRequestInternalServerError to nil")
+		return errors.New("invalid: unable to decode EditVMNetworkAdaptersItemNetworkDetailsType to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = GetVirtualMachineRequestInternalServerError(unwrapped)
+	// Try to use constant string.
+	switch EditVMNetworkAdaptersItemNetworkDetailsType(v) {
+	case EditVMNetworkAdaptersItemNetworkDetailsTypeSTANDARDPORTGROUP:
+		*s = EditVMNetworkAdaptersItemNetworkDetailsTypeSTANDARDPORTGROUP
+	default:
+		*s = EditVMNetworkAdaptersItemNetworkDetailsType(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetVirtualMachineRequestInternalServerError) MarshalJSON() ([]byte, error) {
+func (s EditVMNetworkAdaptersItemNetworkDetailsType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetVirtualMachineRequestInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *EditVMNetworkAdaptersItemNetworkDetailsType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetVirtualMachineRequestListForbidden as json.
-func (s *GetVirtualMachineRequestListForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes EditVMNetworkAdaptersItemOperation as json.
+func (s EditVMNetworkAdaptersItemOperation) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes GetVirtualMachineRequestListForbidden from json.
-func (s *GetVirtualMachineRequestListForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMNetworkAdaptersItemOperation from json.
+func (s *EditVMNetworkAdaptersItemOperation) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetVirtualMachineRequestListForbidden to nil")
+		return errors.New("invalid: unable to decode EditVMNetworkAdaptersItemOperation to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = GetVirtualMachineRequestListForbidden(unwrapped)
+	// Try to use constant string.
+	switch EditVMNetworkAdaptersItemOperation(v) {
+	case EditVMNetworkAdaptersItemOperationADD:
+		*s = EditVMNetworkAdaptersItemOperationADD
+	case EditVMNetworkAdaptersItemOperationEDIT:
+		*s = EditVMNetworkAdaptersItemOperationEDIT
+	case EditVMNetworkAdaptersItemOperationDELETE:
+		*s = EditVMNetworkAdaptersItemOperationDELETE
+	default:
+		*s = EditVMNetworkAdaptersItemOperation(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetVirtualMachineRequestListForbidden) MarshalJSON() ([]byte, error) {
+func (s EditVMNetworkAdaptersItemOperation) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetVirtualMachineRequestListForbidden) UnmarshalJSON(data []byte) error {
+func (s *EditVMNetworkAdaptersItemOperation) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetVirtualMachineRequestListInternalServerError as json.
-func (s *GetVirtualMachineRequestListInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes EditVMNetworkAdaptersItemType as json.
+func (s EditVMNetworkAdaptersItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes GetVirtualMachineRequestListInternalServerError from json.
-func (s *GetVirtualMachineRequestListInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMNetworkAdaptersItemType from json.
+func (s *EditVMNetworkAdaptersItemType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetVirtualMachineRequestListInternalServerError to nil")
+		return errors.New("invalid: unable to decode EditVMNetworkAdaptersItemType to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = GetVirtualMachineRequestListInternalServerError(unwrapped)
+	// Try to use constant string.
+	switch EditVMNetworkAdaptersItemType(v) {
+	case EditVMNetworkAdaptersItemTypeE1000:
+		*s = EditVMNetworkAdaptersItemTypeE1000
+	case EditVMNetworkAdaptersItemTypeE1000E:
+		*s = EditVMNetworkAdaptersItemTypeE1000E
+	case EditVMNetworkAdaptersItemTypePCNET32:
+		*s = EditVMNetworkAdaptersItemTypePCNET32
+	case EditVMNetworkAdaptersItemTypeVMXNET:
+		*s = EditVMNetworkAdaptersItemTypeVMXNET
+	case EditVMNetworkAdaptersItemTypeVMXNET2:
+		*s = EditVMNetworkAdaptersItemTypeVMXNET2
+	case EditVMNetworkAdaptersItemTypeVMXNET3:
+		*s = EditVMNetworkAdaptersItemTypeVMXNET3
+	default:
+		*s = EditVMNetworkAdaptersItemType(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetVirtualMachineRequestListInternalServerError) MarshalJSON() ([]byte, error) {
+func (s EditVMNetworkAdaptersItemType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetVirtualMachineRequestListInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *EditVMNetworkAdaptersItemType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetVirtualMachineRequestListNotFound as json.
-func (s *GetVirtualMachineRequestListNotFound) Encode(e *jx.Encoder) {
+// Encode encodes EditVMNotFound as json.
+func (s *EditVMNotFound) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetVirtualMachineRequestListNotFound from json.
-func (s *GetVirtualMachineRequestListNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMNotFound from json.
+func (s *EditVMNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetVirtualMachineRequestListNotFound to nil")
+		return errors.New("invalid: unable to decode EditVMNotFound to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -1384,34 +1439,34 @@ func (s *GetVirtualMachineRequestListNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetVirtualMachineRequestListNotFound(unwrapped)
+	*s = EditVMNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetVirtualMachineRequestListNotFound) MarshalJSON() ([]byte, error) {
+func (s *EditVMNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetVirtualMachineRequestListNotFound) UnmarshalJSON(data []byte) error {
+func (s *EditVMNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetVirtualMachineRequestListUnauthorized as json.
-func (s *GetVirtualMachineRequestListUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes EditVMServiceUnavailable as json.
+func (s *EditVMServiceUnavailable) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetVirtualMachineRequestListUnauthorized from json.
-func (s *GetVirtualMachineRequestListUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMServiceUnavailable from json.
+func (s *EditVMServiceUnavailable) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetVirtualMachineRequestListUnauthorized to nil")
+		return errors.New("invalid: unable to decode EditVMServiceUnavailable to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -1422,34 +1477,34 @@ func (s *GetVirtualMachineRequestListUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetVirtualMachineRequestListUnauthorized(unwrapped)
+	*s = EditVMServiceUnavailable(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetVirtualMachineRequestListUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *EditVMServiceUnavailable) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetVirtualMachineRequestListUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *EditVMServiceUnavailable) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetVirtualMachineRequestNotFound as json.
-func (s *GetVirtualMachineRequestNotFound) Encode(e *jx.Encoder) {
+// Encode encodes EditVMUnauthorized as json.
+func (s *EditVMUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes GetVirtualMachineRequestNotFound from json.
-func (s *GetVirtualMachineRequestNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMUnauthorized from json.
+func (s *EditVMUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetVirtualMachineRequestNotFound to nil")
+		return errors.New("invalid: unable to decode EditVMUnauthorized to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -1460,586 +1515,659 @@ func (s *GetVirtualMachineRequestNotFound) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = GetVirtualMachineRequestNotFound(unwrapped)
+	*s = EditVMUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetVirtualMachineRequestNotFound) MarshalJSON() ([]byte, error) {
+func (s *EditVMUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetVirtualMachineRequestNotFound) UnmarshalJSON(data []byte) error {
+func (s *EditVMUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetVirtualMachineRequestUnauthorized as json.
-func (s *GetVirtualMachineRequestUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *EditVMVirtualDisksItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *EditVMVirtualDisksItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.DiskConfig.Set {
+			e.FieldStart("diskConfig")
+			s.DiskConfig.Encode(e)
+		}
+	}
+	{
+		if s.Operation.Set {
+			e.FieldStart("operation")
+			s.Operation.Encode(e)
+		}
+	}
 }
 
-// Decode decodes GetVirtualMachineRequestUnauthorized from json.
-func (s *GetVirtualMachineRequestUnauthorized) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfEditVMVirtualDisksItem = [2]string{
+	0: "diskConfig",
+	1: "operation",
+}
+
+// Decode decodes EditVMVirtualDisksItem from json.
+func (s *EditVMVirtualDisksItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetVirtualMachineRequestUnauthorized to nil")
+		return errors.New("invalid: unable to decode EditVMVirtualDisksItem to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "diskConfig":
+			if err := func() error {
+				s.DiskConfig.Reset()
+				if err := s.DiskConfig.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"diskConfig\"")
+			}
+		case "operation":
+			if err := func() error {
+				s.Operation.Reset()
+				if err := s.Operation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"operation\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode EditVMVirtualDisksItem")
 	}
-	*s = GetVirtualMachineRequestUnauthorized(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetVirtualMachineRequestUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *EditVMVirtualDisksItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetVirtualMachineRequestUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *EditVMVirtualDisksItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *HCIDeployVM) Encode(e *jx.Encoder) {
+func (s *EditVMVirtualDisksItemDiskConfig) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *HCIDeployVM) encodeFields(e *jx.Encoder) {
+func (s *EditVMVirtualDisksItemDiskConfig) encodeFields(e *jx.Encoder) {
 	{
-		if s.Destination.Set {
-			e.FieldStart("destination")
-			s.Destination.Encode(e)
+		if s.CapacityInMb.Set {
+			e.FieldStart("capacityInMb")
+			s.CapacityInMb.Encode(e)
 		}
 	}
 	{
-		if s.ImageSource.Set {
-			e.FieldStart("imageSource")
-			s.ImageSource.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
 	}
 	{
-		if s.NetworkConfig.Set {
-			e.FieldStart("networkConfig")
-			s.NetworkConfig.Encode(e)
+		if s.RetainFiles.Set {
+			e.FieldStart("retainFiles")
+			s.RetainFiles.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("storageConfig")
-		s.StorageConfig.Encode(e)
-	}
-	{
-		e.FieldStart("vmConfig")
-		s.VmConfig.Encode(e)
-	}
-	{
-		if s.VmPolicy != nil {
-			e.FieldStart("vmPolicy")
-			e.ArrStart()
-			for _, elem := range s.VmPolicy {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfHCIDeployVM = [6]string{
-	0: "destination",
-	1: "imageSource",
-	2: "networkConfig",
-	3: "storageConfig",
-	4: "vmConfig",
-	5: "vmPolicy",
+var jsonFieldsNameOfEditVMVirtualDisksItemDiskConfig = [4]string{
+	0: "capacityInMb",
+	1: "id",
+	2: "retainFiles",
+	3: "type",
 }
 
-// Decode decodes HCIDeployVM from json.
-func (s *HCIDeployVM) Decode(d *jx.Decoder) error {
+// Decode decodes EditVMVirtualDisksItemDiskConfig from json.
+func (s *EditVMVirtualDisksItemDiskConfig) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVM to nil")
+		return errors.New("invalid: unable to decode EditVMVirtualDisksItemDiskConfig to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "destination":
-			if err := func() error {
-				s.Destination.Reset()
-				if err := s.Destination.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"destination\"")
-			}
-		case "imageSource":
-			if err := func() error {
-				s.ImageSource.Reset()
-				if err := s.ImageSource.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"imageSource\"")
-			}
-		case "networkConfig":
+		case "capacityInMb":
 			if err := func() error {
-				s.NetworkConfig.Reset()
-				if err := s.NetworkConfig.Decode(d); err != nil {
+				s.CapacityInMb.Reset()
+				if err := s.CapacityInMb.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"networkConfig\"")
+				return errors.Wrap(err, "decode field \"capacityInMb\"")
 			}
-		case "storageConfig":
-			requiredBitSet[0] |= 1 << 3
+		case "id":
 			if err := func() error {
-				if err := s.StorageConfig.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"storageConfig\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "vmConfig":
-			requiredBitSet[0] |= 1 << 4
+		case "retainFiles":
 			if err := func() error {
-				if err := s.VmConfig.Decode(d); err != nil {
+				s.RetainFiles.Reset()
+				if err := s.RetainFiles.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vmConfig\"")
+				return errors.Wrap(err, "decode field \"retainFiles\"")
 			}
-		case "vmPolicy":
+		case "type":
 			if err := func() error {
-				s.VmPolicy = make([]HCIDeployVMVmPolicyItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem HCIDeployVMVmPolicyItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.VmPolicy = append(s.VmPolicy, elem)
-					return nil
-				}); err != nil {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vmPolicy\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode HCIDeployVM")
+		return errors.Wrap(err, "decode EditVMVirtualDisksItemDiskConfig")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00011000,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfHCIDeployVM) {
-					name = jsonFieldsNameOfHCIDeployVM[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *EditVMVirtualDisksItemDiskConfig) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EditVMVirtualDisksItemDiskConfig) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EditVMVirtualDisksItemDiskConfigType as json.
+func (s EditVMVirtualDisksItemDiskConfigType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes EditVMVirtualDisksItemDiskConfigType from json.
+func (s *EditVMVirtualDisksItemDiskConfigType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EditVMVirtualDisksItemDiskConfigType to nil")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch EditVMVirtualDisksItemDiskConfigType(v) {
+	case EditVMVirtualDisksItemDiskConfigTypeIDE:
+		*s = EditVMVirtualDisksItemDiskConfigTypeIDE
+	case EditVMVirtualDisksItemDiskConfigTypeSCSI:
+		*s = EditVMVirtualDisksItemDiskConfigTypeSCSI
+	case EditVMVirtualDisksItemDiskConfigTypeSATA:
+		*s = EditVMVirtualDisksItemDiskConfigTypeSATA
+	case EditVMVirtualDisksItemDiskConfigTypeNVME:
+		*s = EditVMVirtualDisksItemDiskConfigTypeNVME
+	default:
+		*s = EditVMVirtualDisksItemDiskConfigType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HCIDeployVM) MarshalJSON() ([]byte, error) {
+func (s EditVMVirtualDisksItemDiskConfigType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVM) UnmarshalJSON(data []byte) error {
+func (s *EditVMVirtualDisksItemDiskConfigType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMBadRequest as json.
-func (s *HCIDeployVMBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode encodes EditVMVirtualDisksItemOperation as json.
+func (s EditVMVirtualDisksItemOperation) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
 
-	unwrapped.Encode(e)
+// Decode decodes EditVMVirtualDisksItemOperation from json.
+func (s *EditVMVirtualDisksItemOperation) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EditVMVirtualDisksItemOperation to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch EditVMVirtualDisksItemOperation(v) {
+	case EditVMVirtualDisksItemOperationADD:
+		*s = EditVMVirtualDisksItemOperationADD
+	case EditVMVirtualDisksItemOperationEDIT:
+		*s = EditVMVirtualDisksItemOperationEDIT
+	case EditVMVirtualDisksItemOperationDELETE:
+		*s = EditVMVirtualDisksItemOperationDELETE
+	default:
+		*s = EditVMVirtualDisksItemOperation(v)
+	}
+
+	return nil
 }
 
-// Decode decodes HCIDeployVMBadRequest from json.
-func (s *HCIDeployVMBadRequest) Decode(d *jx.Decoder) error {
+// MarshalJSON implements stdjson.Marshaler.
+func (s EditVMVirtualDisksItemOperation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EditVMVirtualDisksItemOperation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *EmptyResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *EmptyResponse) encodeFields(e *jx.Encoder) {
+}
+
+var jsonFieldsNameOfEmptyResponse = [0]string{}
+
+// Decode decodes EmptyResponse from json.
+func (s *EmptyResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMBadRequest to nil")
+		return errors.New("invalid: unable to decode EmptyResponse to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		default:
+			return d.Skip()
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode EmptyResponse")
 	}
-	*s = HCIDeployVMBadRequest(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HCIDeployVMBadRequest) MarshalJSON() ([]byte, error) {
+func (s *EmptyResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMBadRequest) UnmarshalJSON(data []byte) error {
+func (s *EmptyResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *HCIDeployVMDestination) Encode(e *jx.Encoder) {
+func (s *ErrorResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *HCIDeployVMDestination) encodeFields(e *jx.Encoder) {
+func (s *ErrorResponse) encodeFields(e *jx.Encoder) {
 	{
-		if s.ClusterId.Set {
-			e.FieldStart("clusterId")
-			s.ClusterId.Encode(e)
-		}
+		e.FieldStart("debugId")
+		e.Str(s.DebugId)
 	}
 	{
-		if s.FolderId.Set {
-			e.FieldStart("folderId")
-			s.FolderId.Encode(e)
-		}
+		e.FieldStart("errorCode")
+		e.Str(s.ErrorCode)
 	}
 	{
-		if s.HostId.Set {
-			e.FieldStart("hostId")
-			s.HostId.Encode(e)
-		}
+		e.FieldStart("httpStatusCode")
+		e.Int(s.HttpStatusCode)
 	}
 	{
-		if s.ResourcePoolId.Set {
-			e.FieldStart("resourcePoolId")
-			s.ResourcePoolId.Encode(e)
-		}
+		e.FieldStart("message")
+		e.Str(s.Message)
 	}
 }
 
-var jsonFieldsNameOfHCIDeployVMDestination = [4]string{
-	0: "clusterId",
-	1: "folderId",
-	2: "hostId",
-	3: "resourcePoolId",
+var jsonFieldsNameOfErrorResponse = [4]string{
+	0: "debugId",
+	1: "errorCode",
+	2: "httpStatusCode",
+	3: "message",
 }
 
-// Decode decodes HCIDeployVMDestination from json.
-func (s *HCIDeployVMDestination) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorResponse from json.
+func (s *ErrorResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMDestination to nil")
+		return errors.New("invalid: unable to decode ErrorResponse to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "clusterId":
+		case "debugId":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.ClusterId.Reset()
-				if err := s.ClusterId.Decode(d); err != nil {
+				v, err := d.Str()
+				s.DebugId = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"clusterId\"")
+				return errors.Wrap(err, "decode field \"debugId\"")
 			}
-		case "folderId":
+		case "errorCode":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.FolderId.Reset()
-				if err := s.FolderId.Decode(d); err != nil {
+				v, err := d.Str()
+				s.ErrorCode = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"folderId\"")
+				return errors.Wrap(err, "decode field \"errorCode\"")
 			}
-		case "hostId":
+		case "httpStatusCode":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.HostId.Reset()
-				if err := s.HostId.Decode(d); err != nil {
+				v, err := d.Int()
+				s.HttpStatusCode = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"hostId\"")
+				return errors.Wrap(err, "decode field \"httpStatusCode\"")
 			}
-		case "resourcePoolId":
+		case "message":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.ResourcePoolId.Reset()
-				if err := s.ResourcePoolId.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"resourcePoolId\"")
+				return errors.Wrap(err, "decode field \"message\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode HCIDeployVMDestination")
+		return errors.Wrap(err, "decode ErrorResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfErrorResponse) {
+					name = jsonFieldsNameOfErrorResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HCIDeployVMDestination) MarshalJSON() ([]byte, error) {
+func (s *ErrorResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMDestination) UnmarshalJSON(data []byte) error {
+func (s *ErrorResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMForbidden as json.
-func (s *HCIDeployVMForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode encodes Generation as json.
+func (s Generation) Encode(e *jx.Encoder) {
+	unwrapped := int64(s)
 
-	unwrapped.Encode(e)
+	e.Int64(unwrapped)
 }
 
-// Decode decodes HCIDeployVMForbidden from json.
-func (s *HCIDeployVMForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes Generation from json.
+func (s *Generation) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMForbidden to nil")
+		return errors.New("invalid: unable to decode Generation to nil")
 	}
-	var unwrapped ErrorResponse
+	var unwrapped int64
 	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
+		v, err := d.Int64()
+		unwrapped = int64(v)
+		if err != nil {
 			return err
 		}
 		return nil
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = HCIDeployVMForbidden(unwrapped)
+	*s = Generation(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HCIDeployVMForbidden) MarshalJSON() ([]byte, error) {
+func (s Generation) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMForbidden) UnmarshalJSON(data []byte) error {
+func (s *Generation) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *HCIDeployVMImageSource) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetVirtualMachineForbidden as json.
+func (s *GetVirtualMachineForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *HCIDeployVMImageSource) encodeFields(e *jx.Encoder) {
-	{
-		if s.ImageId.Set {
-			e.FieldStart("imageId")
-			s.ImageId.Encode(e)
-		}
-	}
-	{
-		if s.ImageName.Set {
-			e.FieldStart("imageName")
-			s.ImageName.Encode(e)
-		}
+// Decode decodes GetVirtualMachineForbidden from json.
+func (s *GetVirtualMachineForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetVirtualMachineForbidden to nil")
 	}
-	{
-		if s.ImageSourceType.Set {
-			e.FieldStart("imageSourceType")
-			s.ImageSourceType.Encode(e)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetVirtualMachineForbidden(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfHCIDeployVMImageSource = [3]string{
-	0: "imageId",
-	1: "imageName",
-	2: "imageSourceType",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetVirtualMachineForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes HCIDeployVMImageSource from json.
-func (s *HCIDeployVMImageSource) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetVirtualMachineForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetVirtualMachineInternalServerError as json.
+func (s *GetVirtualMachineInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetVirtualMachineInternalServerError from json.
+func (s *GetVirtualMachineInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMImageSource to nil")
+		return errors.New("invalid: unable to decode GetVirtualMachineInternalServerError to nil")
 	}
-	s.setDefaults()
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "imageId":
-			if err := func() error {
-				s.ImageId.Reset()
-				if err := s.ImageId.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"imageId\"")
-			}
-		case "imageName":
-			if err := func() error {
-				s.ImageName.Reset()
-				if err := s.ImageName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"imageName\"")
-			}
-		case "imageSourceType":
-			if err := func() error {
-				s.ImageSourceType.Reset()
-				if err := s.ImageSourceType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"imageSourceType\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode HCIDeployVMImageSource")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetVirtualMachineInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HCIDeployVMImageSource) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMImageSource) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMImageSourceImageSourceType as json.
-func (s HCIDeployVMImageSourceImageSourceType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode encodes GetVirtualMachineListBadRequest as json.
+func (s *GetVirtualMachineListBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes HCIDeployVMImageSourceImageSourceType from json.
-func (s *HCIDeployVMImageSourceImageSourceType) Decode(d *jx.Decoder) error {
+// Decode decodes GetVirtualMachineListBadRequest from json.
+func (s *GetVirtualMachineListBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMImageSourceImageSourceType to nil")
+		return errors.New("invalid: unable to decode GetVirtualMachineListBadRequest to nil")
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch HCIDeployVMImageSourceImageSourceType(v) {
-	case HCIDeployVMImageSourceImageSourceTypeHYPERVISORIMAGELIBRARY:
-		*s = HCIDeployVMImageSourceImageSourceTypeHYPERVISORIMAGELIBRARY
-	default:
-		*s = HCIDeployVMImageSourceImageSourceType(v)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetVirtualMachineListBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s HCIDeployVMImageSourceImageSourceType) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineListBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMImageSourceImageSourceType) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineListBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMInternalServerError as json.
-func (s *HCIDeployVMInternalServerError) Encode(e *jx.Encoder) {
+// Encode encodes GetVirtualMachineListForbidden as json.
+func (s *GetVirtualMachineListForbidden) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes HCIDeployVMInternalServerError from json.
-func (s *HCIDeployVMInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes GetVirtualMachineListForbidden from json.
+func (s *GetVirtualMachineListForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMInternalServerError to nil")
+		return errors.New("invalid: unable to decode GetVirtualMachineListForbidden to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -2050,398 +2178,338 @@ func (s *HCIDeployVMInternalServerError) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = HCIDeployVMInternalServerError(unwrapped)
+	*s = GetVirtualMachineListForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HCIDeployVMInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineListForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineListForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *HCIDeployVMNetworkConfig) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetVirtualMachineListInternalServerError as json.
+func (s *GetVirtualMachineListInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *HCIDeployVMNetworkConfig) encodeFields(e *jx.Encoder) {
-	{
-		if s.IpAllocationPolicy.Set {
-			e.FieldStart("ipAllocationPolicy")
-			s.IpAllocationPolicy.Encode(e)
-		}
+// Decode decodes GetVirtualMachineListInternalServerError from json.
+func (s *GetVirtualMachineListInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetVirtualMachineListInternalServerError to nil")
 	}
-	{
-		if s.NetworkMapping != nil {
-			e.FieldStart("networkMapping")
-			e.ArrStart()
-			for _, elem := range s.NetworkMapping {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetVirtualMachineListInternalServerError(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfHCIDeployVMNetworkConfig = [2]string{
-	0: "ipAllocationPolicy",
-	1: "networkMapping",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetVirtualMachineListInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes HCIDeployVMNetworkConfig from json.
-func (s *HCIDeployVMNetworkConfig) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetVirtualMachineListInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetVirtualMachineListServiceUnavailable as json.
+func (s *GetVirtualMachineListServiceUnavailable) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetVirtualMachineListServiceUnavailable from json.
+func (s *GetVirtualMachineListServiceUnavailable) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMNetworkConfig to nil")
+		return errors.New("invalid: unable to decode GetVirtualMachineListServiceUnavailable to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "ipAllocationPolicy":
-			if err := func() error {
-				s.IpAllocationPolicy.Reset()
-				if err := s.IpAllocationPolicy.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ipAllocationPolicy\"")
-			}
-		case "networkMapping":
-			if err := func() error {
-				s.NetworkMapping = make([]HCIDeployVMNetworkConfigNetworkMappingItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem HCIDeployVMNetworkConfigNetworkMappingItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.NetworkMapping = append(s.NetworkMapping, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"networkMapping\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode HCIDeployVMNetworkConfig")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetVirtualMachineListServiceUnavailable(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HCIDeployVMNetworkConfig) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineListServiceUnavailable) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMNetworkConfig) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineListServiceUnavailable) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMNetworkConfigIpAllocationPolicy as json.
-func (s HCIDeployVMNetworkConfigIpAllocationPolicy) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode encodes GetVirtualMachineListUnauthorized as json.
+func (s *GetVirtualMachineListUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes HCIDeployVMNetworkConfigIpAllocationPolicy from json.
-func (s *HCIDeployVMNetworkConfigIpAllocationPolicy) Decode(d *jx.Decoder) error {
+// Decode decodes GetVirtualMachineListUnauthorized from json.
+func (s *GetVirtualMachineListUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMNetworkConfigIpAllocationPolicy to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+		return errors.New("invalid: unable to decode GetVirtualMachineListUnauthorized to nil")
 	}
-	// Try to use constant string.
-	switch HCIDeployVMNetworkConfigIpAllocationPolicy(v) {
-	case HCIDeployVMNetworkConfigIpAllocationPolicyDHCPPOLICY:
-		*s = HCIDeployVMNetworkConfigIpAllocationPolicyDHCPPOLICY
-	case HCIDeployVMNetworkConfigIpAllocationPolicyFIXEDPOLICY:
-		*s = HCIDeployVMNetworkConfigIpAllocationPolicyFIXEDPOLICY
-	default:
-		*s = HCIDeployVMNetworkConfigIpAllocationPolicy(v)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetVirtualMachineListUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s HCIDeployVMNetworkConfigIpAllocationPolicy) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineListUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMNetworkConfigIpAllocationPolicy) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineListUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *HCIDeployVMNetworkConfigNetworkMappingItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetVirtualMachineNotFound as json.
+func (s *GetVirtualMachineNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *HCIDeployVMNetworkConfigNetworkMappingItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
-		}
+// Decode decodes GetVirtualMachineNotFound from json.
+func (s *GetVirtualMachineNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetVirtualMachineNotFound to nil")
 	}
-	{
-		if s.Network.Set {
-			e.FieldStart("network")
-			s.Network.Encode(e)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetVirtualMachineNotFound(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfHCIDeployVMNetworkConfigNetworkMappingItem = [2]string{
-	0: "name",
-	1: "network",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetVirtualMachineNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes HCIDeployVMNetworkConfigNetworkMappingItem from json.
-func (s *HCIDeployVMNetworkConfigNetworkMappingItem) Decode(d *jx.Decoder) error {
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetVirtualMachineNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetVirtualMachineRequestForbidden as json.
+func (s *GetVirtualMachineRequestForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetVirtualMachineRequestForbidden from json.
+func (s *GetVirtualMachineRequestForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMNetworkConfigNetworkMappingItem to nil")
+		return errors.New("invalid: unable to decode GetVirtualMachineRequestForbidden to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "network":
-			if err := func() error {
-				s.Network.Reset()
-				if err := s.Network.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"network\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode HCIDeployVMNetworkConfigNetworkMappingItem")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetVirtualMachineRequestForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HCIDeployVMNetworkConfigNetworkMappingItem) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineRequestForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMNetworkConfigNetworkMappingItem) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineRequestForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *HCIDeployVMStorageConfig) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetVirtualMachineRequestInternalServerError as json.
+func (s *GetVirtualMachineRequestInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *HCIDeployVMStorageConfig) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("defaultDatastoreId")
-		e.Str(s.DefaultDatastoreId)
+// Decode decodes GetVirtualMachineRequestInternalServerError from json.
+func (s *GetVirtualMachineRequestInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetVirtualMachineRequestInternalServerError to nil")
 	}
-	{
-		if s.ProvisioningType.Set {
-			e.FieldStart("provisioningType")
-			s.ProvisioningType.Encode(e)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetVirtualMachineRequestInternalServerError(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfHCIDeployVMStorageConfig = [2]string{
-	0: "defaultDatastoreId",
-	1: "provisioningType",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetVirtualMachineRequestInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes HCIDeployVMStorageConfig from json.
-func (s *HCIDeployVMStorageConfig) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMStorageConfig to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "defaultDatastoreId":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.DefaultDatastoreId = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"defaultDatastoreId\"")
-			}
-		case "provisioningType":
-			if err := func() error {
-				s.ProvisioningType.Reset()
-				if err := s.ProvisioningType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provisioningType\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode HCIDeployVMStorageConfig")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetVirtualMachineRequestInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetVirtualMachineRequestListBadRequest as json.
+func (s *GetVirtualMachineRequestListBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetVirtualMachineRequestListBadRequest from json.
+func (s *GetVirtualMachineRequestListBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetVirtualMachineRequestListBadRequest to nil")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfHCIDeployVMStorageConfig) {
-					name = jsonFieldsNameOfHCIDeployVMStorageConfig[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
+	*s = GetVirtualMachineRequestListBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HCIDeployVMStorageConfig) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineRequestListBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMStorageConfig) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineRequestListBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMStorageConfigProvisioningType as json.
-func (s HCIDeployVMStorageConfigProvisioningType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode encodes GetVirtualMachineRequestListForbidden as json.
+func (s *GetVirtualMachineRequestListForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes HCIDeployVMStorageConfigProvisioningType from json.
-func (s *HCIDeployVMStorageConfigProvisioningType) Decode(d *jx.Decoder) error {
+// Decode decodes GetVirtualMachineRequestListForbidden from json.
+func (s *GetVirtualMachineRequestListForbidden) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMStorageConfigProvisioningType to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+		return errors.New("invalid: unable to decode GetVirtualMachineRequestListForbidden to nil")
 	}
-	// Try to use constant string.
-	switch HCIDeployVMStorageConfigProvisioningType(v) {
-	case HCIDeployVMStorageConfigProvisioningTypeTHIN:
-		*s = HCIDeployVMStorageConfigProvisioningTypeTHIN
-	case HCIDeployVMStorageConfigProvisioningTypeTHICK:
-		*s = HCIDeployVMStorageConfigProvisioningTypeTHICK
-	default:
-		*s = HCIDeployVMStorageConfigProvisioningType(v)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetVirtualMachineRequestListForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s HCIDeployVMStorageConfigProvisioningType) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineRequestListForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMStorageConfigProvisioningType) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineRequestListForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMUnauthorized as json.
-func (s *HCIDeployVMUnauthorized) Encode(e *jx.Encoder) {
+// Encode encodes GetVirtualMachineRequestListInternalServerError as json.
+func (s *GetVirtualMachineRequestListInternalServerError) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes HCIDeployVMUnauthorized from json.
-func (s *HCIDeployVMUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes GetVirtualMachineRequestListInternalServerError from json.
+func (s *GetVirtualMachineRequestListInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMUnauthorized to nil")
+		return errors.New("invalid: unable to decode GetVirtualMachineRequestListInternalServerError to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -2452,1133 +2520,1330 @@ func (s *HCIDeployVMUnauthorized) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = HCIDeployVMUnauthorized(unwrapped)
+	*s = GetVirtualMachineRequestListInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HCIDeployVMUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineRequestListInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineRequestListInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *HCIDeployVMVmConfig) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetVirtualMachineRequestListNotFound as json.
+func (s *GetVirtualMachineRequestListNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *HCIDeployVMVmConfig) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("acceptEula")
-		e.Bool(s.AcceptEula)
-	}
-	{
-		if s.Annotation.Set {
-			e.FieldStart("annotation")
-			s.Annotation.Encode(e)
-		}
-	}
-	{
-		if s.Locale.Set {
-			e.FieldStart("locale")
-			s.Locale.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+// Decode decodes GetVirtualMachineRequestListNotFound from json.
+func (s *GetVirtualMachineRequestListNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetVirtualMachineRequestListNotFound to nil")
 	}
-	{
-		if s.NumberOfVms.Set {
-			e.FieldStart("numberOfVms")
-			s.NumberOfVms.Encode(e)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	{
-		if s.PowerOn.Set {
-			e.FieldStart("powerOn")
-			s.PowerOn.Encode(e)
-		}
+	*s = GetVirtualMachineRequestListNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetVirtualMachineRequestListNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetVirtualMachineRequestListNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetVirtualMachineRequestListServiceUnavailable as json.
+func (s *GetVirtualMachineRequestListServiceUnavailable) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetVirtualMachineRequestListServiceUnavailable from json.
+func (s *GetVirtualMachineRequestListServiceUnavailable) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetVirtualMachineRequestListServiceUnavailable to nil")
 	}
-	{
-		if s.PropertyConfig != nil {
-			e.FieldStart("propertyConfig")
-			e.ArrStart()
-			for _, elem := range s.PropertyConfig {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetVirtualMachineRequestListServiceUnavailable(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfHCIDeployVMVmConfig = [7]string{
-	0: "acceptEula",
-	1: "annotation",
-	2: "locale",
-	3: "name",
-	4: "numberOfVms",
-	5: "powerOn",
-	6: "propertyConfig",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetVirtualMachineRequestListServiceUnavailable) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes HCIDeployVMVmConfig from json.
-func (s *HCIDeployVMVmConfig) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMVmConfig to nil")
-	}
-	var requiredBitSet [1]uint8
-	s.setDefaults()
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetVirtualMachineRequestListServiceUnavailable) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "acceptEula":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Bool()
-				s.AcceptEula = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"acceptEula\"")
-			}
-		case "annotation":
-			if err := func() error {
-				s.Annotation.Reset()
-				if err := s.Annotation.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"annotation\"")
-			}
-		case "locale":
-			if err := func() error {
-				s.Locale.Reset()
-				if err := s.Locale.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"locale\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "numberOfVms":
-			if err := func() error {
-				s.NumberOfVms.Reset()
-				if err := s.NumberOfVms.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"numberOfVms\"")
-			}
-		case "powerOn":
-			if err := func() error {
-				s.PowerOn.Reset()
-				if err := s.PowerOn.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"powerOn\"")
-			}
-		case "propertyConfig":
-			if err := func() error {
-				s.PropertyConfig = make([]HCIDeployVMVmConfigPropertyConfigItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem HCIDeployVMVmConfigPropertyConfigItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.PropertyConfig = append(s.PropertyConfig, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"propertyConfig\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode HCIDeployVMVmConfig")
+// Encode encodes GetVirtualMachineRequestListUnauthorized as json.
+func (s *GetVirtualMachineRequestListUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes GetVirtualMachineRequestListUnauthorized from json.
+func (s *GetVirtualMachineRequestListUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetVirtualMachineRequestListUnauthorized to nil")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfHCIDeployVMVmConfig) {
-					name = jsonFieldsNameOfHCIDeployVMVmConfig[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
+	*s = GetVirtualMachineRequestListUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HCIDeployVMVmConfig) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineRequestListUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMVmConfig) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineRequestListUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *HCIDeployVMVmConfigPropertyConfigItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *HCIDeployVMVmConfigPropertyConfigItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Key.Set {
-			e.FieldStart("key")
-			s.Key.Encode(e)
-		}
-	}
-	{
-		if s.Value.Set {
-			e.FieldStart("value")
-			s.Value.Encode(e)
-		}
-	}
-}
+// Encode encodes GetVirtualMachineRequestNotFound as json.
+func (s *GetVirtualMachineRequestNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
 
-var jsonFieldsNameOfHCIDeployVMVmConfigPropertyConfigItem = [2]string{
-	0: "key",
-	1: "value",
+	unwrapped.Encode(e)
 }
 
-// Decode decodes HCIDeployVMVmConfigPropertyConfigItem from json.
-func (s *HCIDeployVMVmConfigPropertyConfigItem) Decode(d *jx.Decoder) error {
+// Decode decodes GetVirtualMachineRequestNotFound from json.
+func (s *GetVirtualMachineRequestNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMVmConfigPropertyConfigItem to nil")
+		return errors.New("invalid: unable to decode GetVirtualMachineRequestNotFound to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "key":
-			if err := func() error {
-				s.Key.Reset()
-				if err := s.Key.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"key\"")
-			}
-		case "value":
-			if err := func() error {
-				s.Value.Reset()
-				if err := s.Value.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"value\"")
-			}
-		default:
-			return d.Skip()
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode HCIDeployVMVmConfigPropertyConfigItem")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetVirtualMachineRequestNotFound(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HCIDeployVMVmConfigPropertyConfigItem) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineRequestNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMVmConfigPropertyConfigItem) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineRequestNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *HCIDeployVMVmPolicyItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GetVirtualMachineRequestServiceUnavailable as json.
+func (s *GetVirtualMachineRequestServiceUnavailable) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *HCIDeployVMVmPolicyItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
+// Decode decodes GetVirtualMachineRequestServiceUnavailable from json.
+func (s *GetVirtualMachineRequestServiceUnavailable) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetVirtualMachineRequestServiceUnavailable to nil")
 	}
-	{
-		if s.Type.Set {
-			e.FieldStart("type")
-			s.Type.Encode(e)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetVirtualMachineRequestServiceUnavailable(unwrapped)
+	return nil
 }
 
-var jsonFieldsNameOfHCIDeployVMVmPolicyItem = [2]string{
-	0: "id",
-	1: "type",
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetVirtualMachineRequestServiceUnavailable) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes HCIDeployVMVmPolicyItem from json.
-func (s *HCIDeployVMVmPolicyItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMVmPolicyItem to nil")
-	}
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetVirtualMachineRequestServiceUnavailable) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "id":
-			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "type":
-			if err := func() error {
-				s.Type.Reset()
-				if err := s.Type.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode HCIDeployVMVmPolicyItem")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *HCIDeployVMVmPolicyItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMVmPolicyItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
+// Encode encodes GetVirtualMachineRequestUnauthorized as json.
+func (s *GetVirtualMachineRequestUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
 
-// Encode encodes HCIDeployVMVmPolicyItemType as json.
-func (s HCIDeployVMVmPolicyItemType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+	unwrapped.Encode(e)
 }
 
-// Decode decodes HCIDeployVMVmPolicyItemType from json.
-func (s *HCIDeployVMVmPolicyItemType) Decode(d *jx.Decoder) error {
+// Decode decodes GetVirtualMachineRequestUnauthorized from json.
+func (s *GetVirtualMachineRequestUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HCIDeployVMVmPolicyItemType to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+		return errors.New("invalid: unable to decode GetVirtualMachineRequestUnauthorized to nil")
 	}
-	// Try to use constant string.
-	switch HCIDeployVMVmPolicyItemType(v) {
-	case HCIDeployVMVmPolicyItemTypeVMPROTECTIONPOLICY:
-		*s = HCIDeployVMVmPolicyItemTypeVMPROTECTIONPOLICY
-	case HCIDeployVMVmPolicyItemTypeVMPROVISIONINGPOLICY:
-		*s = HCIDeployVMVmPolicyItemTypeVMPROVISIONINGPOLICY
-	default:
-		*s = HCIDeployVMVmPolicyItemType(v)
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetVirtualMachineRequestUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s HCIDeployVMVmPolicyItemType) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineRequestUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HCIDeployVMVmPolicyItemType) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineRequestUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes bool as json.
-func (o OptBool) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Bool(bool(o.Value))
+// Encode encodes GetVirtualMachineServiceUnavailable as json.
+func (s *GetVirtualMachineServiceUnavailable) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes bool from json.
-func (o *OptBool) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptBool to nil")
+// Decode decodes GetVirtualMachineServiceUnavailable from json.
+func (s *GetVirtualMachineServiceUnavailable) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetVirtualMachineServiceUnavailable to nil")
 	}
-	o.Set = true
-	v, err := d.Bool()
-	if err != nil {
-		return err
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	o.Value = bool(v)
+	*s = GetVirtualMachineServiceUnavailable(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptBool) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineServiceUnavailable) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptBool) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineServiceUnavailable) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMNetworkAdaptersItemNetworkDetails as json.
-func (o OptEditVMNetworkAdaptersItemNetworkDetails) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes GetVirtualMachineUnauthorized as json.
+func (s *GetVirtualMachineUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes EditVMNetworkAdaptersItemNetworkDetails from json.
-func (o *OptEditVMNetworkAdaptersItemNetworkDetails) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptEditVMNetworkAdaptersItemNetworkDetails to nil")
+// Decode decodes GetVirtualMachineUnauthorized from json.
+func (s *GetVirtualMachineUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetVirtualMachineUnauthorized to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = GetVirtualMachineUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptEditVMNetworkAdaptersItemNetworkDetails) MarshalJSON() ([]byte, error) {
+func (s *GetVirtualMachineUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptEditVMNetworkAdaptersItemNetworkDetails) UnmarshalJSON(data []byte) error {
+func (s *GetVirtualMachineUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMNetworkAdaptersItemNetworkDetailsType as json.
-func (o OptEditVMNetworkAdaptersItemNetworkDetailsType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode implements json.Marshaler.
+func (s *HCIDeployVM) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes EditVMNetworkAdaptersItemNetworkDetailsType from json.
-func (o *OptEditVMNetworkAdaptersItemNetworkDetailsType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptEditVMNetworkAdaptersItemNetworkDetailsType to nil")
+// encodeFields encodes fields.
+func (s *HCIDeployVM) encodeFields(e *jx.Encoder) {
+	{
+		if s.Destination.Set {
+			e.FieldStart("destination")
+			s.Destination.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.ImageSource.Set {
+			e.FieldStart("imageSource")
+			s.ImageSource.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptEditVMNetworkAdaptersItemNetworkDetailsType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptEditVMNetworkAdaptersItemNetworkDetailsType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes EditVMNetworkAdaptersItemOperation as json.
-func (o OptEditVMNetworkAdaptersItemOperation) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	{
+		if s.NetworkConfig.Set {
+			e.FieldStart("networkConfig")
+			s.NetworkConfig.Encode(e)
+		}
 	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes EditVMNetworkAdaptersItemOperation from json.
-func (o *OptEditVMNetworkAdaptersItemOperation) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptEditVMNetworkAdaptersItemOperation to nil")
+	{
+		e.FieldStart("storageConfig")
+		s.StorageConfig.Encode(e)
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		e.FieldStart("vmConfig")
+		s.VmConfig.Encode(e)
+	}
+	{
+		if s.VmPolicy != nil {
+			e.FieldStart("vmPolicy")
+			e.ArrStart()
+			for _, elem := range s.VmPolicy {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptEditVMNetworkAdaptersItemOperation) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptEditVMNetworkAdaptersItemOperation) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfHCIDeployVM = [6]string{
+	0: "destination",
+	1: "imageSource",
+	2: "networkConfig",
+	3: "storageConfig",
+	4: "vmConfig",
+	5: "vmPolicy",
 }
 
-// Encode encodes EditVMNetworkAdaptersItemType as json.
-func (o OptEditVMNetworkAdaptersItemType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes HCIDeployVM from json.
+func (s *HCIDeployVM) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVM to nil")
 	}
-	e.Str(string(o.Value))
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes EditVMNetworkAdaptersItemType from json.
-func (o *OptEditVMNetworkAdaptersItemType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptEditVMNetworkAdaptersItemType to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "destination":
+			if err := func() error {
+				s.Destination.Reset()
+				if err := s.Destination.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"destination\"")
+			}
+		case "imageSource":
+			if err := func() error {
+				s.ImageSource.Reset()
+				if err := s.ImageSource.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"imageSource\"")
+			}
+		case "networkConfig":
+			if err := func() error {
+				s.NetworkConfig.Reset()
+				if err := s.NetworkConfig.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"networkConfig\"")
+			}
+		case "storageConfig":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.StorageConfig.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"storageConfig\"")
+			}
+		case "vmConfig":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.VmConfig.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vmConfig\"")
+			}
+		case "vmPolicy":
+			if err := func() error {
+				s.VmPolicy = make([]HCIDeployVMVmPolicyItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem HCIDeployVMVmPolicyItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.VmPolicy = append(s.VmPolicy, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vmPolicy\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode HCIDeployVM")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfHCIDeployVM) {
+					name = jsonFieldsNameOfHCIDeployVM[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptEditVMNetworkAdaptersItemType) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVM) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptEditVMNetworkAdaptersItemType) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVM) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMVirtualDisksItemDiskConfig as json.
-func (o OptEditVMVirtualDisksItemDiskConfig) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes HCIDeployVMBadRequest as json.
+func (s *HCIDeployVMBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes EditVMVirtualDisksItemDiskConfig from json.
-func (o *OptEditVMVirtualDisksItemDiskConfig) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptEditVMVirtualDisksItemDiskConfig to nil")
+// Decode decodes HCIDeployVMBadRequest from json.
+func (s *HCIDeployVMBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVMBadRequest to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = HCIDeployVMBadRequest(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptEditVMVirtualDisksItemDiskConfig) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVMBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptEditVMVirtualDisksItemDiskConfig) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMVirtualDisksItemDiskConfigType as json.
-func (o OptEditVMVirtualDisksItemDiskConfigType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode encodes HCIDeployVMConflict as json.
+func (s *HCIDeployVMConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes EditVMVirtualDisksItemDiskConfigType from json.
-func (o *OptEditVMVirtualDisksItemDiskConfigType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptEditVMVirtualDisksItemDiskConfigType to nil")
+// Decode decodes HCIDeployVMConflict from json.
+func (s *HCIDeployVMConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVMConflict to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = HCIDeployVMConflict(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptEditVMVirtualDisksItemDiskConfigType) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVMConflict) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptEditVMVirtualDisksItemDiskConfigType) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMConflict) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EditVMVirtualDisksItemOperation as json.
-func (o OptEditVMVirtualDisksItemOperation) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode implements json.Marshaler.
+func (s *HCIDeployVMDestination) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes EditVMVirtualDisksItemOperation from json.
-func (o *OptEditVMVirtualDisksItemOperation) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptEditVMVirtualDisksItemOperation to nil")
+// encodeFields encodes fields.
+func (s *HCIDeployVMDestination) encodeFields(e *jx.Encoder) {
+	{
+		if s.ClusterId.Set {
+			e.FieldStart("clusterId")
+			s.ClusterId.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.FolderId.Set {
+			e.FieldStart("folderId")
+			s.FolderId.Encode(e)
+		}
+	}
+	{
+		if s.HostId.Set {
+			e.FieldStart("hostId")
+			s.HostId.Encode(e)
+		}
+	}
+	{
+		if s.ResourcePoolId.Set {
+			e.FieldStart("resourcePoolId")
+			s.ResourcePoolId.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptEditVMVirtualDisksItemOperation) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptEditVMVirtualDisksItemOperation) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMDestination as json.
-func (o OptHCIDeployVMDestination) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+var jsonFieldsNameOfHCIDeployVMDestination = [4]string{
+	0: "clusterId",
+	1: "folderId",
+	2: "hostId",
+	3: "resourcePoolId",
 }
 
 // Decode decodes HCIDeployVMDestination from json.
-func (o *OptHCIDeployVMDestination) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptHCIDeployVMDestination to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+func (s *HCIDeployVMDestination) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVMDestination to nil")
 	}
-	return nil
-}
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptHCIDeployVMDestination) MarshalJSON() ([]byte, error) {
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "clusterId":
+			if err := func() error {
+				s.ClusterId.Reset()
+				if err := s.ClusterId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"clusterId\"")
+			}
+		case "folderId":
+			if err := func() error {
+				s.FolderId.Reset()
+				if err := s.FolderId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"folderId\"")
+			}
+		case "hostId":
+			if err := func() error {
+				s.HostId.Reset()
+				if err := s.HostId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hostId\"")
+			}
+		case "resourcePoolId":
+			if err := func() error {
+				s.ResourcePoolId.Reset()
+				if err := s.ResourcePoolId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourcePoolId\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode HCIDeployVMDestination")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *HCIDeployVMDestination) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptHCIDeployVMDestination) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMDestination) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMImageSource as json.
-func (o OptHCIDeployVMImageSource) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes HCIDeployVMForbidden as json.
+func (s *HCIDeployVMForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes HCIDeployVMImageSource from json.
-func (o *OptHCIDeployVMImageSource) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptHCIDeployVMImageSource to nil")
+// Decode decodes HCIDeployVMForbidden from json.
+func (s *HCIDeployVMForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVMForbidden to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = HCIDeployVMForbidden(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptHCIDeployVMImageSource) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVMForbidden) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptHCIDeployVMImageSource) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMForbidden) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMImageSourceImageSourceType as json.
-func (o OptHCIDeployVMImageSourceImageSourceType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *HCIDeployVMImageSource) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *HCIDeployVMImageSource) encodeFields(e *jx.Encoder) {
+	{
+		if s.ImageId.Set {
+			e.FieldStart("imageId")
+			s.ImageId.Encode(e)
+		}
+	}
+	{
+		if s.ImageName.Set {
+			e.FieldStart("imageName")
+			s.ImageName.Encode(e)
+		}
+	}
+	{
+		if s.ImageSourceType.Set {
+			e.FieldStart("imageSourceType")
+			s.ImageSourceType.Encode(e)
+		}
 	}
-	e.Str(string(o.Value))
 }
 
-// Decode decodes HCIDeployVMImageSourceImageSourceType from json.
-func (o *OptHCIDeployVMImageSourceImageSourceType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptHCIDeployVMImageSourceImageSourceType to nil")
+var jsonFieldsNameOfHCIDeployVMImageSource = [3]string{
+	0: "imageId",
+	1: "imageName",
+	2: "imageSourceType",
+}
+
+// Decode decodes HCIDeployVMImageSource from json.
+func (s *HCIDeployVMImageSource) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVMImageSource to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "imageId":
+			if err := func() error {
+				s.ImageId.Reset()
+				if err := s.ImageId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"imageId\"")
+			}
+		case "imageName":
+			if err := func() error {
+				s.ImageName.Reset()
+				if err := s.ImageName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"imageName\"")
+			}
+		case "imageSourceType":
+			if err := func() error {
+				s.ImageSourceType.Reset()
+				if err := s.ImageSourceType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"imageSourceType\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode HCIDeployVMImageSource")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptHCIDeployVMImageSourceImageSourceType) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVMImageSource) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptHCIDeployVMImageSourceImageSourceType) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMImageSource) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMNetworkConfig as json.
-func (o OptHCIDeployVMNetworkConfig) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes HCIDeployVMImageSourceImageSourceType as json.
+func (s HCIDeployVMImageSourceImageSourceType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes HCIDeployVMNetworkConfig from json.
-func (o *OptHCIDeployVMNetworkConfig) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptHCIDeployVMNetworkConfig to nil")
+// Decode decodes HCIDeployVMImageSourceImageSourceType from json.
+func (s *HCIDeployVMImageSourceImageSourceType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVMImageSourceImageSourceType to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
+	v, err := d.StrBytes()
+	if err != nil {
 		return err
 	}
+	// Try to use constant string.
+	switch HCIDeployVMImageSourceImageSourceType(v) {
+	case HCIDeployVMImageSourceImageSourceTypeHYPERVISORIMAGELIBRARY:
+		*s = HCIDeployVMImageSourceImageSourceTypeHYPERVISORIMAGELIBRARY
+	default:
+		*s = HCIDeployVMImageSourceImageSourceType(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptHCIDeployVMNetworkConfig) MarshalJSON() ([]byte, error) {
+func (s HCIDeployVMImageSourceImageSourceType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptHCIDeployVMNetworkConfig) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMImageSourceImageSourceType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMNetworkConfigIpAllocationPolicy as json.
-func (o OptHCIDeployVMNetworkConfigIpAllocationPolicy) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode encodes HCIDeployVMInternalServerError as json.
+func (s *HCIDeployVMInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes HCIDeployVMNetworkConfigIpAllocationPolicy from json.
-func (o *OptHCIDeployVMNetworkConfigIpAllocationPolicy) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptHCIDeployVMNetworkConfigIpAllocationPolicy to nil")
+// Decode decodes HCIDeployVMInternalServerError from json.
+func (s *HCIDeployVMInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVMInternalServerError to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
+	*s = HCIDeployVMInternalServerError(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptHCIDeployVMNetworkConfigIpAllocationPolicy) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVMInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptHCIDeployVMNetworkConfigIpAllocationPolicy) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMStorageConfigProvisioningType as json.
-func (o OptHCIDeployVMStorageConfigProvisioningType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *HCIDeployVMNetworkConfig) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *HCIDeployVMNetworkConfig) encodeFields(e *jx.Encoder) {
+	{
+		if s.IpAllocationPolicy.Set {
+			e.FieldStart("ipAllocationPolicy")
+			s.IpAllocationPolicy.Encode(e)
+		}
+	}
+	{
+		if s.NetworkMapping != nil {
+			e.FieldStart("networkMapping")
+			e.ArrStart()
+			for _, elem := range s.NetworkMapping {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-	e.Str(string(o.Value))
 }
 
-// Decode decodes HCIDeployVMStorageConfigProvisioningType from json.
-func (o *OptHCIDeployVMStorageConfigProvisioningType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptHCIDeployVMStorageConfigProvisioningType to nil")
+var jsonFieldsNameOfHCIDeployVMNetworkConfig = [2]string{
+	0: "ipAllocationPolicy",
+	1: "networkMapping",
+}
+
+// Decode decodes HCIDeployVMNetworkConfig from json.
+func (s *HCIDeployVMNetworkConfig) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVMNetworkConfig to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ipAllocationPolicy":
+			if err := func() error {
+				s.IpAllocationPolicy.Reset()
+				if err := s.IpAllocationPolicy.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ipAllocationPolicy\"")
+			}
+		case "networkMapping":
+			if err := func() error {
+				s.NetworkMapping = make([]HCIDeployVMNetworkConfigNetworkMappingItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem HCIDeployVMNetworkConfigNetworkMappingItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.NetworkMapping = append(s.NetworkMapping, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"networkMapping\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode HCIDeployVMNetworkConfig")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptHCIDeployVMStorageConfigProvisioningType) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVMNetworkConfig) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptHCIDeployVMStorageConfigProvisioningType) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMNetworkConfig) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes HCIDeployVMVmPolicyItemType as json.
-func (o OptHCIDeployVMVmPolicyItemType) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode encodes HCIDeployVMNetworkConfigIpAllocationPolicy as json.
+func (s HCIDeployVMNetworkConfigIpAllocationPolicy) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes HCIDeployVMVmPolicyItemType from json.
-func (o *OptHCIDeployVMVmPolicyItemType) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptHCIDeployVMVmPolicyItemType to nil")
+// Decode decodes HCIDeployVMNetworkConfigIpAllocationPolicy from json.
+func (s *HCIDeployVMNetworkConfigIpAllocationPolicy) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVMNetworkConfigIpAllocationPolicy to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
+	v, err := d.StrBytes()
+	if err != nil {
 		return err
 	}
+	// Try to use constant string.
+	switch HCIDeployVMNetworkConfigIpAllocationPolicy(v) {
+	case HCIDeployVMNetworkConfigIpAllocationPolicyDHCPPOLICY:
+		*s = HCIDeployVMNetworkConfigIpAllocationPolicyDHCPPOLICY
+	case HCIDeployVMNetworkConfigIpAllocationPolicyFIXEDPOLICY:
+		*s = HCIDeployVMNetworkConfigIpAllocationPolicyFIXEDPOLICY
+	default:
+		*s = HCIDeployVMNetworkConfigIpAllocationPolicy(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptHCIDeployVMVmPolicyItemType) MarshalJSON() ([]byte, error) {
+func (s HCIDeployVMNetworkConfigIpAllocationPolicy) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptHCIDeployVMVmPolicyItemType) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMNetworkConfigIpAllocationPolicy) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes int as json.
-func (o OptInt) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *HCIDeployVMNetworkConfigNetworkMappingItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *HCIDeployVMNetworkConfigNetworkMappingItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.Network.Set {
+			e.FieldStart("network")
+			s.Network.Encode(e)
+		}
 	}
-	e.Int(int(o.Value))
 }
 
-// Decode decodes int from json.
-func (o *OptInt) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptInt to nil")
+var jsonFieldsNameOfHCIDeployVMNetworkConfigNetworkMappingItem = [2]string{
+	0: "name",
+	1: "network",
+}
+
+// Decode decodes HCIDeployVMNetworkConfigNetworkMappingItem from json.
+func (s *HCIDeployVMNetworkConfigNetworkMappingItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVMNetworkConfigNetworkMappingItem to nil")
 	}
-	o.Set = true
-	v, err := d.Int()
-	if err != nil {
-		return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "network":
+			if err := func() error {
+				s.Network.Reset()
+				if err := s.Network.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"network\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode HCIDeployVMNetworkConfigNetworkMappingItem")
 	}
-	o.Value = int(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptInt) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVMNetworkConfigNetworkMappingItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInt) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMNetworkConfigNetworkMappingItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes time.Time as json.
-func (o OptNilDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
-	}
-	format(e, o.Value)
+// Encode encodes HCIDeployVMServiceUnavailable as json.
+func (s *HCIDeployVMServiceUnavailable) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
 }
 
-// Decode decodes time.Time from json.
-func (o *OptNilDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilDateTime to nil")
+// Decode decodes HCIDeployVMServiceUnavailable from json.
+func (s *HCIDeployVMServiceUnavailable) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVMServiceUnavailable to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
 			return err
 		}
-
-		var v time.Time
-		o.Value = v
-		o.Set = true
-		o.Null = true
 		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-	o.Set = true
-	o.Null = false
-	v, err := format(d)
-	if err != nil {
-		return err
-	}
-	o.Value = v
+	*s = HCIDeployVMServiceUnavailable(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilDateTime) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVMServiceUnavailable) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
-	s.Encode(&e, json.EncodeDateTime)
+	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilDateTime) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMServiceUnavailable) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
-	return s.Decode(d, json.DecodeDateTime)
+	return s.Decode(d)
 }
 
-// Encode encodes string as json.
-func (o OptString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode implements json.Marshaler.
+func (s *HCIDeployVMStorageConfig) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes string from json.
-func (o *OptString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptString to nil")
-	}
-	o.Set = true
-	v, err := d.Str()
-	if err != nil {
-		return err
+// encodeFields encodes fields.
+func (s *HCIDeployVMStorageConfig) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("defaultDatastoreId")
+		e.Str(s.DefaultDatastoreId)
+	}
+	{
+		if s.ProvisioningType.Set {
+			e.FieldStart("provisioningType")
+			s.ProvisioningType.Encode(e)
+		}
 	}
-	o.Value = string(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptString) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptString) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfHCIDeployVMStorageConfig = [2]string{
+	0: "defaultDatastoreId",
+	1: "provisioningType",
 }
 
-// Encode encodes VMRequestsListItems as json.
-func (o OptVMRequestsListItems) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes HCIDeployVMStorageConfig from json.
+func (s *HCIDeployVMStorageConfig) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVMStorageConfig to nil")
 	}
-	o.Value.Encode(e)
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes VMRequestsListItems from json.
-func (o *OptVMRequestsListItems) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptVMRequestsListItems to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "defaultDatastoreId":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.DefaultDatastoreId = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"defaultDatastoreId\"")
+			}
+		case "provisioningType":
+			if err := func() error {
+				s.ProvisioningType.Reset()
+				if err := s.ProvisioningType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provisioningType\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode HCIDeployVMStorageConfig")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfHCIDeployVMStorageConfig) {
+					name = jsonFieldsNameOfHCIDeployVMStorageConfig[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptVMRequestsListItems) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVMStorageConfig) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptVMRequestsListItems) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMStorageConfig) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMDeleteBadRequest as json.
-func (s *VMDeleteBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes HCIDeployVMStorageConfigProvisioningType as json.
+func (s HCIDeployVMStorageConfigProvisioningType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes VMDeleteBadRequest from json.
-func (s *VMDeleteBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes HCIDeployVMStorageConfigProvisioningType from json.
+func (s *HCIDeployVMStorageConfigProvisioningType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMDeleteBadRequest to nil")
+		return errors.New("invalid: unable to decode HCIDeployVMStorageConfigProvisioningType to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = VMDeleteBadRequest(unwrapped)
+	// Try to use constant string.
+	switch HCIDeployVMStorageConfigProvisioningType(v) {
+	case HCIDeployVMStorageConfigProvisioningTypeTHIN:
+		*s = HCIDeployVMStorageConfigProvisioningTypeTHIN
+	case HCIDeployVMStorageConfigProvisioningTypeTHICK:
+		*s = HCIDeployVMStorageConfigProvisioningTypeTHICK
+	default:
+		*s = HCIDeployVMStorageConfigProvisioningType(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMDeleteBadRequest) MarshalJSON() ([]byte, error) {
+func (s HCIDeployVMStorageConfigProvisioningType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMDeleteBadRequest) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMStorageConfigProvisioningType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMDeleteConflict as json.
-func (s *VMDeleteConflict) Encode(e *jx.Encoder) {
+// Encode encodes HCIDeployVMUnauthorized as json.
+func (s *HCIDeployVMUnauthorized) Encode(e *jx.Encoder) {
 	unwrapped := (*ErrorResponse)(s)
 
 	unwrapped.Encode(e)
 }
 
-// Decode decodes VMDeleteConflict from json.
-func (s *VMDeleteConflict) Decode(d *jx.Decoder) error {
+// Decode decodes HCIDeployVMUnauthorized from json.
+func (s *HCIDeployVMUnauthorized) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMDeleteConflict to nil")
+		return errors.New("invalid: unable to decode HCIDeployVMUnauthorized to nil")
 	}
 	var unwrapped ErrorResponse
 	if err := func() error {
@@ -3589,349 +3854,7072 @@ func (s *VMDeleteConflict) Decode(d *jx.Decoder) error {
 	}(); err != nil {
 		return errors.Wrap(err, "alias")
 	}
-	*s = VMDeleteConflict(unwrapped)
+	*s = HCIDeployVMUnauthorized(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMDeleteConflict) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVMUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMDeleteConflict) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMDeleteForbidden as json.
-func (s *VMDeleteForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *HCIDeployVMVmConfig) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes VMDeleteForbidden from json.
-func (s *VMDeleteForbidden) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMDeleteForbidden to nil")
+// encodeFields encodes fields.
+func (s *HCIDeployVMVmConfig) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("acceptEula")
+		e.Bool(s.AcceptEula)
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.Annotation.Set {
+			e.FieldStart("annotation")
+			s.Annotation.Encode(e)
+		}
+	}
+	{
+		if s.Locale.Set {
+			e.FieldStart("locale")
+			s.Locale.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.NumberOfVms.Set {
+			e.FieldStart("numberOfVms")
+			s.NumberOfVms.Encode(e)
+		}
+	}
+	{
+		if s.PowerOn.Set {
+			e.FieldStart("powerOn")
+			s.PowerOn.Encode(e)
+		}
+	}
+	{
+		if s.PropertyConfig != nil {
+			e.FieldStart("propertyConfig")
+			e.ArrStart()
+			for _, elem := range s.PropertyConfig {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = VMDeleteForbidden(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMDeleteForbidden) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMDeleteForbidden) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfHCIDeployVMVmConfig = [7]string{
+	0: "acceptEula",
+	1: "annotation",
+	2: "locale",
+	3: "name",
+	4: "numberOfVms",
+	5: "powerOn",
+	6: "propertyConfig",
 }
 
-// Encode encodes VMDeleteInternalServerError as json.
-func (s *VMDeleteInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes VMDeleteInternalServerError from json.
-func (s *VMDeleteInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes HCIDeployVMVmConfig from json.
+func (s *HCIDeployVMVmConfig) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMDeleteInternalServerError to nil")
+		return errors.New("invalid: unable to decode HCIDeployVMVmConfig to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	var requiredBitSet [1]uint8
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "acceptEula":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.AcceptEula = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"acceptEula\"")
+			}
+		case "annotation":
+			if err := func() error {
+				s.Annotation.Reset()
+				if err := s.Annotation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"annotation\"")
+			}
+		case "locale":
+			if err := func() error {
+				s.Locale.Reset()
+				if err := s.Locale.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"locale\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "numberOfVms":
+			if err := func() error {
+				s.NumberOfVms.Reset()
+				if err := s.NumberOfVms.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"numberOfVms\"")
+			}
+		case "powerOn":
+			if err := func() error {
+				s.PowerOn.Reset()
+				if err := s.PowerOn.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"powerOn\"")
+			}
+		case "propertyConfig":
+			if err := func() error {
+				s.PropertyConfig = make([]HCIDeployVMVmConfigPropertyConfigItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem HCIDeployVMVmConfigPropertyConfigItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.PropertyConfig = append(s.PropertyConfig, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"propertyConfig\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode HCIDeployVMVmConfig")
 	}
-	*s = VMDeleteInternalServerError(unwrapped)
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfHCIDeployVMVmConfig) {
+					name = jsonFieldsNameOfHCIDeployVMVmConfig[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMDeleteInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVMVmConfig) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMDeleteInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMVmConfig) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMDeleteNotFound as json.
-func (s *VMDeleteNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *HCIDeployVMVmConfigPropertyConfigItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes VMDeleteNotFound from json.
-func (s *VMDeleteNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMDeleteNotFound to nil")
+// encodeFields encodes fields.
+func (s *HCIDeployVMVmConfigPropertyConfigItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Key.Set {
+			e.FieldStart("key")
+			s.Key.Encode(e)
+		}
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.Value.Set {
+			e.FieldStart("value")
+			s.Value.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = VMDeleteNotFound(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMDeleteNotFound) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMDeleteNotFound) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes VMDeleteServiceUnavailable as json.
-func (s *VMDeleteServiceUnavailable) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfHCIDeployVMVmConfigPropertyConfigItem = [2]string{
+	0: "key",
+	1: "value",
 }
 
-// Decode decodes VMDeleteServiceUnavailable from json.
-func (s *VMDeleteServiceUnavailable) Decode(d *jx.Decoder) error {
+// Decode decodes HCIDeployVMVmConfigPropertyConfigItem from json.
+func (s *HCIDeployVMVmConfigPropertyConfigItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMDeleteServiceUnavailable to nil")
+		return errors.New("invalid: unable to decode HCIDeployVMVmConfigPropertyConfigItem to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "key":
+			if err := func() error {
+				s.Key.Reset()
+				if err := s.Key.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"key\"")
+			}
+		case "value":
+			if err := func() error {
+				s.Value.Reset()
+				if err := s.Value.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode HCIDeployVMVmConfigPropertyConfigItem")
 	}
-	*s = VMDeleteServiceUnavailable(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMDeleteServiceUnavailable) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVMVmConfigPropertyConfigItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMDeleteServiceUnavailable) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMVmConfigPropertyConfigItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMDeleteUnauthorized as json.
-func (s *VMDeleteUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *HCIDeployVMVmPolicyItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes VMDeleteUnauthorized from json.
-func (s *VMDeleteUnauthorized) Decode(d *jx.Decoder) error {
+// encodeFields encodes fields.
+func (s *HCIDeployVMVmPolicyItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfHCIDeployVMVmPolicyItem = [2]string{
+	0: "id",
+	1: "type",
+}
+
+// Decode decodes HCIDeployVMVmPolicyItem from json.
+func (s *HCIDeployVMVmPolicyItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMDeleteUnauthorized to nil")
+		return errors.New("invalid: unable to decode HCIDeployVMVmPolicyItem to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode HCIDeployVMVmPolicyItem")
 	}
-	*s = VMDeleteUnauthorized(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMDeleteUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *HCIDeployVMVmPolicyItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMDeleteUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *HCIDeployVMVmPolicyItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *VMDeployInstance) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes HCIDeployVMVmPolicyItemType as json.
+func (s HCIDeployVMVmPolicyItemType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// encodeFields encodes fields.
-func (s *VMDeployInstance) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("requestId")
-		e.Str(s.RequestId)
+// Decode decodes HCIDeployVMVmPolicyItemType from json.
+func (s *HCIDeployVMVmPolicyItemType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HCIDeployVMVmPolicyItemType to nil")
 	}
-	{
-		if s.VmId.Set {
-			e.FieldStart("vmId")
-			s.VmId.Encode(e)
-		}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	{
-		e.FieldStart("vmName")
-		e.Str(s.VmName)
+	// Try to use constant string.
+	switch HCIDeployVMVmPolicyItemType(v) {
+	case HCIDeployVMVmPolicyItemTypeVMPROTECTIONPOLICY:
+		*s = HCIDeployVMVmPolicyItemTypeVMPROTECTIONPOLICY
+	case HCIDeployVMVmPolicyItemTypeVMPROVISIONINGPOLICY:
+		*s = HCIDeployVMVmPolicyItemTypeVMPROVISIONINGPOLICY
+	default:
+		*s = HCIDeployVMVmPolicyItemType(v)
 	}
-	{
-		e.FieldStart("vmStatus")
-		e.Str(s.VmStatus)
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s HCIDeployVMVmPolicyItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *HCIDeployVMVmPolicyItemType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.VmStateMessage.Set {
-			e.FieldStart("vmStateMessage")
-			s.VmStateMessage.Encode(e)
-		}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
 	}
-	{
-		if s.CompletedAt.Set {
-			e.FieldStart("completedAt")
-			s.CompletedAt.Encode(e, json.EncodeDateTime)
-		}
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
 	}
+	o.Value = bool(v)
+	return nil
 }
 
-var jsonFieldsNameOfVMDeployInstance = [6]string{
-	0: "requestId",
-	1: "vmId",
-	2: "vmName",
-	3: "vmStatus",
-	4: "vmStateMessage",
-	5: "completedAt",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes VMDeployInstance from json.
-func (s *VMDeployInstance) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMDeployInstance to nil")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DataManagementJobInfo as json.
+func (o OptDataManagementJobInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	var requiredBitSet [1]uint8
+	o.Value.Encode(e)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "requestId":
-			requiredBitSet[0] |= 1 << 0
+// Decode decodes DataManagementJobInfo from json.
+func (o *OptDataManagementJobInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDataManagementJobInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDataManagementJobInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDataManagementJobInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DataManagementTemplateInfo as json.
+func (o OptDataManagementTemplateInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes DataManagementTemplateInfo from json.
+func (o *OptDataManagementTemplateInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDataManagementTemplateInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDataManagementTemplateInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDataManagementTemplateInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DatacenterInfo as json.
+func (o OptDatacenterInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes DatacenterInfo from json.
+func (o *OptDatacenterInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDatacenterInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDatacenterInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDatacenterInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EditVMCpuMemConfig as json.
+func (o OptEditVMCpuMemConfig) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes EditVMCpuMemConfig from json.
+func (o *OptEditVMCpuMemConfig) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEditVMCpuMemConfig to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEditVMCpuMemConfig) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEditVMCpuMemConfig) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EditVMCpuMemConfigCPU as json.
+func (o OptEditVMCpuMemConfigCPU) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes EditVMCpuMemConfigCPU from json.
+func (o *OptEditVMCpuMemConfigCPU) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEditVMCpuMemConfigCPU to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEditVMCpuMemConfigCPU) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEditVMCpuMemConfigCPU) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EditVMCpuMemConfigMemory as json.
+func (o OptEditVMCpuMemConfigMemory) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes EditVMCpuMemConfigMemory from json.
+func (o *OptEditVMCpuMemConfigMemory) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEditVMCpuMemConfigMemory to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEditVMCpuMemConfigMemory) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEditVMCpuMemConfigMemory) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EditVMNetworkAdaptersItemNetworkDetails as json.
+func (o OptEditVMNetworkAdaptersItemNetworkDetails) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes EditVMNetworkAdaptersItemNetworkDetails from json.
+func (o *OptEditVMNetworkAdaptersItemNetworkDetails) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEditVMNetworkAdaptersItemNetworkDetails to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEditVMNetworkAdaptersItemNetworkDetails) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEditVMNetworkAdaptersItemNetworkDetails) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EditVMNetworkAdaptersItemNetworkDetailsType as json.
+func (o OptEditVMNetworkAdaptersItemNetworkDetailsType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes EditVMNetworkAdaptersItemNetworkDetailsType from json.
+func (o *OptEditVMNetworkAdaptersItemNetworkDetailsType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEditVMNetworkAdaptersItemNetworkDetailsType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEditVMNetworkAdaptersItemNetworkDetailsType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEditVMNetworkAdaptersItemNetworkDetailsType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EditVMNetworkAdaptersItemOperation as json.
+func (o OptEditVMNetworkAdaptersItemOperation) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes EditVMNetworkAdaptersItemOperation from json.
+func (o *OptEditVMNetworkAdaptersItemOperation) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEditVMNetworkAdaptersItemOperation to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEditVMNetworkAdaptersItemOperation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEditVMNetworkAdaptersItemOperation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EditVMNetworkAdaptersItemType as json.
+func (o OptEditVMNetworkAdaptersItemType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes EditVMNetworkAdaptersItemType from json.
+func (o *OptEditVMNetworkAdaptersItemType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEditVMNetworkAdaptersItemType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEditVMNetworkAdaptersItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEditVMNetworkAdaptersItemType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EditVMVirtualDisksItemDiskConfig as json.
+func (o OptEditVMVirtualDisksItemDiskConfig) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes EditVMVirtualDisksItemDiskConfig from json.
+func (o *OptEditVMVirtualDisksItemDiskConfig) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEditVMVirtualDisksItemDiskConfig to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEditVMVirtualDisksItemDiskConfig) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEditVMVirtualDisksItemDiskConfig) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EditVMVirtualDisksItemDiskConfigType as json.
+func (o OptEditVMVirtualDisksItemDiskConfigType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes EditVMVirtualDisksItemDiskConfigType from json.
+func (o *OptEditVMVirtualDisksItemDiskConfigType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEditVMVirtualDisksItemDiskConfigType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEditVMVirtualDisksItemDiskConfigType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEditVMVirtualDisksItemDiskConfigType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EditVMVirtualDisksItemOperation as json.
+func (o OptEditVMVirtualDisksItemOperation) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes EditVMVirtualDisksItemOperation from json.
+func (o *OptEditVMVirtualDisksItemOperation) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEditVMVirtualDisksItemOperation to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEditVMVirtualDisksItemOperation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEditVMVirtualDisksItemOperation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes HCIDeployVMDestination as json.
+func (o OptHCIDeployVMDestination) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes HCIDeployVMDestination from json.
+func (o *OptHCIDeployVMDestination) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptHCIDeployVMDestination to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptHCIDeployVMDestination) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptHCIDeployVMDestination) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes HCIDeployVMImageSource as json.
+func (o OptHCIDeployVMImageSource) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes HCIDeployVMImageSource from json.
+func (o *OptHCIDeployVMImageSource) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptHCIDeployVMImageSource to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptHCIDeployVMImageSource) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptHCIDeployVMImageSource) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes HCIDeployVMImageSourceImageSourceType as json.
+func (o OptHCIDeployVMImageSourceImageSourceType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes HCIDeployVMImageSourceImageSourceType from json.
+func (o *OptHCIDeployVMImageSourceImageSourceType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptHCIDeployVMImageSourceImageSourceType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptHCIDeployVMImageSourceImageSourceType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptHCIDeployVMImageSourceImageSourceType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes HCIDeployVMNetworkConfig as json.
+func (o OptHCIDeployVMNetworkConfig) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes HCIDeployVMNetworkConfig from json.
+func (o *OptHCIDeployVMNetworkConfig) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptHCIDeployVMNetworkConfig to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptHCIDeployVMNetworkConfig) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptHCIDeployVMNetworkConfig) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes HCIDeployVMNetworkConfigIpAllocationPolicy as json.
+func (o OptHCIDeployVMNetworkConfigIpAllocationPolicy) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes HCIDeployVMNetworkConfigIpAllocationPolicy from json.
+func (o *OptHCIDeployVMNetworkConfigIpAllocationPolicy) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptHCIDeployVMNetworkConfigIpAllocationPolicy to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptHCIDeployVMNetworkConfigIpAllocationPolicy) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptHCIDeployVMNetworkConfigIpAllocationPolicy) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes HCIDeployVMStorageConfigProvisioningType as json.
+func (o OptHCIDeployVMStorageConfigProvisioningType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes HCIDeployVMStorageConfigProvisioningType from json.
+func (o *OptHCIDeployVMStorageConfigProvisioningType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptHCIDeployVMStorageConfigProvisioningType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptHCIDeployVMStorageConfigProvisioningType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptHCIDeployVMStorageConfigProvisioningType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes HCIDeployVMVmPolicyItemType as json.
+func (o OptHCIDeployVMVmPolicyItemType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes HCIDeployVMVmPolicyItemType from json.
+func (o *OptHCIDeployVMVmPolicyItemType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptHCIDeployVMVmPolicyItemType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptHCIDeployVMVmPolicyItemType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptHCIDeployVMVmPolicyItemType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int as json.
+func (o OptInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt to nil")
+	}
+	o.Set = true
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int64 as json.
+func (o OptInt64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int64(int64(o.Value))
+}
+
+// Decode decodes int64 from json.
+func (o *OptInt64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt64 to nil")
+	}
+	o.Set = true
+	v, err := d.Int64()
+	if err != nil {
+		return err
+	}
+	o.Value = int64(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes time.Time as json.
+func (o OptNilDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptNilDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilDateTime to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v time.Time
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes ProtectionPolicyAppliedAtInfo as json.
+func (o OptProtectionPolicyAppliedAtInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ProtectionPolicyAppliedAtInfo from json.
+func (o *OptProtectionPolicyAppliedAtInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptProtectionPolicyAppliedAtInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptProtectionPolicyAppliedAtInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptProtectionPolicyAppliedAtInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ProtectionPolicyAppliedAtInfoType as json.
+func (o OptProtectionPolicyAppliedAtInfoType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes ProtectionPolicyAppliedAtInfoType from json.
+func (o *OptProtectionPolicyAppliedAtInfoType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptProtectionPolicyAppliedAtInfoType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptProtectionPolicyAppliedAtInfoType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptProtectionPolicyAppliedAtInfoType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ProtectionStatus as json.
+func (o OptProtectionStatus) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes ProtectionStatus from json.
+func (o *OptProtectionStatus) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptProtectionStatus to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptProtectionStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptProtectionStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RecoveryPointsExist as json.
+func (o OptRecoveryPointsExist) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes RecoveryPointsExist from json.
+func (o *OptRecoveryPointsExist) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptRecoveryPointsExist to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptRecoveryPointsExist) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptRecoveryPointsExist) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StorageFolderInfo as json.
+func (o OptStorageFolderInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes StorageFolderInfo from json.
+func (o *OptStorageFolderInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptStorageFolderInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptStorageFolderInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptStorageFolderInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StoragePoolInfo as json.
+func (o OptStoragePoolInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes StoragePoolInfo from json.
+func (o *OptStoragePoolInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptStoragePoolInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptStoragePoolInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptStoragePoolInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StorageSystemInfo as json.
+func (o OptStorageSystemInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes StorageSystemInfo from json.
+func (o *OptStorageSystemInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptStorageSystemInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptStorageSystemInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptStorageSystemInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StorageSystemInfoType as json.
+func (o OptStorageSystemInfoType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes StorageSystemInfoType from json.
+func (o *OptStorageSystemInfoType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptStorageSystemInfoType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptStorageSystemInfoType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptStorageSystemInfoType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o OptString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *OptString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptString to nil")
+	}
+	o.Set = true
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes Type as json.
+func (o OptType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes Type from json.
+func (o *OptType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes uuid.UUID as json.
+func (o OptUUID) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	json.EncodeUUID(e, o.Value)
+}
+
+// Decode decodes uuid.UUID from json.
+func (o *OptUUID) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUUID to nil")
+	}
+	o.Set = true
+	v, err := json.DecodeUUID(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUUID) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUUID) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRequestsListItems as json.
+func (o OptVMRequestsListItems) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VMRequestsListItems from json.
+func (o *OptVMRequestsListItems) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVMRequestsListItems to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVMRequestsListItems) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVMRequestsListItems) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineAppInfo as json.
+func (o OptVirtualMachineAppInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineAppInfo from json.
+func (o *OptVirtualMachineAppInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineAppInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineAppInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineAppInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineAppInfoVmware as json.
+func (o OptVirtualMachineAppInfoVmware) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineAppInfoVmware from json.
+func (o *OptVirtualMachineAppInfoVmware) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineAppInfoVmware to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineAppInfoVmware) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineAppInfoVmware) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineAppInfoVmwareResourcePoolInfo as json.
+func (o OptVirtualMachineAppInfoVmwareResourcePoolInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineAppInfoVmwareResourcePoolInfo from json.
+func (o *OptVirtualMachineAppInfoVmwareResourcePoolInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineAppInfoVmwareResourcePoolInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineAppInfoVmwareResourcePoolInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineAppInfoVmwareResourcePoolInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineAppInfoVmwareToolsInfo as json.
+func (o OptVirtualMachineAppInfoVmwareToolsInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineAppInfoVmwareToolsInfo from json.
+func (o *OptVirtualMachineAppInfoVmwareToolsInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineAppInfoVmwareToolsInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineAppInfoVmwareToolsInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineAppInfoVmwareToolsInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineAppInfoVmwareToolsInfoStatus as json.
+func (o OptVirtualMachineAppInfoVmwareToolsInfoStatus) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes VirtualMachineAppInfoVmwareToolsInfoStatus from json.
+func (o *OptVirtualMachineAppInfoVmwareToolsInfoStatus) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineAppInfoVmwareToolsInfoStatus to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineAppInfoVmwareToolsInfoStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineAppInfoVmwareToolsInfoStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineAppInfoVmwareType as json.
+func (o OptVirtualMachineAppInfoVmwareType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes VirtualMachineAppInfoVmwareType from json.
+func (o *OptVirtualMachineAppInfoVmwareType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineAppInfoVmwareType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineAppInfoVmwareType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineAppInfoVmwareType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineAppType as json.
+func (o OptVirtualMachineAppType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes VirtualMachineAppType from json.
+func (o *OptVirtualMachineAppType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineAppType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineAppType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineAppType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineClusterInfo as json.
+func (o OptVirtualMachineClusterInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineClusterInfo from json.
+func (o *OptVirtualMachineClusterInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineClusterInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineClusterInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineClusterInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineComputeInfo as json.
+func (o OptVirtualMachineComputeInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineComputeInfo from json.
+func (o *OptVirtualMachineComputeInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineComputeInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineComputeInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineComputeInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineFolderInfo as json.
+func (o OptVirtualMachineFolderInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineFolderInfo from json.
+func (o *OptVirtualMachineFolderInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineFolderInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineFolderInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineFolderInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineGuestInfo as json.
+func (o OptVirtualMachineGuestInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineGuestInfo from json.
+func (o *OptVirtualMachineGuestInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineGuestInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineGuestInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineGuestInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineGuestInfoType as json.
+func (o OptVirtualMachineGuestInfoType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes VirtualMachineGuestInfoType from json.
+func (o *OptVirtualMachineGuestInfoType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineGuestInfoType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineGuestInfoType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineGuestInfoType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineHostInfo as json.
+func (o OptVirtualMachineHostInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineHostInfo from json.
+func (o *OptVirtualMachineHostInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineHostInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineHostInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineHostInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineHypervisorManagerInfo as json.
+func (o OptVirtualMachineHypervisorManagerInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineHypervisorManagerInfo from json.
+func (o *OptVirtualMachineHypervisorManagerInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineHypervisorManagerInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineHypervisorManagerInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineHypervisorManagerInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineNetworkAdaptersItemMacAddressType as json.
+func (o OptVirtualMachineNetworkAdaptersItemMacAddressType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes VirtualMachineNetworkAdaptersItemMacAddressType from json.
+func (o *OptVirtualMachineNetworkAdaptersItemMacAddressType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineNetworkAdaptersItemMacAddressType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineNetworkAdaptersItemMacAddressType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineNetworkAdaptersItemMacAddressType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineNetworkAdaptersItemNetworkDetails as json.
+func (o OptVirtualMachineNetworkAdaptersItemNetworkDetails) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineNetworkAdaptersItemNetworkDetails from json.
+func (o *OptVirtualMachineNetworkAdaptersItemNetworkDetails) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineNetworkAdaptersItemNetworkDetails to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineNetworkAdaptersItemNetworkDetails) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineNetworkAdaptersItemNetworkDetails) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineNetworkAdaptersItemNetworkDetailsState as json.
+func (o OptVirtualMachineNetworkAdaptersItemNetworkDetailsState) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes VirtualMachineNetworkAdaptersItemNetworkDetailsState from json.
+func (o *OptVirtualMachineNetworkAdaptersItemNetworkDetailsState) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineNetworkAdaptersItemNetworkDetailsState to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineNetworkAdaptersItemNetworkDetailsState) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineNetworkAdaptersItemNetworkDetailsState) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachinePowerState as json.
+func (o OptVirtualMachinePowerState) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes VirtualMachinePowerState from json.
+func (o *OptVirtualMachinePowerState) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachinePowerState to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachinePowerState) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachinePowerState) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineState as json.
+func (o OptVirtualMachineState) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes VirtualMachineState from json.
+func (o *OptVirtualMachineState) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineState to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineState) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineState) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineStatus as json.
+func (o OptVirtualMachineStatus) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes VirtualMachineStatus from json.
+func (o *OptVirtualMachineStatus) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineStatus to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineVirtualDisksItemAppInfo as json.
+func (o OptVirtualMachineVirtualDisksItemAppInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineVirtualDisksItemAppInfo from json.
+func (o *OptVirtualMachineVirtualDisksItemAppInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineVirtualDisksItemAppInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineVirtualDisksItemAppInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineVirtualDisksItemAppInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineVirtualDisksItemAppInfoVmware as json.
+func (o OptVirtualMachineVirtualDisksItemAppInfoVmware) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineVirtualDisksItemAppInfoVmware from json.
+func (o *OptVirtualMachineVirtualDisksItemAppInfoVmware) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineVirtualDisksItemAppInfoVmware to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineVirtualDisksItemAppInfoVmware) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineVirtualDisksItemAppInfoVmware) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo as json.
+func (o OptVirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo from json.
+func (o *OptVirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineVirtualDisksItemAppInfoVmwareType as json.
+func (o OptVirtualMachineVirtualDisksItemAppInfoVmwareType) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes VirtualMachineVirtualDisksItemAppInfoVmwareType from json.
+func (o *OptVirtualMachineVirtualDisksItemAppInfoVmwareType) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineVirtualDisksItemAppInfoVmwareType to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineVirtualDisksItemAppInfoVmwareType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineVirtualDisksItemAppInfoVmwareType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineVmClassification as json.
+func (o OptVirtualMachineVmClassification) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes VirtualMachineVmClassification from json.
+func (o *OptVirtualMachineVmClassification) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineVmClassification to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineVmClassification) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineVmClassification) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineVmPerfMetricInfo as json.
+func (o OptVirtualMachineVmPerfMetricInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VirtualMachineVmPerfMetricInfo from json.
+func (o *OptVirtualMachineVmPerfMetricInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVirtualMachineVmPerfMetricInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVirtualMachineVmPerfMetricInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVirtualMachineVmPerfMetricInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VolumeSetInfo as json.
+func (o OptVolumeSetInfo) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VolumeSetInfo from json.
+func (o *OptVolumeSetInfo) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVolumeSetInfo to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVolumeSetInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVolumeSetInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProtectionPolicyAppliedAtInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProtectionPolicyAppliedAtInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfProtectionPolicyAppliedAtInfo = [4]string{
+	0: "id",
+	1: "name",
+	2: "resourceUri",
+	3: "type",
+}
+
+// Decode decodes ProtectionPolicyAppliedAtInfo from json.
+func (s *ProtectionPolicyAppliedAtInfo) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProtectionPolicyAppliedAtInfo to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProtectionPolicyAppliedAtInfo")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProtectionPolicyAppliedAtInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProtectionPolicyAppliedAtInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ProtectionPolicyAppliedAtInfoType as json.
+func (s ProtectionPolicyAppliedAtInfoType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ProtectionPolicyAppliedAtInfoType from json.
+func (s *ProtectionPolicyAppliedAtInfoType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProtectionPolicyAppliedAtInfoType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ProtectionPolicyAppliedAtInfoType(v) {
+	case ProtectionPolicyAppliedAtInfoTypeDATASTORE:
+		*s = ProtectionPolicyAppliedAtInfoTypeDATASTORE
+	case ProtectionPolicyAppliedAtInfoTypeVIRTUALMACHINE:
+		*s = ProtectionPolicyAppliedAtInfoTypeVIRTUALMACHINE
+	case ProtectionPolicyAppliedAtInfoTypeVMPROTECTIONGROUP:
+		*s = ProtectionPolicyAppliedAtInfoTypeVMPROTECTIONGROUP
+	default:
+		*s = ProtectionPolicyAppliedAtInfoType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ProtectionPolicyAppliedAtInfoType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProtectionPolicyAppliedAtInfoType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ProtectionStatus as json.
+func (s ProtectionStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ProtectionStatus from json.
+func (s *ProtectionStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProtectionStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ProtectionStatus(v) {
+	case ProtectionStatusUNPROTECTED:
+		*s = ProtectionStatusUNPROTECTED
+	case ProtectionStatusLAPSED:
+		*s = ProtectionStatusLAPSED
+	case ProtectionStatusPENDING:
+		*s = ProtectionStatusPENDING
+	case ProtectionStatusPARTIAL:
+		*s = ProtectionStatusPARTIAL
+	case ProtectionStatusPROTECTED:
+		*s = ProtectionStatusPROTECTED
+	case ProtectionStatusPAUSED:
+		*s = ProtectionStatusPAUSED
+	case ProtectionStatusUNSUPPORTED:
+		*s = ProtectionStatusUNSUPPORTED
+	default:
+		*s = ProtectionStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ProtectionStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProtectionStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RecoveryPointsExist as json.
+func (s RecoveryPointsExist) Encode(e *jx.Encoder) {
+	unwrapped := bool(s)
+
+	e.Bool(unwrapped)
+}
+
+// Decode decodes RecoveryPointsExist from json.
+func (s *RecoveryPointsExist) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RecoveryPointsExist to nil")
+	}
+	var unwrapped bool
+	if err := func() error {
+		v, err := d.Bool()
+		unwrapped = bool(v)
+		if err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RecoveryPointsExist(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s RecoveryPointsExist) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RecoveryPointsExist) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RetryVirtualMachineRequestConflict as json.
+func (s *RetryVirtualMachineRequestConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RetryVirtualMachineRequestConflict from json.
+func (s *RetryVirtualMachineRequestConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RetryVirtualMachineRequestConflict to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RetryVirtualMachineRequestConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RetryVirtualMachineRequestConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RetryVirtualMachineRequestConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RetryVirtualMachineRequestForbidden as json.
+func (s *RetryVirtualMachineRequestForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RetryVirtualMachineRequestForbidden from json.
+func (s *RetryVirtualMachineRequestForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RetryVirtualMachineRequestForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RetryVirtualMachineRequestForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RetryVirtualMachineRequestForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RetryVirtualMachineRequestForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RetryVirtualMachineRequestInternalServerError as json.
+func (s *RetryVirtualMachineRequestInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RetryVirtualMachineRequestInternalServerError from json.
+func (s *RetryVirtualMachineRequestInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RetryVirtualMachineRequestInternalServerError to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RetryVirtualMachineRequestInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RetryVirtualMachineRequestInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RetryVirtualMachineRequestInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RetryVirtualMachineRequestNotFound as json.
+func (s *RetryVirtualMachineRequestNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RetryVirtualMachineRequestNotFound from json.
+func (s *RetryVirtualMachineRequestNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RetryVirtualMachineRequestNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RetryVirtualMachineRequestNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RetryVirtualMachineRequestNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RetryVirtualMachineRequestNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RetryVirtualMachineRequestServiceUnavailable as json.
+func (s *RetryVirtualMachineRequestServiceUnavailable) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RetryVirtualMachineRequestServiceUnavailable from json.
+func (s *RetryVirtualMachineRequestServiceUnavailable) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RetryVirtualMachineRequestServiceUnavailable to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RetryVirtualMachineRequestServiceUnavailable(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RetryVirtualMachineRequestServiceUnavailable) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RetryVirtualMachineRequestServiceUnavailable) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RetryVirtualMachineRequestUnauthorized as json.
+func (s *RetryVirtualMachineRequestUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes RetryVirtualMachineRequestUnauthorized from json.
+func (s *RetryVirtualMachineRequestUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RetryVirtualMachineRequestUnauthorized to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = RetryVirtualMachineRequestUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RetryVirtualMachineRequestUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RetryVirtualMachineRequestUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes Services as json.
+func (s Services) Encode(e *jx.Encoder) {
+	unwrapped := []string(s)
+	if unwrapped == nil {
+		e.ArrEmpty()
+		return
+	}
+	if unwrapped != nil {
+		e.ArrStart()
+		for _, elem := range unwrapped {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+}
+
+// Decode decodes Services from json.
+func (s *Services) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Services to nil")
+	}
+	var unwrapped []string
+	if err := func() error {
+		unwrapped = make([]string, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem string
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = Services(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s Services) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Services) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StorageFolderInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StorageFolderInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStorageFolderInfo = [5]string{
+	0: "displayName",
+	1: "id",
+	2: "name",
+	3: "resourceUri",
+	4: "type",
+}
+
+// Decode decodes StorageFolderInfo from json.
+func (s *StorageFolderInfo) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StorageFolderInfo to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "displayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"displayName\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StorageFolderInfo")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StorageFolderInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StorageFolderInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StoragePoolInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StoragePoolInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStoragePoolInfo = [5]string{
+	0: "displayName",
+	1: "id",
+	2: "name",
+	3: "resourceUri",
+	4: "type",
+}
+
+// Decode decodes StoragePoolInfo from json.
+func (s *StoragePoolInfo) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StoragePoolInfo to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "displayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"displayName\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StoragePoolInfo")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StoragePoolInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StoragePoolInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *StorageSystemInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *StorageSystemInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Managed.Set {
+			e.FieldStart("managed")
+			s.Managed.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.SerialNumber.Set {
+			e.FieldStart("serialNumber")
+			s.SerialNumber.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
+	{
+		if s.VendorName.Set {
+			e.FieldStart("vendorName")
+			s.VendorName.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfStorageSystemInfo = [8]string{
+	0: "displayName",
+	1: "id",
+	2: "managed",
+	3: "name",
+	4: "resourceUri",
+	5: "serialNumber",
+	6: "type",
+	7: "vendorName",
+}
+
+// Decode decodes StorageSystemInfo from json.
+func (s *StorageSystemInfo) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StorageSystemInfo to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "displayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"displayName\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "managed":
+			if err := func() error {
+				s.Managed.Reset()
+				if err := s.Managed.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"managed\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "serialNumber":
+			if err := func() error {
+				s.SerialNumber.Reset()
+				if err := s.SerialNumber.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"serialNumber\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "vendorName":
+			if err := func() error {
+				s.VendorName.Reset()
+				if err := s.VendorName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vendorName\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode StorageSystemInfo")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *StorageSystemInfo) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StorageSystemInfo) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes StorageSystemInfoType as json.
+func (s StorageSystemInfoType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes StorageSystemInfoType from json.
+func (s *StorageSystemInfoType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode StorageSystemInfoType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch StorageSystemInfoType(v) {
+	case StorageSystemInfoTypeNIMBLE:
+		*s = StorageSystemInfoTypeNIMBLE
+	case StorageSystemInfoTypeTHREEPAR:
+		*s = StorageSystemInfoTypeTHREEPAR
+	case StorageSystemInfoTypePRIMERA:
+		*s = StorageSystemInfoTypePRIMERA
+	case StorageSystemInfoTypeALLETRA6000:
+		*s = StorageSystemInfoTypeALLETRA6000
+	case StorageSystemInfoTypeALLETRA9000:
+		*s = StorageSystemInfoTypeALLETRA9000
+	default:
+		*s = StorageSystemInfoType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s StorageSystemInfoType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *StorageSystemInfoType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes Type as json.
+func (s Type) Encode(e *jx.Encoder) {
+	unwrapped := string(s)
+
+	e.Str(unwrapped)
+}
+
+// Decode decodes Type from json.
+func (s *Type) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Type to nil")
+	}
+	var unwrapped string
+	if err := func() error {
+		v, err := d.Str()
+		unwrapped = string(v)
+		if err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = Type(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s Type) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Type) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMDeleteBadRequest as json.
+func (s *VMDeleteBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMDeleteBadRequest from json.
+func (s *VMDeleteBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMDeleteBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMDeleteBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMDeleteBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMDeleteBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMDeleteConflict as json.
+func (s *VMDeleteConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMDeleteConflict from json.
+func (s *VMDeleteConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMDeleteConflict to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMDeleteConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMDeleteConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMDeleteConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMDeleteForbidden as json.
+func (s *VMDeleteForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMDeleteForbidden from json.
+func (s *VMDeleteForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMDeleteForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMDeleteForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMDeleteForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMDeleteForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMDeleteInternalServerError as json.
+func (s *VMDeleteInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMDeleteInternalServerError from json.
+func (s *VMDeleteInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMDeleteInternalServerError to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMDeleteInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMDeleteInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMDeleteInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMDeleteNotFound as json.
+func (s *VMDeleteNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMDeleteNotFound from json.
+func (s *VMDeleteNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMDeleteNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMDeleteNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMDeleteNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMDeleteNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMDeleteServiceUnavailable as json.
+func (s *VMDeleteServiceUnavailable) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMDeleteServiceUnavailable from json.
+func (s *VMDeleteServiceUnavailable) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMDeleteServiceUnavailable to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMDeleteServiceUnavailable(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMDeleteServiceUnavailable) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMDeleteServiceUnavailable) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMDeleteUnauthorized as json.
+func (s *VMDeleteUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMDeleteUnauthorized from json.
+func (s *VMDeleteUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMDeleteUnauthorized to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMDeleteUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMDeleteUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMDeleteUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *VMDeployInstance) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *VMDeployInstance) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("requestId")
+		e.Str(s.RequestId)
+	}
+	{
+		if s.VmId.Set {
+			e.FieldStart("vmId")
+			s.VmId.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("vmName")
+		e.Str(s.VmName)
+	}
+	{
+		e.FieldStart("vmStatus")
+		e.Str(s.VmStatus)
+	}
+	{
+		if s.VmStateMessage.Set {
+			e.FieldStart("vmStateMessage")
+			s.VmStateMessage.Encode(e)
+		}
+	}
+	{
+		if s.CompletedAt.Set {
+			e.FieldStart("completedAt")
+			s.CompletedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfVMDeployInstance = [6]string{
+	0: "requestId",
+	1: "vmId",
+	2: "vmName",
+	3: "vmStatus",
+	4: "vmStateMessage",
+	5: "completedAt",
+}
+
+// Decode decodes VMDeployInstance from json.
+func (s *VMDeployInstance) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMDeployInstance to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "requestId":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.RequestId = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"requestId\"")
+			}
+		case "vmId":
+			if err := func() error {
+				s.VmId.Reset()
+				if err := s.VmId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vmId\"")
+			}
+		case "vmName":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.VmName = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vmName\"")
+			}
+		case "vmStatus":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.VmStatus = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vmStatus\"")
+			}
+		case "vmStateMessage":
+			if err := func() error {
+				s.VmStateMessage.Reset()
+				if err := s.VmStateMessage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vmStateMessage\"")
+			}
+		case "completedAt":
+			if err := func() error {
+				s.CompletedAt.Reset()
+				if err := s.CompletedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"completedAt\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode VMDeployInstance")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfVMDeployInstance) {
+					name = jsonFieldsNameOfVMDeployInstance[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMDeployInstance) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMDeployInstance) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOffBadRequest as json.
+func (s *VMPowerOffBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOffBadRequest from json.
+func (s *VMPowerOffBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOffBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOffBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOffBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOffBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOffConflict as json.
+func (s *VMPowerOffConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOffConflict from json.
+func (s *VMPowerOffConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOffConflict to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOffConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOffConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOffConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOffForbidden as json.
+func (s *VMPowerOffForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOffForbidden from json.
+func (s *VMPowerOffForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOffForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOffForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOffForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOffForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOffInternalServerError as json.
+func (s *VMPowerOffInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOffInternalServerError from json.
+func (s *VMPowerOffInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOffInternalServerError to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOffInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOffInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOffInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOffNotFound as json.
+func (s *VMPowerOffNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOffNotFound from json.
+func (s *VMPowerOffNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOffNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOffNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOffNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOffNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOffServiceUnavailable as json.
+func (s *VMPowerOffServiceUnavailable) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOffServiceUnavailable from json.
+func (s *VMPowerOffServiceUnavailable) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOffServiceUnavailable to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOffServiceUnavailable(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOffServiceUnavailable) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOffServiceUnavailable) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOffUnauthorized as json.
+func (s *VMPowerOffUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOffUnauthorized from json.
+func (s *VMPowerOffUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOffUnauthorized to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOffUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOffUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOffUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOnBadRequest as json.
+func (s *VMPowerOnBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOnBadRequest from json.
+func (s *VMPowerOnBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOnBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOnBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOnBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOnBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOnConflict as json.
+func (s *VMPowerOnConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOnConflict from json.
+func (s *VMPowerOnConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOnConflict to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOnConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOnConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOnConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOnForbidden as json.
+func (s *VMPowerOnForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOnForbidden from json.
+func (s *VMPowerOnForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOnForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOnForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOnForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOnForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOnInternalServerError as json.
+func (s *VMPowerOnInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOnInternalServerError from json.
+func (s *VMPowerOnInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOnInternalServerError to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOnInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOnInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOnInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOnNotFound as json.
+func (s *VMPowerOnNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOnNotFound from json.
+func (s *VMPowerOnNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOnNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOnNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOnNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOnNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOnServiceUnavailable as json.
+func (s *VMPowerOnServiceUnavailable) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOnServiceUnavailable from json.
+func (s *VMPowerOnServiceUnavailable) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOnServiceUnavailable to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOnServiceUnavailable(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOnServiceUnavailable) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOnServiceUnavailable) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerOnUnauthorized as json.
+func (s *VMPowerOnUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerOnUnauthorized from json.
+func (s *VMPowerOnUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerOnUnauthorized to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerOnUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerOnUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerOnUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerResetBadRequest as json.
+func (s *VMPowerResetBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerResetBadRequest from json.
+func (s *VMPowerResetBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerResetBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerResetBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerResetBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerResetBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerResetConflict as json.
+func (s *VMPowerResetConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerResetConflict from json.
+func (s *VMPowerResetConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerResetConflict to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerResetConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerResetConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerResetConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerResetForbidden as json.
+func (s *VMPowerResetForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerResetForbidden from json.
+func (s *VMPowerResetForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerResetForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerResetForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerResetForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerResetForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerResetInternalServerError as json.
+func (s *VMPowerResetInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerResetInternalServerError from json.
+func (s *VMPowerResetInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerResetInternalServerError to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerResetInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerResetInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerResetInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerResetNotFound as json.
+func (s *VMPowerResetNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerResetNotFound from json.
+func (s *VMPowerResetNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerResetNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerResetNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerResetNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerResetNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerResetServiceUnavailable as json.
+func (s *VMPowerResetServiceUnavailable) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerResetServiceUnavailable from json.
+func (s *VMPowerResetServiceUnavailable) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerResetServiceUnavailable to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerResetServiceUnavailable(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerResetServiceUnavailable) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerResetServiceUnavailable) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMPowerResetUnauthorized as json.
+func (s *VMPowerResetUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMPowerResetUnauthorized from json.
+func (s *VMPowerResetUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMPowerResetUnauthorized to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMPowerResetUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMPowerResetUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMPowerResetUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRefreshConflict as json.
+func (s *VMRefreshConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMRefreshConflict from json.
+func (s *VMRefreshConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRefreshConflict to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMRefreshConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRefreshConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRefreshConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRefreshForbidden as json.
+func (s *VMRefreshForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMRefreshForbidden from json.
+func (s *VMRefreshForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRefreshForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMRefreshForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRefreshForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRefreshForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRefreshInternalServerError as json.
+func (s *VMRefreshInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMRefreshInternalServerError from json.
+func (s *VMRefreshInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRefreshInternalServerError to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMRefreshInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRefreshInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRefreshInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRefreshNotFound as json.
+func (s *VMRefreshNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMRefreshNotFound from json.
+func (s *VMRefreshNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRefreshNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMRefreshNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRefreshNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRefreshNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRefreshServiceUnavailable as json.
+func (s *VMRefreshServiceUnavailable) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMRefreshServiceUnavailable from json.
+func (s *VMRefreshServiceUnavailable) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRefreshServiceUnavailable to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMRefreshServiceUnavailable(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRefreshServiceUnavailable) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRefreshServiceUnavailable) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRefreshUnauthorized as json.
+func (s *VMRefreshUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMRefreshUnauthorized from json.
+func (s *VMRefreshUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRefreshUnauthorized to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMRefreshUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRefreshUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRefreshUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *VMRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *VMRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("requestId")
+		e.Str(s.RequestId)
+	}
+	{
+		e.FieldStart("operation")
+		s.Operation.Encode(e)
+	}
+	{
+		e.FieldStart("requestStatus")
+		s.RequestStatus.Encode(e)
+	}
+	{
+		if s.WorkspaceId.Set {
+			e.FieldStart("workspaceId")
+			s.WorkspaceId.Encode(e)
+		}
+	}
+	{
+		if s.DatacenterId.Set {
+			e.FieldStart("datacenterId")
+			s.DatacenterId.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		if s.CompletedAt.Set {
+			e.FieldStart("completedAt")
+			s.CompletedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		e.FieldStart("requestMetadata")
+		e.Str(s.RequestMetadata)
+	}
+	{
+		if s.CancelRequested.Set {
+			e.FieldStart("cancelRequested")
+			s.CancelRequested.Encode(e)
+		}
+	}
+	{
+		if s.ParentRequestId.Set {
+			e.FieldStart("parentRequestId")
+			s.ParentRequestId.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfVMRequest = [10]string{
+	0: "requestId",
+	1: "operation",
+	2: "requestStatus",
+	3: "workspaceId",
+	4: "datacenterId",
+	5: "createdAt",
+	6: "completedAt",
+	7: "requestMetadata",
+	8: "cancelRequested",
+	9: "parentRequestId",
+}
+
+// Decode decodes VMRequest from json.
+func (s *VMRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRequest to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "requestId":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.RequestId = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"requestId\"")
+			}
+		case "operation":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Operation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"operation\"")
+			}
+		case "requestStatus":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.RequestStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"requestStatus\"")
+			}
+		case "workspaceId":
+			if err := func() error {
+				s.WorkspaceId.Reset()
+				if err := s.WorkspaceId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"workspaceId\"")
+			}
+		case "datacenterId":
+			if err := func() error {
+				s.DatacenterId.Reset()
+				if err := s.DatacenterId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"datacenterId\"")
+			}
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		case "completedAt":
+			if err := func() error {
+				s.CompletedAt.Reset()
+				if err := s.CompletedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"completedAt\"")
+			}
+		case "requestMetadata":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Str()
+				s.RequestMetadata = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"requestMetadata\"")
+			}
+		case "cancelRequested":
+			if err := func() error {
+				s.CancelRequested.Reset()
+				if err := s.CancelRequested.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cancelRequested\"")
+			}
+		case "parentRequestId":
+			if err := func() error {
+				s.ParentRequestId.Reset()
+				if err := s.ParentRequestId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"parentRequestId\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode VMRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b10100111,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfVMRequest) {
+					name = jsonFieldsNameOfVMRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRequestOperation as json.
+func (s VMRequestOperation) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes VMRequestOperation from json.
+func (s *VMRequestOperation) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRequestOperation to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch VMRequestOperation(v) {
+	case VMRequestOperationVmDeploy:
+		*s = VMRequestOperationVmDeploy
+	case VMRequestOperationVmReconfigure:
+		*s = VMRequestOperationVmReconfigure
+	case VMRequestOperationVmPowerOn:
+		*s = VMRequestOperationVmPowerOn
+	case VMRequestOperationVmPowerOff:
+		*s = VMRequestOperationVmPowerOff
+	case VMRequestOperationVmReset:
+		*s = VMRequestOperationVmReset
+	case VMRequestOperationVmRestart:
+		*s = VMRequestOperationVmRestart
+	case VMRequestOperationVmShutdown:
+		*s = VMRequestOperationVmShutdown
+	case VMRequestOperationVmDelete:
+		*s = VMRequestOperationVmDelete
+	default:
+		*s = VMRequestOperation(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s VMRequestOperation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRequestOperation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRequestRequestStatus as json.
+func (s VMRequestRequestStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes VMRequestRequestStatus from json.
+func (s *VMRequestRequestStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRequestRequestStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch VMRequestRequestStatus(v) {
+	case VMRequestRequestStatusNew:
+		*s = VMRequestRequestStatusNew
+	case VMRequestRequestStatusInprogress:
+		*s = VMRequestRequestStatusInprogress
+	case VMRequestRequestStatusSuccess:
+		*s = VMRequestRequestStatusSuccess
+	case VMRequestRequestStatusFailure:
+		*s = VMRequestRequestStatusFailure
+	case VMRequestRequestStatusCancelled:
+		*s = VMRequestRequestStatusCancelled
+	default:
+		*s = VMRequestRequestStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s VMRequestRequestStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRequestRequestStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *VMRequestWithDeploy) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *VMRequestWithDeploy) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("vm_request")
+		s.VMRequest.Encode(e)
+	}
+	{
+		e.FieldStart("vm_deploy_list")
+		e.ArrStart()
+		for _, elem := range s.VMDeployList {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.RetryRequestID.Set {
+			e.FieldStart("retry_request_id")
+			s.RetryRequestID.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfVMRequestWithDeploy = [3]string{
+	0: "vm_request",
+	1: "vm_deploy_list",
+	2: "retry_request_id",
+}
+
+// Decode decodes VMRequestWithDeploy from json.
+func (s *VMRequestWithDeploy) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRequestWithDeploy to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "vm_request":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.VMRequest.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vm_request\"")
+			}
+		case "vm_deploy_list":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.VMDeployList = make([]VMDeployInstance, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VMDeployInstance
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.VMDeployList = append(s.VMDeployList, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vm_deploy_list\"")
+			}
+		case "retry_request_id":
+			if err := func() error {
+				s.RetryRequestID.Reset()
+				if err := s.RetryRequestID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"retry_request_id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode VMRequestWithDeploy")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfVMRequestWithDeploy) {
+					name = jsonFieldsNameOfVMRequestWithDeploy[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRequestWithDeploy) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRequestWithDeploy) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *VMRequestsList) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *VMRequestsList) encodeFields(e *jx.Encoder) {
+	{
+		if s.VMMinusRequestsListCount.Set {
+			e.FieldStart("vm-requests_list_count")
+			s.VMMinusRequestsListCount.Encode(e)
+		}
+	}
+	{
+		if s.VMMinusDeployListCount.Set {
+			e.FieldStart("vm-deploy_list_count")
+			s.VMMinusDeployListCount.Encode(e)
+		}
+	}
+	{
+		if s.Limit.Set {
+			e.FieldStart("limit")
+			s.Limit.Encode(e)
+		}
+	}
+	{
+		if s.Offset.Set {
+			e.FieldStart("offset")
+			s.Offset.Encode(e)
+		}
+	}
+	{
+		if s.Items.Set {
+			e.FieldStart("items")
+			s.Items.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfVMRequestsList = [5]string{
+	0: "vm-requests_list_count",
+	1: "vm-deploy_list_count",
+	2: "limit",
+	3: "offset",
+	4: "items",
+}
+
+// Decode decodes VMRequestsList from json.
+func (s *VMRequestsList) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRequestsList to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "vm-requests_list_count":
+			if err := func() error {
+				s.VMMinusRequestsListCount.Reset()
+				if err := s.VMMinusRequestsListCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vm-requests_list_count\"")
+			}
+		case "vm-deploy_list_count":
+			if err := func() error {
+				s.VMMinusDeployListCount.Reset()
+				if err := s.VMMinusDeployListCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vm-deploy_list_count\"")
+			}
+		case "limit":
+			if err := func() error {
+				s.Limit.Reset()
+				if err := s.Limit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"limit\"")
+			}
+		case "offset":
+			if err := func() error {
+				s.Offset.Reset()
+				if err := s.Offset.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"offset\"")
+			}
+		case "items":
+			if err := func() error {
+				s.Items.Reset()
+				if err := s.Items.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"items\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode VMRequestsList")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRequestsList) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRequestsList) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *VMRequestsListItems) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *VMRequestsListItems) encodeFields(e *jx.Encoder) {
+	{
+		if s.VMRequetsList != nil {
+			e.FieldStart("vm_requets_list")
+			e.ArrStart()
+			for _, elem := range s.VMRequetsList {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.VMDeployList != nil {
+			e.FieldStart("vm_deploy_list")
+			e.ArrStart()
+			for _, elem := range s.VMDeployList {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfVMRequestsListItems = [2]string{
+	0: "vm_requets_list",
+	1: "vm_deploy_list",
+}
+
+// Decode decodes VMRequestsListItems from json.
+func (s *VMRequestsListItems) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRequestsListItems to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "vm_requets_list":
+			if err := func() error {
+				s.VMRequetsList = make([]VMRequest, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VMRequest
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.VMRequetsList = append(s.VMRequetsList, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vm_requets_list\"")
+			}
+		case "vm_deploy_list":
+			if err := func() error {
+				s.VMDeployList = make([]VMDeployInstance, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VMDeployInstance
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.VMDeployList = append(s.VMDeployList, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vm_deploy_list\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode VMRequestsListItems")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRequestsListItems) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRequestsListItems) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRestartGuestOSBadRequest as json.
+func (s *VMRestartGuestOSBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMRestartGuestOSBadRequest from json.
+func (s *VMRestartGuestOSBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRestartGuestOSBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMRestartGuestOSBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRestartGuestOSBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRestartGuestOSBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRestartGuestOSConflict as json.
+func (s *VMRestartGuestOSConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMRestartGuestOSConflict from json.
+func (s *VMRestartGuestOSConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRestartGuestOSConflict to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMRestartGuestOSConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRestartGuestOSConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRestartGuestOSConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRestartGuestOSForbidden as json.
+func (s *VMRestartGuestOSForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMRestartGuestOSForbidden from json.
+func (s *VMRestartGuestOSForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRestartGuestOSForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMRestartGuestOSForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRestartGuestOSForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRestartGuestOSForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRestartGuestOSInternalServerError as json.
+func (s *VMRestartGuestOSInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMRestartGuestOSInternalServerError from json.
+func (s *VMRestartGuestOSInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRestartGuestOSInternalServerError to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMRestartGuestOSInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRestartGuestOSInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRestartGuestOSInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRestartGuestOSNotFound as json.
+func (s *VMRestartGuestOSNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMRestartGuestOSNotFound from json.
+func (s *VMRestartGuestOSNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRestartGuestOSNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMRestartGuestOSNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRestartGuestOSNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRestartGuestOSNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRestartGuestOSServiceUnavailable as json.
+func (s *VMRestartGuestOSServiceUnavailable) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMRestartGuestOSServiceUnavailable from json.
+func (s *VMRestartGuestOSServiceUnavailable) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRestartGuestOSServiceUnavailable to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMRestartGuestOSServiceUnavailable(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRestartGuestOSServiceUnavailable) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRestartGuestOSServiceUnavailable) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMRestartGuestOSUnauthorized as json.
+func (s *VMRestartGuestOSUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMRestartGuestOSUnauthorized from json.
+func (s *VMRestartGuestOSUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMRestartGuestOSUnauthorized to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMRestartGuestOSUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMRestartGuestOSUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMRestartGuestOSUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMShutdownGuestOSBadRequest as json.
+func (s *VMShutdownGuestOSBadRequest) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMShutdownGuestOSBadRequest from json.
+func (s *VMShutdownGuestOSBadRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMShutdownGuestOSBadRequest to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMShutdownGuestOSBadRequest(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMShutdownGuestOSBadRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMShutdownGuestOSBadRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMShutdownGuestOSConflict as json.
+func (s *VMShutdownGuestOSConflict) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMShutdownGuestOSConflict from json.
+func (s *VMShutdownGuestOSConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMShutdownGuestOSConflict to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMShutdownGuestOSConflict(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMShutdownGuestOSConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMShutdownGuestOSConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMShutdownGuestOSForbidden as json.
+func (s *VMShutdownGuestOSForbidden) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMShutdownGuestOSForbidden from json.
+func (s *VMShutdownGuestOSForbidden) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMShutdownGuestOSForbidden to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMShutdownGuestOSForbidden(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMShutdownGuestOSForbidden) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMShutdownGuestOSForbidden) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMShutdownGuestOSInternalServerError as json.
+func (s *VMShutdownGuestOSInternalServerError) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMShutdownGuestOSInternalServerError from json.
+func (s *VMShutdownGuestOSInternalServerError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMShutdownGuestOSInternalServerError to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMShutdownGuestOSInternalServerError(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMShutdownGuestOSInternalServerError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMShutdownGuestOSInternalServerError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMShutdownGuestOSNotFound as json.
+func (s *VMShutdownGuestOSNotFound) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMShutdownGuestOSNotFound from json.
+func (s *VMShutdownGuestOSNotFound) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMShutdownGuestOSNotFound to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMShutdownGuestOSNotFound(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMShutdownGuestOSNotFound) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMShutdownGuestOSNotFound) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMShutdownGuestOSServiceUnavailable as json.
+func (s *VMShutdownGuestOSServiceUnavailable) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMShutdownGuestOSServiceUnavailable from json.
+func (s *VMShutdownGuestOSServiceUnavailable) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMShutdownGuestOSServiceUnavailable to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMShutdownGuestOSServiceUnavailable(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMShutdownGuestOSServiceUnavailable) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMShutdownGuestOSServiceUnavailable) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VMShutdownGuestOSUnauthorized as json.
+func (s *VMShutdownGuestOSUnauthorized) Encode(e *jx.Encoder) {
+	unwrapped := (*ErrorResponse)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes VMShutdownGuestOSUnauthorized from json.
+func (s *VMShutdownGuestOSUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VMShutdownGuestOSUnauthorized to nil")
+	}
+	var unwrapped ErrorResponse
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = VMShutdownGuestOSUnauthorized(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VMShutdownGuestOSUnauthorized) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VMShutdownGuestOSUnauthorized) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *VirtualMachine) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *VirtualMachine) encodeFields(e *jx.Encoder) {
+	{
+		if s.AllowedOperations != nil {
+			e.FieldStart("allowedOperations")
+			e.ArrStart()
+			for _, elem := range s.AllowedOperations {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.AppInfo.Set {
+			e.FieldStart("appInfo")
+			s.AppInfo.Encode(e)
+		}
+	}
+	{
+		if s.AppType.Set {
+			e.FieldStart("appType")
+			s.AppType.Encode(e)
+		}
+	}
+	{
+		if s.CapacityInBytes.Set {
+			e.FieldStart("capacityInBytes")
+			s.CapacityInBytes.Encode(e)
+		}
+	}
+	{
+		if s.ClusterInfo.Set {
+			e.FieldStart("clusterInfo")
+			s.ClusterInfo.Encode(e)
+		}
+	}
+	{
+		if s.ComputeInfo.Set {
+			e.FieldStart("computeInfo")
+			s.ComputeInfo.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("createdAt")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		if s.CustomerId.Set {
+			e.FieldStart("customerId")
+			s.CustomerId.Encode(e)
+		}
+	}
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
+		}
+	}
+	{
+		if s.FolderInfo.Set {
+			e.FieldStart("folderInfo")
+			s.FolderInfo.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("generation")
+		s.Generation.Encode(e)
+	}
+	{
+		if s.GuestInfo.Set {
+			e.FieldStart("guestInfo")
+			s.GuestInfo.Encode(e)
+		}
+	}
+	{
+		if s.HciClusterUuid.Set {
+			e.FieldStart("hciClusterUuid")
+			s.HciClusterUuid.Encode(e)
+		}
+	}
+	{
+		if s.HostInfo.Set {
+			e.FieldStart("hostInfo")
+			s.HostInfo.Encode(e)
+		}
+	}
+	{
+		if s.HypervisorManagerInfo.Set {
+			e.FieldStart("hypervisorManagerInfo")
+			s.HypervisorManagerInfo.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("id")
+		e.Str(s.ID)
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.NetworkAdapters != nil {
+			e.FieldStart("networkAdapters")
+			e.ArrStart()
+			for _, elem := range s.NetworkAdapters {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.NetworkAddress.Set {
+			e.FieldStart("networkAddress")
+			s.NetworkAddress.Encode(e)
+		}
+	}
+	{
+		if s.PowerState.Set {
+			e.FieldStart("powerState")
+			s.PowerState.Encode(e)
+		}
+	}
+	{
+		if s.ProtectionJobInfo.Set {
+			e.FieldStart("protectionJobInfo")
+			s.ProtectionJobInfo.Encode(e)
+		}
+	}
+	{
+		if s.ProtectionPolicyAppliedAtInfo.Set {
+			e.FieldStart("protectionPolicyAppliedAtInfo")
+			s.ProtectionPolicyAppliedAtInfo.Encode(e)
+		}
+	}
+	{
+		if s.ProtectionStatus.Set {
+			e.FieldStart("protectionStatus")
+			s.ProtectionStatus.Encode(e)
+		}
+	}
+	{
+		if s.RecoveryPointsExist.Set {
+			e.FieldStart("recoveryPointsExist")
+			s.RecoveryPointsExist.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.Services != nil {
+			e.FieldStart("services")
+			s.Services.Encode(e)
+		}
+	}
+	{
+		if s.State.Set {
+			e.FieldStart("state")
+			s.State.Encode(e)
+		}
+	}
+	{
+		if s.StateReason.Set {
+			e.FieldStart("stateReason")
+			s.StateReason.Encode(e)
+		}
+	}
+	{
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		if s.UID.Set {
+			e.FieldStart("uid")
+			s.UID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("updatedAt")
+		json.EncodeDateTime(e, s.UpdatedAt)
+	}
+	{
+		if s.VclsVm.Set {
+			e.FieldStart("vclsVm")
+			s.VclsVm.Encode(e)
+		}
+	}
+	{
+		if s.VirtualDisks != nil {
+			e.FieldStart("virtualDisks")
+			e.ArrStart()
+			for _, elem := range s.VirtualDisks {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.VmClassification.Set {
+			e.FieldStart("vmClassification")
+			s.VmClassification.Encode(e)
+		}
+	}
+	{
+		if s.VmConfigPath.Set {
+			e.FieldStart("vmConfigPath")
+			s.VmConfigPath.Encode(e)
+		}
+	}
+	{
+		if s.VmPerfMetricInfo.Set {
+			e.FieldStart("vmPerfMetricInfo")
+			s.VmPerfMetricInfo.Encode(e)
+		}
+	}
+	{
+		if s.VmProtectionGroupsInfo != nil {
+			e.FieldStart("vmProtectionGroupsInfo")
+			e.ArrStart()
+			for _, elem := range s.VmProtectionGroupsInfo {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.VolumesInfo != nil {
+			e.FieldStart("volumesInfo")
+			e.ArrStart()
+			for _, elem := range s.VolumesInfo {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfVirtualMachine = [39]string{
+	0:  "allowedOperations",
+	1:  "appInfo",
+	2:  "appType",
+	3:  "capacityInBytes",
+	4:  "clusterInfo",
+	5:  "computeInfo",
+	6:  "createdAt",
+	7:  "customerId",
+	8:  "displayName",
+	9:  "folderInfo",
+	10: "generation",
+	11: "guestInfo",
+	12: "hciClusterUuid",
+	13: "hostInfo",
+	14: "hypervisorManagerInfo",
+	15: "id",
+	16: "name",
+	17: "networkAdapters",
+	18: "networkAddress",
+	19: "powerState",
+	20: "protectionJobInfo",
+	21: "protectionPolicyAppliedAtInfo",
+	22: "protectionStatus",
+	23: "recoveryPointsExist",
+	24: "resourceUri",
+	25: "services",
+	26: "state",
+	27: "stateReason",
+	28: "status",
+	29: "type",
+	30: "uid",
+	31: "updatedAt",
+	32: "vclsVm",
+	33: "virtualDisks",
+	34: "vmClassification",
+	35: "vmConfigPath",
+	36: "vmPerfMetricInfo",
+	37: "vmProtectionGroupsInfo",
+	38: "volumesInfo",
+}
+
+// Decode decodes VirtualMachine from json.
+func (s *VirtualMachine) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VirtualMachine to nil")
+	}
+	var requiredBitSet [5]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "allowedOperations":
+			if err := func() error {
+				s.AllowedOperations = make([]VirtualMachineAllowedOperationsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VirtualMachineAllowedOperationsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.AllowedOperations = append(s.AllowedOperations, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"allowedOperations\"")
+			}
+		case "appInfo":
+			if err := func() error {
+				s.AppInfo.Reset()
+				if err := s.AppInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"appInfo\"")
+			}
+		case "appType":
+			if err := func() error {
+				s.AppType.Reset()
+				if err := s.AppType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"appType\"")
+			}
+		case "capacityInBytes":
+			if err := func() error {
+				s.CapacityInBytes.Reset()
+				if err := s.CapacityInBytes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"capacityInBytes\"")
+			}
+		case "clusterInfo":
+			if err := func() error {
+				s.ClusterInfo.Reset()
+				if err := s.ClusterInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"clusterInfo\"")
+			}
+		case "computeInfo":
+			if err := func() error {
+				s.ComputeInfo.Reset()
+				if err := s.ComputeInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"computeInfo\"")
+			}
+		case "createdAt":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdAt\"")
+			}
+		case "customerId":
+			if err := func() error {
+				s.CustomerId.Reset()
+				if err := s.CustomerId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"customerId\"")
+			}
+		case "displayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"displayName\"")
+			}
+		case "folderInfo":
+			if err := func() error {
+				s.FolderInfo.Reset()
+				if err := s.FolderInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"folderInfo\"")
+			}
+		case "generation":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				if err := s.Generation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"generation\"")
+			}
+		case "guestInfo":
+			if err := func() error {
+				s.GuestInfo.Reset()
+				if err := s.GuestInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guestInfo\"")
+			}
+		case "hciClusterUuid":
+			if err := func() error {
+				s.HciClusterUuid.Reset()
+				if err := s.HciClusterUuid.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hciClusterUuid\"")
+			}
+		case "hostInfo":
+			if err := func() error {
+				s.HostInfo.Reset()
+				if err := s.HostInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hostInfo\"")
+			}
+		case "hypervisorManagerInfo":
+			if err := func() error {
+				s.HypervisorManagerInfo.Reset()
+				if err := s.HypervisorManagerInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hypervisorManagerInfo\"")
+			}
+		case "id":
+			requiredBitSet[1] |= 1 << 7
 			if err := func() error {
 				v, err := d.Str()
-				s.RequestId = string(v)
+				s.ID = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"requestId\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "vmId":
+		case "name":
 			if err := func() error {
-				s.VmId.Reset()
-				if err := s.VmId.Decode(d); err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vmId\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "vmName":
-			requiredBitSet[0] |= 1 << 2
+		case "networkAdapters":
 			if err := func() error {
-				v, err := d.Str()
-				s.VmName = string(v)
-				if err != nil {
+				s.NetworkAdapters = make([]VirtualMachineNetworkAdaptersItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VirtualMachineNetworkAdaptersItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.NetworkAdapters = append(s.NetworkAdapters, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vmName\"")
+				return errors.Wrap(err, "decode field \"networkAdapters\"")
 			}
-		case "vmStatus":
-			requiredBitSet[0] |= 1 << 3
+		case "networkAddress":
 			if err := func() error {
-				v, err := d.Str()
-				s.VmStatus = string(v)
+				s.NetworkAddress.Reset()
+				if err := s.NetworkAddress.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"networkAddress\"")
+			}
+		case "powerState":
+			if err := func() error {
+				s.PowerState.Reset()
+				if err := s.PowerState.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"powerState\"")
+			}
+		case "protectionJobInfo":
+			if err := func() error {
+				s.ProtectionJobInfo.Reset()
+				if err := s.ProtectionJobInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"protectionJobInfo\"")
+			}
+		case "protectionPolicyAppliedAtInfo":
+			if err := func() error {
+				s.ProtectionPolicyAppliedAtInfo.Reset()
+				if err := s.ProtectionPolicyAppliedAtInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"protectionPolicyAppliedAtInfo\"")
+			}
+		case "protectionStatus":
+			if err := func() error {
+				s.ProtectionStatus.Reset()
+				if err := s.ProtectionStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"protectionStatus\"")
+			}
+		case "recoveryPointsExist":
+			if err := func() error {
+				s.RecoveryPointsExist.Reset()
+				if err := s.RecoveryPointsExist.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"recoveryPointsExist\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "services":
+			if err := func() error {
+				if err := s.Services.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"services\"")
+			}
+		case "state":
+			if err := func() error {
+				s.State.Reset()
+				if err := s.State.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"state\"")
+			}
+		case "stateReason":
+			if err := func() error {
+				s.StateReason.Reset()
+				if err := s.StateReason.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"stateReason\"")
+			}
+		case "status":
+			if err := func() error {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "type":
+			requiredBitSet[3] |= 1 << 5
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "uid":
+			if err := func() error {
+				s.UID.Reset()
+				if err := s.UID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uid\"")
+			}
+		case "updatedAt":
+			requiredBitSet[3] |= 1 << 7
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vmStatus\"")
+				return errors.Wrap(err, "decode field \"updatedAt\"")
 			}
-		case "vmStateMessage":
+		case "vclsVm":
 			if err := func() error {
-				s.VmStateMessage.Reset()
-				if err := s.VmStateMessage.Decode(d); err != nil {
+				s.VclsVm.Reset()
+				if err := s.VclsVm.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vmStateMessage\"")
+				return errors.Wrap(err, "decode field \"vclsVm\"")
 			}
-		case "completedAt":
+		case "virtualDisks":
 			if err := func() error {
-				s.CompletedAt.Reset()
-				if err := s.CompletedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.VirtualDisks = make([]VirtualMachineVirtualDisksItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VirtualMachineVirtualDisksItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.VirtualDisks = append(s.VirtualDisks, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"completedAt\"")
+				return errors.Wrap(err, "decode field \"virtualDisks\"")
+			}
+		case "vmClassification":
+			if err := func() error {
+				s.VmClassification.Reset()
+				if err := s.VmClassification.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vmClassification\"")
+			}
+		case "vmConfigPath":
+			if err := func() error {
+				s.VmConfigPath.Reset()
+				if err := s.VmConfigPath.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vmConfigPath\"")
+			}
+		case "vmPerfMetricInfo":
+			if err := func() error {
+				s.VmPerfMetricInfo.Reset()
+				if err := s.VmPerfMetricInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vmPerfMetricInfo\"")
+			}
+		case "vmProtectionGroupsInfo":
+			if err := func() error {
+				s.VmProtectionGroupsInfo = make([]VmProtectionGroupInfo, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VmProtectionGroupInfo
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.VmProtectionGroupsInfo = append(s.VmProtectionGroupsInfo, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vmProtectionGroupsInfo\"")
+			}
+		case "volumesInfo":
+			if err := func() error {
+				s.VolumesInfo = make([]VolumeInfo, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VolumeInfo
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.VolumesInfo = append(s.VolumesInfo, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"volumesInfo\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode VMDeployInstance")
+		return errors.Wrap(err, "decode VirtualMachine")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001101,
+	for i, mask := range [5]uint8{
+		0b01000000,
+		0b10000100,
+		0b00000000,
+		0b10100000,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3943,8 +10931,8 @@ func (s *VMDeployInstance) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfVMDeployInstance) {
-					name = jsonFieldsNameOfVMDeployInstance[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfVirtualMachine) {
+					name = jsonFieldsNameOfVirtualMachine[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3954,2172 +10942,3474 @@ func (s *VMDeployInstance) Decode(d *jx.Decoder) error {
 				})
 				// Reset bit.
 				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMDeployInstance) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMDeployInstance) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes VMPowerOffBadRequest as json.
-func (s *VMPowerOffBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes VMPowerOffBadRequest from json.
-func (s *VMPowerOffBadRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOffBadRequest to nil")
-	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = VMPowerOffBadRequest(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOffBadRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOffBadRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes VMPowerOffConflict as json.
-func (s *VMPowerOffConflict) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes VMPowerOffConflict from json.
-func (s *VMPowerOffConflict) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOffConflict to nil")
-	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+			}
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = VMPowerOffConflict(unwrapped)
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOffConflict) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachine) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOffConflict) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachine) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMPowerOffForbidden as json.
-func (s *VMPowerOffForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes VirtualMachineAllowedOperationsItem as json.
+func (s VirtualMachineAllowedOperationsItem) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes VMPowerOffForbidden from json.
-func (s *VMPowerOffForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineAllowedOperationsItem from json.
+func (s *VirtualMachineAllowedOperationsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOffForbidden to nil")
+		return errors.New("invalid: unable to decode VirtualMachineAllowedOperationsItem to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = VMPowerOffForbidden(unwrapped)
+	// Try to use constant string.
+	switch VirtualMachineAllowedOperationsItem(v) {
+	case VirtualMachineAllowedOperationsItemVIRTUALMACHINEPOWERON:
+		*s = VirtualMachineAllowedOperationsItemVIRTUALMACHINEPOWERON
+	case VirtualMachineAllowedOperationsItemVIRTUALMACHINEPOWEROFF:
+		*s = VirtualMachineAllowedOperationsItemVIRTUALMACHINEPOWEROFF
+	case VirtualMachineAllowedOperationsItemVIRTUALMACHINERESET:
+		*s = VirtualMachineAllowedOperationsItemVIRTUALMACHINERESET
+	case VirtualMachineAllowedOperationsItemVIRTUALMACHINESHUTDOWNGUESTOS:
+		*s = VirtualMachineAllowedOperationsItemVIRTUALMACHINESHUTDOWNGUESTOS
+	case VirtualMachineAllowedOperationsItemVIRTUALMACHINERESTARTGUESTOS:
+		*s = VirtualMachineAllowedOperationsItemVIRTUALMACHINERESTARTGUESTOS
+	case VirtualMachineAllowedOperationsItemVIRTUALMACHINEDELETE:
+		*s = VirtualMachineAllowedOperationsItemVIRTUALMACHINEDELETE
+	default:
+		*s = VirtualMachineAllowedOperationsItem(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOffForbidden) MarshalJSON() ([]byte, error) {
+func (s VirtualMachineAllowedOperationsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOffForbidden) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineAllowedOperationsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMPowerOffInternalServerError as json.
-func (s *VMPowerOffInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineAppInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes VMPowerOffInternalServerError from json.
-func (s *VMPowerOffInternalServerError) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOffInternalServerError to nil")
-	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+// encodeFields encodes fields.
+func (s *VirtualMachineAppInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.Vmware.Set {
+			e.FieldStart("vmware")
+			s.Vmware.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = VMPowerOffInternalServerError(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOffInternalServerError) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOffInternalServerError) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes VMPowerOffNotFound as json.
-func (s *VMPowerOffNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfVirtualMachineAppInfo = [1]string{
+	0: "vmware",
 }
 
-// Decode decodes VMPowerOffNotFound from json.
-func (s *VMPowerOffNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineAppInfo from json.
+func (s *VirtualMachineAppInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOffNotFound to nil")
+		return errors.New("invalid: unable to decode VirtualMachineAppInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "vmware":
+			if err := func() error {
+				s.Vmware.Reset()
+				if err := s.Vmware.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vmware\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineAppInfo")
 	}
-	*s = VMPowerOffNotFound(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOffNotFound) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineAppInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOffNotFound) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineAppInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMPowerOffServiceUnavailable as json.
-func (s *VMPowerOffServiceUnavailable) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineAppInfoVmware) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes VMPowerOffServiceUnavailable from json.
-func (s *VMPowerOffServiceUnavailable) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOffServiceUnavailable to nil")
+// encodeFields encodes fields.
+func (s *VirtualMachineAppInfoVmware) encodeFields(e *jx.Encoder) {
+	{
+		if s.DatacenterInfo.Set {
+			e.FieldStart("datacenterInfo")
+			s.DatacenterInfo.Encode(e)
+		}
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.DatastoresInfo != nil {
+			e.FieldStart("datastoresInfo")
+			e.ArrStart()
+			for _, elem := range s.DatastoresInfo {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = VMPowerOffServiceUnavailable(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOffServiceUnavailable) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOffServiceUnavailable) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes VMPowerOffUnauthorized as json.
-func (s *VMPowerOffUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
-}
-
-// Decode decodes VMPowerOffUnauthorized from json.
-func (s *VMPowerOffUnauthorized) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOffUnauthorized to nil")
+	{
+		if s.Moref.Set {
+			e.FieldStart("moref")
+			s.Moref.Encode(e)
+		}
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.ResourcePoolInfo.Set {
+			e.FieldStart("resourcePoolInfo")
+			s.ResourcePoolInfo.Encode(e)
+		}
+	}
+	{
+		if s.ToolsInfo.Set {
+			e.FieldStart("toolsInfo")
+			s.ToolsInfo.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = VMPowerOffUnauthorized(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOffUnauthorized) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOffUnauthorized) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes VMPowerOnBadRequest as json.
-func (s *VMPowerOnBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfVirtualMachineAppInfoVmware = [6]string{
+	0: "datacenterInfo",
+	1: "datastoresInfo",
+	2: "moref",
+	3: "resourcePoolInfo",
+	4: "toolsInfo",
+	5: "type",
 }
 
-// Decode decodes VMPowerOnBadRequest from json.
-func (s *VMPowerOnBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineAppInfoVmware from json.
+func (s *VirtualMachineAppInfoVmware) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOnBadRequest to nil")
+		return errors.New("invalid: unable to decode VirtualMachineAppInfoVmware to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "datacenterInfo":
+			if err := func() error {
+				s.DatacenterInfo.Reset()
+				if err := s.DatacenterInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"datacenterInfo\"")
+			}
+		case "datastoresInfo":
+			if err := func() error {
+				s.DatastoresInfo = make([]VirtualMachineAppInfoVmwareDatastoresInfoItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VirtualMachineAppInfoVmwareDatastoresInfoItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.DatastoresInfo = append(s.DatastoresInfo, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"datastoresInfo\"")
+			}
+		case "moref":
+			if err := func() error {
+				s.Moref.Reset()
+				if err := s.Moref.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"moref\"")
+			}
+		case "resourcePoolInfo":
+			if err := func() error {
+				s.ResourcePoolInfo.Reset()
+				if err := s.ResourcePoolInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourcePoolInfo\"")
+			}
+		case "toolsInfo":
+			if err := func() error {
+				s.ToolsInfo.Reset()
+				if err := s.ToolsInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"toolsInfo\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineAppInfoVmware")
 	}
-	*s = VMPowerOnBadRequest(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOnBadRequest) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineAppInfoVmware) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOnBadRequest) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineAppInfoVmware) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMPowerOnConflict as json.
-func (s *VMPowerOnConflict) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineAppInfoVmwareDatastoresInfoItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes VMPowerOnConflict from json.
-func (s *VMPowerOnConflict) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOnConflict to nil")
+// encodeFields encodes fields.
+func (s *VirtualMachineAppInfoVmwareDatastoresInfoItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
+		}
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = VMPowerOnConflict(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOnConflict) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOnConflict) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes VMPowerOnForbidden as json.
-func (s *VMPowerOnForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfVirtualMachineAppInfoVmwareDatastoresInfoItem = [5]string{
+	0: "displayName",
+	1: "id",
+	2: "name",
+	3: "resourceUri",
+	4: "type",
 }
 
-// Decode decodes VMPowerOnForbidden from json.
-func (s *VMPowerOnForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineAppInfoVmwareDatastoresInfoItem from json.
+func (s *VirtualMachineAppInfoVmwareDatastoresInfoItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOnForbidden to nil")
+		return errors.New("invalid: unable to decode VirtualMachineAppInfoVmwareDatastoresInfoItem to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "displayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"displayName\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineAppInfoVmwareDatastoresInfoItem")
 	}
-	*s = VMPowerOnForbidden(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOnForbidden) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineAppInfoVmwareDatastoresInfoItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOnForbidden) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineAppInfoVmwareDatastoresInfoItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMPowerOnInternalServerError as json.
-func (s *VMPowerOnInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineAppInfoVmwareResourcePoolInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes VMPowerOnInternalServerError from json.
-func (s *VMPowerOnInternalServerError) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOnInternalServerError to nil")
+// encodeFields encodes fields.
+func (s *VirtualMachineAppInfoVmwareResourcePoolInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
+		}
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Moref.Set {
+			e.FieldStart("moref")
+			s.Moref.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = VMPowerOnInternalServerError(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOnInternalServerError) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOnInternalServerError) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes VMPowerOnNotFound as json.
-func (s *VMPowerOnNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfVirtualMachineAppInfoVmwareResourcePoolInfo = [6]string{
+	0: "displayName",
+	1: "id",
+	2: "moref",
+	3: "name",
+	4: "resourceUri",
+	5: "type",
 }
 
-// Decode decodes VMPowerOnNotFound from json.
-func (s *VMPowerOnNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineAppInfoVmwareResourcePoolInfo from json.
+func (s *VirtualMachineAppInfoVmwareResourcePoolInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOnNotFound to nil")
+		return errors.New("invalid: unable to decode VirtualMachineAppInfoVmwareResourcePoolInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "displayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"displayName\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "moref":
+			if err := func() error {
+				s.Moref.Reset()
+				if err := s.Moref.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"moref\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineAppInfoVmwareResourcePoolInfo")
 	}
-	*s = VMPowerOnNotFound(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOnNotFound) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineAppInfoVmwareResourcePoolInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOnNotFound) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineAppInfoVmwareResourcePoolInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMPowerOnServiceUnavailable as json.
-func (s *VMPowerOnServiceUnavailable) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineAppInfoVmwareToolsInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *VirtualMachineAppInfoVmwareToolsInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
+	{
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
+		}
+	}
 }
 
-// Decode decodes VMPowerOnServiceUnavailable from json.
-func (s *VMPowerOnServiceUnavailable) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfVirtualMachineAppInfoVmwareToolsInfo = [3]string{
+	0: "status",
+	1: "type",
+	2: "version",
+}
+
+// Decode decodes VirtualMachineAppInfoVmwareToolsInfo from json.
+func (s *VirtualMachineAppInfoVmwareToolsInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOnServiceUnavailable to nil")
+		return errors.New("invalid: unable to decode VirtualMachineAppInfoVmwareToolsInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "status":
+			if err := func() error {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "version":
+			if err := func() error {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"version\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineAppInfoVmwareToolsInfo")
 	}
-	*s = VMPowerOnServiceUnavailable(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOnServiceUnavailable) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineAppInfoVmwareToolsInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOnServiceUnavailable) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineAppInfoVmwareToolsInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMPowerOnUnauthorized as json.
-func (s *VMPowerOnUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes VirtualMachineAppInfoVmwareToolsInfoStatus as json.
+func (s VirtualMachineAppInfoVmwareToolsInfoStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes VMPowerOnUnauthorized from json.
-func (s *VMPowerOnUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineAppInfoVmwareToolsInfoStatus from json.
+func (s *VirtualMachineAppInfoVmwareToolsInfoStatus) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerOnUnauthorized to nil")
+		return errors.New("invalid: unable to decode VirtualMachineAppInfoVmwareToolsInfoStatus to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = VMPowerOnUnauthorized(unwrapped)
+	// Try to use constant string.
+	switch VirtualMachineAppInfoVmwareToolsInfoStatus(v) {
+	case VirtualMachineAppInfoVmwareToolsInfoStatusNOTINSTALLED:
+		*s = VirtualMachineAppInfoVmwareToolsInfoStatusNOTINSTALLED
+	case VirtualMachineAppInfoVmwareToolsInfoStatusNOTRUNNING:
+		*s = VirtualMachineAppInfoVmwareToolsInfoStatusNOTRUNNING
+	case VirtualMachineAppInfoVmwareToolsInfoStatusOK:
+		*s = VirtualMachineAppInfoVmwareToolsInfoStatusOK
+	case VirtualMachineAppInfoVmwareToolsInfoStatusOLD:
+		*s = VirtualMachineAppInfoVmwareToolsInfoStatusOLD
+	default:
+		*s = VirtualMachineAppInfoVmwareToolsInfoStatus(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerOnUnauthorized) MarshalJSON() ([]byte, error) {
+func (s VirtualMachineAppInfoVmwareToolsInfoStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerOnUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineAppInfoVmwareToolsInfoStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMPowerResetBadRequest as json.
-func (s *VMPowerResetBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes VirtualMachineAppInfoVmwareType as json.
+func (s VirtualMachineAppInfoVmwareType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes VMPowerResetBadRequest from json.
-func (s *VMPowerResetBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineAppInfoVmwareType from json.
+func (s *VirtualMachineAppInfoVmwareType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerResetBadRequest to nil")
+		return errors.New("invalid: unable to decode VirtualMachineAppInfoVmwareType to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = VMPowerResetBadRequest(unwrapped)
+	// Try to use constant string.
+	switch VirtualMachineAppInfoVmwareType(v) {
+	case VirtualMachineAppInfoVmwareTypeVMFS:
+		*s = VirtualMachineAppInfoVmwareTypeVMFS
+	case VirtualMachineAppInfoVmwareTypeVVOL:
+		*s = VirtualMachineAppInfoVmwareTypeVVOL
+	case VirtualMachineAppInfoVmwareTypeNFS:
+		*s = VirtualMachineAppInfoVmwareTypeNFS
+	case VirtualMachineAppInfoVmwareTypeVSAN:
+		*s = VirtualMachineAppInfoVmwareTypeVSAN
+	default:
+		*s = VirtualMachineAppInfoVmwareType(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerResetBadRequest) MarshalJSON() ([]byte, error) {
+func (s VirtualMachineAppInfoVmwareType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerResetBadRequest) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineAppInfoVmwareType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMPowerResetConflict as json.
-func (s *VMPowerResetConflict) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes VirtualMachineAppType as json.
+func (s VirtualMachineAppType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes VMPowerResetConflict from json.
-func (s *VMPowerResetConflict) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineAppType from json.
+func (s *VirtualMachineAppType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerResetConflict to nil")
+		return errors.New("invalid: unable to decode VirtualMachineAppType to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = VMPowerResetConflict(unwrapped)
+	// Try to use constant string.
+	switch VirtualMachineAppType(v) {
+	case VirtualMachineAppTypeVMWARE:
+		*s = VirtualMachineAppTypeVMWARE
+	default:
+		*s = VirtualMachineAppType(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerResetConflict) MarshalJSON() ([]byte, error) {
+func (s VirtualMachineAppType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerResetConflict) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineAppType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMPowerResetForbidden as json.
-func (s *VMPowerResetForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineClusterInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *VirtualMachineClusterInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
 }
 
-// Decode decodes VMPowerResetForbidden from json.
-func (s *VMPowerResetForbidden) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfVirtualMachineClusterInfo = [5]string{
+	0: "displayName",
+	1: "id",
+	2: "name",
+	3: "resourceUri",
+	4: "type",
+}
+
+// Decode decodes VirtualMachineClusterInfo from json.
+func (s *VirtualMachineClusterInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerResetForbidden to nil")
+		return errors.New("invalid: unable to decode VirtualMachineClusterInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "displayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"displayName\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineClusterInfo")
 	}
-	*s = VMPowerResetForbidden(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerResetForbidden) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineClusterInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerResetForbidden) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineClusterInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMPowerResetInternalServerError as json.
-func (s *VMPowerResetInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineComputeInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes VMPowerResetInternalServerError from json.
-func (s *VMPowerResetInternalServerError) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerResetInternalServerError to nil")
+// encodeFields encodes fields.
+func (s *VirtualMachineComputeInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.MemorySizeInMib.Set {
+			e.FieldStart("memorySizeInMib")
+			s.MemorySizeInMib.Encode(e)
+		}
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.NumCpuCores.Set {
+			e.FieldStart("numCpuCores")
+			s.NumCpuCores.Encode(e)
+		}
+	}
+	{
+		if s.NumCpuThreads.Set {
+			e.FieldStart("numCpuThreads")
+			s.NumCpuThreads.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = VMPowerResetInternalServerError(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerResetInternalServerError) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerResetInternalServerError) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes VMPowerResetNotFound as json.
-func (s *VMPowerResetNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfVirtualMachineComputeInfo = [3]string{
+	0: "memorySizeInMib",
+	1: "numCpuCores",
+	2: "numCpuThreads",
 }
 
-// Decode decodes VMPowerResetNotFound from json.
-func (s *VMPowerResetNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineComputeInfo from json.
+func (s *VirtualMachineComputeInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerResetNotFound to nil")
+		return errors.New("invalid: unable to decode VirtualMachineComputeInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "memorySizeInMib":
+			if err := func() error {
+				s.MemorySizeInMib.Reset()
+				if err := s.MemorySizeInMib.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"memorySizeInMib\"")
+			}
+		case "numCpuCores":
+			if err := func() error {
+				s.NumCpuCores.Reset()
+				if err := s.NumCpuCores.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"numCpuCores\"")
+			}
+		case "numCpuThreads":
+			if err := func() error {
+				s.NumCpuThreads.Reset()
+				if err := s.NumCpuThreads.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"numCpuThreads\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineComputeInfo")
 	}
-	*s = VMPowerResetNotFound(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerResetNotFound) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineComputeInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerResetNotFound) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineComputeInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMPowerResetServiceUnavailable as json.
-func (s *VMPowerResetServiceUnavailable) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineFolderInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *VirtualMachineFolderInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
 }
 
-// Decode decodes VMPowerResetServiceUnavailable from json.
-func (s *VMPowerResetServiceUnavailable) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfVirtualMachineFolderInfo = [5]string{
+	0: "displayName",
+	1: "id",
+	2: "name",
+	3: "resourceUri",
+	4: "type",
+}
+
+// Decode decodes VirtualMachineFolderInfo from json.
+func (s *VirtualMachineFolderInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerResetServiceUnavailable to nil")
+		return errors.New("invalid: unable to decode VirtualMachineFolderInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "displayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"displayName\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineFolderInfo")
 	}
-	*s = VMPowerResetServiceUnavailable(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerResetServiceUnavailable) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineFolderInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerResetServiceUnavailable) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineFolderInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMPowerResetUnauthorized as json.
-func (s *VMPowerResetUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineGuestInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *VirtualMachineGuestInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.BuildVersion.Set {
+			e.FieldStart("buildVersion")
+			s.BuildVersion.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ReleaseVersion.Set {
+			e.FieldStart("releaseVersion")
+			s.ReleaseVersion.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
 }
 
-// Decode decodes VMPowerResetUnauthorized from json.
-func (s *VMPowerResetUnauthorized) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfVirtualMachineGuestInfo = [4]string{
+	0: "buildVersion",
+	1: "name",
+	2: "releaseVersion",
+	3: "type",
+}
+
+// Decode decodes VirtualMachineGuestInfo from json.
+func (s *VirtualMachineGuestInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMPowerResetUnauthorized to nil")
+		return errors.New("invalid: unable to decode VirtualMachineGuestInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "buildVersion":
+			if err := func() error {
+				s.BuildVersion.Reset()
+				if err := s.BuildVersion.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"buildVersion\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "releaseVersion":
+			if err := func() error {
+				s.ReleaseVersion.Reset()
+				if err := s.ReleaseVersion.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"releaseVersion\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineGuestInfo")
 	}
-	*s = VMPowerResetUnauthorized(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMPowerResetUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineGuestInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMPowerResetUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineGuestInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMRefreshConflict as json.
-func (s *VMRefreshConflict) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes VirtualMachineGuestInfoType as json.
+func (s VirtualMachineGuestInfoType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes VMRefreshConflict from json.
-func (s *VMRefreshConflict) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineGuestInfoType from json.
+func (s *VirtualMachineGuestInfoType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMRefreshConflict to nil")
+		return errors.New("invalid: unable to decode VirtualMachineGuestInfoType to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = VMRefreshConflict(unwrapped)
+	// Try to use constant string.
+	switch VirtualMachineGuestInfoType(v) {
+	case VirtualMachineGuestInfoTypeWINDOWS:
+		*s = VirtualMachineGuestInfoTypeWINDOWS
+	case VirtualMachineGuestInfoTypeLINUX:
+		*s = VirtualMachineGuestInfoTypeLINUX
+	case VirtualMachineGuestInfoTypeOTHERS:
+		*s = VirtualMachineGuestInfoTypeOTHERS
+	default:
+		*s = VirtualMachineGuestInfoType(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMRefreshConflict) MarshalJSON() ([]byte, error) {
+func (s VirtualMachineGuestInfoType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRefreshConflict) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineGuestInfoType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMRefreshForbidden as json.
-func (s *VMRefreshForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineHostInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes VMRefreshForbidden from json.
-func (s *VMRefreshForbidden) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMRefreshForbidden to nil")
+// encodeFields encodes fields.
+func (s *VirtualMachineHostInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
+		}
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = VMRefreshForbidden(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMRefreshForbidden) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRefreshForbidden) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes VMRefreshInternalServerError as json.
-func (s *VMRefreshInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfVirtualMachineHostInfo = [5]string{
+	0: "displayName",
+	1: "id",
+	2: "name",
+	3: "resourceUri",
+	4: "type",
 }
 
-// Decode decodes VMRefreshInternalServerError from json.
-func (s *VMRefreshInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineHostInfo from json.
+func (s *VirtualMachineHostInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMRefreshInternalServerError to nil")
+		return errors.New("invalid: unable to decode VirtualMachineHostInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "displayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"displayName\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineHostInfo")
 	}
-	*s = VMRefreshInternalServerError(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMRefreshInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineHostInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRefreshInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineHostInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMRefreshNotFound as json.
-func (s *VMRefreshNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineHypervisorManagerInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes VMRefreshNotFound from json.
-func (s *VMRefreshNotFound) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMRefreshNotFound to nil")
+// encodeFields encodes fields.
+func (s *VirtualMachineHypervisorManagerInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
+		}
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = VMRefreshNotFound(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMRefreshNotFound) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRefreshNotFound) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes VMRefreshUnauthorized as json.
-func (s *VMRefreshUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfVirtualMachineHypervisorManagerInfo = [5]string{
+	0: "displayName",
+	1: "id",
+	2: "name",
+	3: "resourceUri",
+	4: "type",
 }
 
-// Decode decodes VMRefreshUnauthorized from json.
-func (s *VMRefreshUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineHypervisorManagerInfo from json.
+func (s *VirtualMachineHypervisorManagerInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMRefreshUnauthorized to nil")
+		return errors.New("invalid: unable to decode VirtualMachineHypervisorManagerInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "displayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"displayName\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineHypervisorManagerInfo")
 	}
-	*s = VMRefreshUnauthorized(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMRefreshUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineHypervisorManagerInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRefreshUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineHypervisorManagerInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *VMRequest) Encode(e *jx.Encoder) {
+func (s *VirtualMachineList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *VMRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("requestId")
-		e.Str(s.RequestId)
-	}
-	{
-		e.FieldStart("operation")
-		s.Operation.Encode(e)
-	}
-	{
-		e.FieldStart("requestStatus")
-		s.RequestStatus.Encode(e)
-	}
+func (s *VirtualMachineList) encodeFields(e *jx.Encoder) {
 	{
-		if s.WorkspaceId.Set {
-			e.FieldStart("workspaceId")
-			s.WorkspaceId.Encode(e)
+		e.FieldStart("items")
+		e.ArrStart()
+		for _, elem := range s.Items {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 	{
-		if s.DatacenterId.Set {
-			e.FieldStart("datacenterId")
-			s.DatacenterId.Encode(e)
-		}
+		e.FieldStart("count")
+		e.Int(s.Count)
 	}
 	{
-		e.FieldStart("createdAt")
-		json.EncodeDateTime(e, s.CreatedAt)
+		e.FieldStart("total")
+		e.Int(s.Total)
 	}
 	{
-		if s.CompletedAt.Set {
-			e.FieldStart("completedAt")
-			s.CompletedAt.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("limit")
+		e.Int(s.Limit)
 	}
 	{
-		e.FieldStart("requestMetadata")
-		e.Str(s.RequestMetadata)
+		e.FieldStart("offset")
+		e.Int(s.Offset)
 	}
 }
 
-var jsonFieldsNameOfVMRequest = [8]string{
-	0: "requestId",
-	1: "operation",
-	2: "requestStatus",
-	3: "workspaceId",
-	4: "datacenterId",
-	5: "createdAt",
-	6: "completedAt",
-	7: "requestMetadata",
+var jsonFieldsNameOfVirtualMachineList = [5]string{
+	0: "items",
+	1: "count",
+	2: "total",
+	3: "limit",
+	4: "offset",
 }
 
-// Decode decodes VMRequest from json.
-func (s *VMRequest) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineList from json.
+func (s *VirtualMachineList) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMRequest to nil")
+		return errors.New("invalid: unable to decode VirtualMachineList to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "requestId":
+		case "items":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.RequestId = string(v)
-				if err != nil {
+				s.Items = make([]VirtualMachine, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VirtualMachine
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Items = append(s.Items, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"requestId\"")
+				return errors.Wrap(err, "decode field \"items\"")
 			}
-		case "operation":
+		case "count":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Operation.Decode(d); err != nil {
+				v, err := d.Int()
+				s.Count = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"operation\"")
+				return errors.Wrap(err, "decode field \"count\"")
 			}
-		case "requestStatus":
+		case "total":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				if err := s.RequestStatus.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"requestStatus\"")
-			}
-		case "workspaceId":
-			if err := func() error {
-				s.WorkspaceId.Reset()
-				if err := s.WorkspaceId.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"workspaceId\"")
-			}
-		case "datacenterId":
-			if err := func() error {
-				s.DatacenterId.Reset()
-				if err := s.DatacenterId.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"datacenterId\"")
-			}
-		case "createdAt":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
+				v, err := d.Int()
+				s.Total = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"createdAt\"")
+				return errors.Wrap(err, "decode field \"total\"")
 			}
-		case "completedAt":
+		case "limit":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.CompletedAt.Reset()
-				if err := s.CompletedAt.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Int()
+				s.Limit = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"completedAt\"")
+				return errors.Wrap(err, "decode field \"limit\"")
 			}
-		case "requestMetadata":
-			requiredBitSet[0] |= 1 << 7
+		case "offset":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				v, err := d.Str()
-				s.RequestMetadata = string(v)
+				v, err := d.Int()
+				s.Offset = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"requestMetadata\"")
+				return errors.Wrap(err, "decode field \"offset\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode VMRequest")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b10100111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfVMRequest) {
-					name = jsonFieldsNameOfVMRequest[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes VMRequestOperation as json.
-func (s VMRequestOperation) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes VMRequestOperation from json.
-func (s *VMRequestOperation) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMRequestOperation to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch VMRequestOperation(v) {
-	case VMRequestOperationVmDeploy:
-		*s = VMRequestOperationVmDeploy
-	case VMRequestOperationVmReconfigure:
-		*s = VMRequestOperationVmReconfigure
-	case VMRequestOperationVmPowerOn:
-		*s = VMRequestOperationVmPowerOn
-	case VMRequestOperationVmPowerOff:
-		*s = VMRequestOperationVmPowerOff
-	case VMRequestOperationVmReset:
-		*s = VMRequestOperationVmReset
-	case VMRequestOperationVmRestart:
-		*s = VMRequestOperationVmRestart
-	case VMRequestOperationVmShutdown:
-		*s = VMRequestOperationVmShutdown
-	case VMRequestOperationVmDelete:
-		*s = VMRequestOperationVmDelete
-	default:
-		*s = VMRequestOperation(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s VMRequestOperation) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRequestOperation) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes VMRequestRequestStatus as json.
-func (s VMRequestRequestStatus) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes VMRequestRequestStatus from json.
-func (s *VMRequestRequestStatus) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMRequestRequestStatus to nil")
+		return errors.Wrap(err, "decode VirtualMachineList")
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfVirtualMachineList) {
+					name = jsonFieldsNameOfVirtualMachineList[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	// Try to use constant string.
-	switch VMRequestRequestStatus(v) {
-	case VMRequestRequestStatusNew:
-		*s = VMRequestRequestStatusNew
-	case VMRequestRequestStatusInprogress:
-		*s = VMRequestRequestStatusInprogress
-	case VMRequestRequestStatusSuccess:
-		*s = VMRequestRequestStatusSuccess
-	case VMRequestRequestStatusFailure:
-		*s = VMRequestRequestStatusFailure
-	default:
-		*s = VMRequestRequestStatus(v)
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s VMRequestRequestStatus) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineList) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRequestRequestStatus) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineList) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *VMRequestWithDeploy) Encode(e *jx.Encoder) {
+func (s *VirtualMachineNetworkAdaptersItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *VMRequestWithDeploy) encodeFields(e *jx.Encoder) {
+func (s *VirtualMachineNetworkAdaptersItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("vm_request")
-		s.VMRequest.Encode(e)
+		if s.MacAddress.Set {
+			e.FieldStart("macAddress")
+			s.MacAddress.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("vm_deploy_list")
-		e.ArrStart()
-		for _, elem := range s.VMDeployList {
-			elem.Encode(e)
+		if s.MacAddressType.Set {
+			e.FieldStart("macAddressType")
+			s.MacAddressType.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.NetworkDetails.Set {
+			e.FieldStart("networkDetails")
+			s.NetworkDetails.Encode(e)
 		}
-		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfVMRequestWithDeploy = [2]string{
-	0: "vm_request",
-	1: "vm_deploy_list",
+var jsonFieldsNameOfVirtualMachineNetworkAdaptersItem = [4]string{
+	0: "macAddress",
+	1: "macAddressType",
+	2: "name",
+	3: "networkDetails",
 }
 
-// Decode decodes VMRequestWithDeploy from json.
-func (s *VMRequestWithDeploy) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineNetworkAdaptersItem from json.
+func (s *VirtualMachineNetworkAdaptersItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMRequestWithDeploy to nil")
+		return errors.New("invalid: unable to decode VirtualMachineNetworkAdaptersItem to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "vm_request":
-			requiredBitSet[0] |= 1 << 0
+		case "macAddress":
 			if err := func() error {
-				if err := s.VMRequest.Decode(d); err != nil {
+				s.MacAddress.Reset()
+				if err := s.MacAddress.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vm_request\"")
+				return errors.Wrap(err, "decode field \"macAddress\"")
 			}
-		case "vm_deploy_list":
-			requiredBitSet[0] |= 1 << 1
+		case "macAddressType":
 			if err := func() error {
-				s.VMDeployList = make([]VMDeployInstance, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem VMDeployInstance
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.VMDeployList = append(s.VMDeployList, elem)
-					return nil
-				}); err != nil {
+				s.MacAddressType.Reset()
+				if err := s.MacAddressType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vm_deploy_list\"")
+				return errors.Wrap(err, "decode field \"macAddressType\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "networkDetails":
+			if err := func() error {
+				s.NetworkDetails.Reset()
+				if err := s.NetworkDetails.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"networkDetails\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode VMRequestWithDeploy")
+		return errors.Wrap(err, "decode VirtualMachineNetworkAdaptersItem")
 	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfVMRequestWithDeploy) {
-					name = jsonFieldsNameOfVMRequestWithDeploy[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VirtualMachineNetworkAdaptersItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VirtualMachineNetworkAdaptersItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VirtualMachineNetworkAdaptersItemMacAddressType as json.
+func (s VirtualMachineNetworkAdaptersItemMacAddressType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes VirtualMachineNetworkAdaptersItemMacAddressType from json.
+func (s *VirtualMachineNetworkAdaptersItemMacAddressType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VirtualMachineNetworkAdaptersItemMacAddressType to nil")
 	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch VirtualMachineNetworkAdaptersItemMacAddressType(v) {
+	case VirtualMachineNetworkAdaptersItemMacAddressTypeMANUAL:
+		*s = VirtualMachineNetworkAdaptersItemMacAddressTypeMANUAL
+	case VirtualMachineNetworkAdaptersItemMacAddressTypeAUTOMATIC:
+		*s = VirtualMachineNetworkAdaptersItemMacAddressTypeAUTOMATIC
+	default:
+		*s = VirtualMachineNetworkAdaptersItemMacAddressType(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMRequestWithDeploy) MarshalJSON() ([]byte, error) {
+func (s VirtualMachineNetworkAdaptersItemMacAddressType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRequestWithDeploy) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineNetworkAdaptersItemMacAddressType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *VMRequestsList) Encode(e *jx.Encoder) {
+func (s *VirtualMachineNetworkAdaptersItemNetworkDetails) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *VMRequestsList) encodeFields(e *jx.Encoder) {
+func (s *VirtualMachineNetworkAdaptersItemNetworkDetails) encodeFields(e *jx.Encoder) {
 	{
-		if s.VMMinusRequestsListCount.Set {
-			e.FieldStart("vm-requests_list_count")
-			s.VMMinusRequestsListCount.Encode(e)
+		if s.ConnectAtPowerOn.Set {
+			e.FieldStart("connectAtPowerOn")
+			s.ConnectAtPowerOn.Encode(e)
 		}
 	}
 	{
-		if s.VMMinusDeployListCount.Set {
-			e.FieldStart("vm-deploy_list_count")
-			s.VMMinusDeployListCount.Encode(e)
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
 		}
 	}
 	{
-		if s.Items.Set {
-			e.FieldStart("items")
-			s.Items.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.State.Set {
+			e.FieldStart("state")
+			s.State.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfVMRequestsList = [3]string{
-	0: "vm-requests_list_count",
-	1: "vm-deploy_list_count",
-	2: "items",
+var jsonFieldsNameOfVirtualMachineNetworkAdaptersItemNetworkDetails = [7]string{
+	0: "connectAtPowerOn",
+	1: "displayName",
+	2: "id",
+	3: "name",
+	4: "resourceUri",
+	5: "state",
+	6: "type",
 }
 
-// Decode decodes VMRequestsList from json.
-func (s *VMRequestsList) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineNetworkAdaptersItemNetworkDetails from json.
+func (s *VirtualMachineNetworkAdaptersItemNetworkDetails) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMRequestsList to nil")
+		return errors.New("invalid: unable to decode VirtualMachineNetworkAdaptersItemNetworkDetails to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "vm-requests_list_count":
+		case "connectAtPowerOn":
 			if err := func() error {
-				s.VMMinusRequestsListCount.Reset()
-				if err := s.VMMinusRequestsListCount.Decode(d); err != nil {
+				s.ConnectAtPowerOn.Reset()
+				if err := s.ConnectAtPowerOn.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vm-requests_list_count\"")
+				return errors.Wrap(err, "decode field \"connectAtPowerOn\"")
 			}
-		case "vm-deploy_list_count":
+		case "displayName":
 			if err := func() error {
-				s.VMMinusDeployListCount.Reset()
-				if err := s.VMMinusDeployListCount.Decode(d); err != nil {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vm-deploy_list_count\"")
+				return errors.Wrap(err, "decode field \"displayName\"")
 			}
-		case "items":
+		case "id":
 			if err := func() error {
-				s.Items.Reset()
-				if err := s.Items.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "state":
+			if err := func() error {
+				s.State.Reset()
+				if err := s.State.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"state\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"items\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode VMRequestsList")
+		return errors.Wrap(err, "decode VirtualMachineNetworkAdaptersItemNetworkDetails")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMRequestsList) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineNetworkAdaptersItemNetworkDetails) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRequestsList) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineNetworkAdaptersItemNetworkDetails) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *VMRequestsListItems) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *VMRequestsListItems) encodeFields(e *jx.Encoder) {
-	{
-		if s.VMRequetsList != nil {
-			e.FieldStart("vm_requets_list")
-			e.ArrStart()
-			for _, elem := range s.VMRequetsList {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.VMDeployList != nil {
-			e.FieldStart("vm_deploy_list")
-			e.ArrStart()
-			for _, elem := range s.VMDeployList {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-}
-
-var jsonFieldsNameOfVMRequestsListItems = [2]string{
-	0: "vm_requets_list",
-	1: "vm_deploy_list",
+// Encode encodes VirtualMachineNetworkAdaptersItemNetworkDetailsState as json.
+func (s VirtualMachineNetworkAdaptersItemNetworkDetailsState) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes VMRequestsListItems from json.
-func (s *VMRequestsListItems) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineNetworkAdaptersItemNetworkDetailsState from json.
+func (s *VirtualMachineNetworkAdaptersItemNetworkDetailsState) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMRequestsListItems to nil")
+		return errors.New("invalid: unable to decode VirtualMachineNetworkAdaptersItemNetworkDetailsState to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "vm_requets_list":
-			if err := func() error {
-				s.VMRequetsList = make([]VMRequest, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem VMRequest
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.VMRequetsList = append(s.VMRequetsList, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vm_requets_list\"")
-			}
-		case "vm_deploy_list":
-			if err := func() error {
-				s.VMDeployList = make([]VMDeployInstance, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem VMDeployInstance
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.VMDeployList = append(s.VMDeployList, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vm_deploy_list\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode VMRequestsListItems")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch VirtualMachineNetworkAdaptersItemNetworkDetailsState(v) {
+	case VirtualMachineNetworkAdaptersItemNetworkDetailsStateAVAILABLE:
+		*s = VirtualMachineNetworkAdaptersItemNetworkDetailsStateAVAILABLE
+	case VirtualMachineNetworkAdaptersItemNetworkDetailsStateDELETED:
+		*s = VirtualMachineNetworkAdaptersItemNetworkDetailsStateDELETED
+	default:
+		*s = VirtualMachineNetworkAdaptersItemNetworkDetailsState(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMRequestsListItems) MarshalJSON() ([]byte, error) {
+func (s VirtualMachineNetworkAdaptersItemNetworkDetailsState) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRequestsListItems) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineNetworkAdaptersItemNetworkDetailsState) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMRestartGuestOSBadRequest as json.
-func (s *VMRestartGuestOSBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes VirtualMachinePowerState as json.
+func (s VirtualMachinePowerState) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes VMRestartGuestOSBadRequest from json.
-func (s *VMRestartGuestOSBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachinePowerState from json.
+func (s *VirtualMachinePowerState) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMRestartGuestOSBadRequest to nil")
+		return errors.New("invalid: unable to decode VirtualMachinePowerState to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = VMRestartGuestOSBadRequest(unwrapped)
+	// Try to use constant string.
+	switch VirtualMachinePowerState(v) {
+	case VirtualMachinePowerStatePOWEREDON:
+		*s = VirtualMachinePowerStatePOWEREDON
+	case VirtualMachinePowerStatePOWEREDOFF:
+		*s = VirtualMachinePowerStatePOWEREDOFF
+	case VirtualMachinePowerStateSUSPENDED:
+		*s = VirtualMachinePowerStateSUSPENDED
+	case VirtualMachinePowerStateUNKNOWN:
+		*s = VirtualMachinePowerStateUNKNOWN
+	default:
+		*s = VirtualMachinePowerState(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMRestartGuestOSBadRequest) MarshalJSON() ([]byte, error) {
+func (s VirtualMachinePowerState) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRestartGuestOSBadRequest) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachinePowerState) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMRestartGuestOSConflict as json.
-func (s *VMRestartGuestOSConflict) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes VirtualMachineState as json.
+func (s VirtualMachineState) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes VMRestartGuestOSConflict from json.
-func (s *VMRestartGuestOSConflict) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineState from json.
+func (s *VirtualMachineState) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMRestartGuestOSConflict to nil")
+		return errors.New("invalid: unable to decode VirtualMachineState to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = VMRestartGuestOSConflict(unwrapped)
+	// Try to use constant string.
+	switch VirtualMachineState(v) {
+	case VirtualMachineStateOK:
+		*s = VirtualMachineStateOK
+	case VirtualMachineStateUNAVAILABLE:
+		*s = VirtualMachineStateUNAVAILABLE
+	case VirtualMachineStateERROR:
+		*s = VirtualMachineStateERROR
+	case VirtualMachineStateCREATING:
+		*s = VirtualMachineStateCREATING
+	case VirtualMachineStateDELETING:
+		*s = VirtualMachineStateDELETING
+	case VirtualMachineStateUPDATING:
+		*s = VirtualMachineStateUPDATING
+	case VirtualMachineStateREFRESHING:
+		*s = VirtualMachineStateREFRESHING
+	case VirtualMachineStateRESTORING:
+		*s = VirtualMachineStateRESTORING
+	case VirtualMachineStateRESTOREFAILED:
+		*s = VirtualMachineStateRESTOREFAILED
+	case VirtualMachineStateDELETED:
+		*s = VirtualMachineStateDELETED
+	default:
+		*s = VirtualMachineState(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMRestartGuestOSConflict) MarshalJSON() ([]byte, error) {
+func (s VirtualMachineState) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRestartGuestOSConflict) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineState) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMRestartGuestOSForbidden as json.
-func (s *VMRestartGuestOSForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes VirtualMachineStatus as json.
+func (s VirtualMachineStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes VMRestartGuestOSForbidden from json.
-func (s *VMRestartGuestOSForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineStatus from json.
+func (s *VirtualMachineStatus) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMRestartGuestOSForbidden to nil")
+		return errors.New("invalid: unable to decode VirtualMachineStatus to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = VMRestartGuestOSForbidden(unwrapped)
+	// Try to use constant string.
+	switch VirtualMachineStatus(v) {
+	case VirtualMachineStatusOK:
+		*s = VirtualMachineStatusOK
+	case VirtualMachineStatusERROR:
+		*s = VirtualMachineStatusERROR
+	case VirtualMachineStatusWARNING:
+		*s = VirtualMachineStatusWARNING
+	default:
+		*s = VirtualMachineStatus(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMRestartGuestOSForbidden) MarshalJSON() ([]byte, error) {
+func (s VirtualMachineStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRestartGuestOSForbidden) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMRestartGuestOSInternalServerError as json.
-func (s *VMRestartGuestOSInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineVirtualDisksItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *VirtualMachineVirtualDisksItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.AppInfo.Set {
+			e.FieldStart("appInfo")
+			s.AppInfo.Encode(e)
+		}
+	}
+	{
+		if s.CapacityInBytes.Set {
+			e.FieldStart("capacityInBytes")
+			s.CapacityInBytes.Encode(e)
+		}
+	}
+	{
+		if s.FilePath.Set {
+			e.FieldStart("filePath")
+			s.FilePath.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.UID.Set {
+			e.FieldStart("uid")
+			s.UID.Encode(e)
+		}
+	}
 }
 
-// Decode decodes VMRestartGuestOSInternalServerError from json.
-func (s *VMRestartGuestOSInternalServerError) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfVirtualMachineVirtualDisksItem = [6]string{
+	0: "appInfo",
+	1: "capacityInBytes",
+	2: "filePath",
+	3: "id",
+	4: "name",
+	5: "uid",
+}
+
+// Decode decodes VirtualMachineVirtualDisksItem from json.
+func (s *VirtualMachineVirtualDisksItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMRestartGuestOSInternalServerError to nil")
+		return errors.New("invalid: unable to decode VirtualMachineVirtualDisksItem to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "appInfo":
+			if err := func() error {
+				s.AppInfo.Reset()
+				if err := s.AppInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"appInfo\"")
+			}
+		case "capacityInBytes":
+			if err := func() error {
+				s.CapacityInBytes.Reset()
+				if err := s.CapacityInBytes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"capacityInBytes\"")
+			}
+		case "filePath":
+			if err := func() error {
+				s.FilePath.Reset()
+				if err := s.FilePath.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"filePath\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "uid":
+			if err := func() error {
+				s.UID.Reset()
+				if err := s.UID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uid\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineVirtualDisksItem")
 	}
-	*s = VMRestartGuestOSInternalServerError(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMRestartGuestOSInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineVirtualDisksItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRestartGuestOSInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineVirtualDisksItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMRestartGuestOSNotFound as json.
-func (s *VMRestartGuestOSNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineVirtualDisksItemAppInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *VirtualMachineVirtualDisksItemAppInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.Vmware.Set {
+			e.FieldStart("vmware")
+			s.Vmware.Encode(e)
+		}
+	}
 }
 
-// Decode decodes VMRestartGuestOSNotFound from json.
-func (s *VMRestartGuestOSNotFound) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfVirtualMachineVirtualDisksItemAppInfo = [1]string{
+	0: "vmware",
+}
+
+// Decode decodes VirtualMachineVirtualDisksItemAppInfo from json.
+func (s *VirtualMachineVirtualDisksItemAppInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMRestartGuestOSNotFound to nil")
+		return errors.New("invalid: unable to decode VirtualMachineVirtualDisksItemAppInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "vmware":
+			if err := func() error {
+				s.Vmware.Reset()
+				if err := s.Vmware.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vmware\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineVirtualDisksItemAppInfo")
 	}
-	*s = VMRestartGuestOSNotFound(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMRestartGuestOSNotFound) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineVirtualDisksItemAppInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRestartGuestOSNotFound) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineVirtualDisksItemAppInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMRestartGuestOSServiceUnavailable as json.
-func (s *VMRestartGuestOSServiceUnavailable) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineVirtualDisksItemAppInfoVmware) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes VMRestartGuestOSServiceUnavailable from json.
-func (s *VMRestartGuestOSServiceUnavailable) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode VMRestartGuestOSServiceUnavailable to nil")
+// encodeFields encodes fields.
+func (s *VirtualMachineVirtualDisksItemAppInfoVmware) encodeFields(e *jx.Encoder) {
+	{
+		if s.DatastoreInfo.Set {
+			e.FieldStart("datastoreInfo")
+			s.DatastoreInfo.Encode(e)
+		}
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+	{
+		if s.DiskUuidEnabled.Set {
+			e.FieldStart("diskUuidEnabled")
+			s.DiskUuidEnabled.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = VMRestartGuestOSServiceUnavailable(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *VMRestartGuestOSServiceUnavailable) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRestartGuestOSServiceUnavailable) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes VMRestartGuestOSUnauthorized as json.
-func (s *VMRestartGuestOSUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+var jsonFieldsNameOfVirtualMachineVirtualDisksItemAppInfoVmware = [3]string{
+	0: "datastoreInfo",
+	1: "diskUuidEnabled",
+	2: "type",
 }
 
-// Decode decodes VMRestartGuestOSUnauthorized from json.
-func (s *VMRestartGuestOSUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineVirtualDisksItemAppInfoVmware from json.
+func (s *VirtualMachineVirtualDisksItemAppInfoVmware) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMRestartGuestOSUnauthorized to nil")
+		return errors.New("invalid: unable to decode VirtualMachineVirtualDisksItemAppInfoVmware to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "datastoreInfo":
+			if err := func() error {
+				s.DatastoreInfo.Reset()
+				if err := s.DatastoreInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"datastoreInfo\"")
+			}
+		case "diskUuidEnabled":
+			if err := func() error {
+				s.DiskUuidEnabled.Reset()
+				if err := s.DiskUuidEnabled.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"diskUuidEnabled\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineVirtualDisksItemAppInfoVmware")
 	}
-	*s = VMRestartGuestOSUnauthorized(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMRestartGuestOSUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineVirtualDisksItemAppInfoVmware) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMRestartGuestOSUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineVirtualDisksItemAppInfoVmware) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMShutdownGuestOSBadRequest as json.
-func (s *VMShutdownGuestOSBadRequest) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *VirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
 }
 
-// Decode decodes VMShutdownGuestOSBadRequest from json.
-func (s *VMShutdownGuestOSBadRequest) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfVirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo = [5]string{
+	0: "displayName",
+	1: "id",
+	2: "name",
+	3: "resourceUri",
+	4: "type",
+}
+
+// Decode decodes VirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo from json.
+func (s *VirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMShutdownGuestOSBadRequest to nil")
+		return errors.New("invalid: unable to decode VirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "displayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"displayName\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo")
 	}
-	*s = VMShutdownGuestOSBadRequest(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMShutdownGuestOSBadRequest) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMShutdownGuestOSBadRequest) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineVirtualDisksItemAppInfoVmwareDatastoreInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMShutdownGuestOSConflict as json.
-func (s *VMShutdownGuestOSConflict) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes VirtualMachineVirtualDisksItemAppInfoVmwareType as json.
+func (s VirtualMachineVirtualDisksItemAppInfoVmwareType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes VMShutdownGuestOSConflict from json.
-func (s *VMShutdownGuestOSConflict) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineVirtualDisksItemAppInfoVmwareType from json.
+func (s *VirtualMachineVirtualDisksItemAppInfoVmwareType) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMShutdownGuestOSConflict to nil")
+		return errors.New("invalid: unable to decode VirtualMachineVirtualDisksItemAppInfoVmwareType to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = VMShutdownGuestOSConflict(unwrapped)
+	// Try to use constant string.
+	switch VirtualMachineVirtualDisksItemAppInfoVmwareType(v) {
+	case VirtualMachineVirtualDisksItemAppInfoVmwareTypeVMFS:
+		*s = VirtualMachineVirtualDisksItemAppInfoVmwareTypeVMFS
+	case VirtualMachineVirtualDisksItemAppInfoVmwareTypeVVOL:
+		*s = VirtualMachineVirtualDisksItemAppInfoVmwareTypeVVOL
+	case VirtualMachineVirtualDisksItemAppInfoVmwareTypePRDM:
+		*s = VirtualMachineVirtualDisksItemAppInfoVmwareTypePRDM
+	case VirtualMachineVirtualDisksItemAppInfoVmwareTypeVRDM:
+		*s = VirtualMachineVirtualDisksItemAppInfoVmwareTypeVRDM
+	default:
+		*s = VirtualMachineVirtualDisksItemAppInfoVmwareType(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMShutdownGuestOSConflict) MarshalJSON() ([]byte, error) {
+func (s VirtualMachineVirtualDisksItemAppInfoVmwareType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMShutdownGuestOSConflict) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineVirtualDisksItemAppInfoVmwareType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMShutdownGuestOSForbidden as json.
-func (s *VMShutdownGuestOSForbidden) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
-
-	unwrapped.Encode(e)
+// Encode encodes VirtualMachineVmClassification as json.
+func (s VirtualMachineVmClassification) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes VMShutdownGuestOSForbidden from json.
-func (s *VMShutdownGuestOSForbidden) Decode(d *jx.Decoder) error {
+// Decode decodes VirtualMachineVmClassification from json.
+func (s *VirtualMachineVmClassification) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMShutdownGuestOSForbidden to nil")
+		return errors.New("invalid: unable to decode VirtualMachineVmClassification to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = VMShutdownGuestOSForbidden(unwrapped)
+	// Try to use constant string.
+	switch VirtualMachineVmClassification(v) {
+	case VirtualMachineVmClassificationDATAORCHESTRATOR:
+		*s = VirtualMachineVmClassificationDATAORCHESTRATOR
+	case VirtualMachineVmClassificationPROTECTIONSTOREGATEWAY:
+		*s = VirtualMachineVmClassificationPROTECTIONSTOREGATEWAY
+	case VirtualMachineVmClassificationVCLSVM:
+		*s = VirtualMachineVmClassificationVCLSVM
+	case VirtualMachineVmClassificationTEMPLATEVM:
+		*s = VirtualMachineVmClassificationTEMPLATEVM
+	case VirtualMachineVmClassificationOMNICUBEVM:
+		*s = VirtualMachineVmClassificationOMNICUBEVM
+	case VirtualMachineVmClassificationDSCVM:
+		*s = VirtualMachineVmClassificationDSCVM
+	default:
+		*s = VirtualMachineVmClassification(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMShutdownGuestOSForbidden) MarshalJSON() ([]byte, error) {
+func (s VirtualMachineVmClassification) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMShutdownGuestOSForbidden) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineVmClassification) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMShutdownGuestOSInternalServerError as json.
-func (s *VMShutdownGuestOSInternalServerError) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *VirtualMachineVmPerfMetricInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *VirtualMachineVmPerfMetricInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.AverageReadLatency.Set {
+			e.FieldStart("averageReadLatency")
+			s.AverageReadLatency.Encode(e)
+		}
+	}
+	{
+		if s.AverageWriteLatency.Set {
+			e.FieldStart("averageWriteLatency")
+			s.AverageWriteLatency.Encode(e)
+		}
+	}
+	{
+		if s.CpuAllocatedInMhz.Set {
+			e.FieldStart("cpuAllocatedInMhz")
+			s.CpuAllocatedInMhz.Encode(e)
+		}
+	}
+	{
+		if s.CpuUsedInMhz.Set {
+			e.FieldStart("cpuUsedInMhz")
+			s.CpuUsedInMhz.Encode(e)
+		}
+	}
+	{
+		if s.MemoryAllocatedInMb.Set {
+			e.FieldStart("memoryAllocatedInMb")
+			s.MemoryAllocatedInMb.Encode(e)
+		}
+	}
+	{
+		if s.MemoryUsedInMb.Set {
+			e.FieldStart("memoryUsedInMb")
+			s.MemoryUsedInMb.Encode(e)
+		}
+	}
+	{
+		if s.StorageAllocatedInKb.Set {
+			e.FieldStart("storageAllocatedInKb")
+			s.StorageAllocatedInKb.Encode(e)
+		}
+	}
+	{
+		if s.StorageUsedInBytes.Set {
+			e.FieldStart("storageUsedInBytes")
+			s.StorageUsedInBytes.Encode(e)
+		}
+	}
+	{
+		if s.TotalReadIops.Set {
+			e.FieldStart("totalReadIops")
+			s.TotalReadIops.Encode(e)
+		}
+	}
+	{
+		if s.TotalWriteIops.Set {
+			e.FieldStart("totalWriteIops")
+			s.TotalWriteIops.Encode(e)
+		}
+	}
 }
 
-// Decode decodes VMShutdownGuestOSInternalServerError from json.
-func (s *VMShutdownGuestOSInternalServerError) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfVirtualMachineVmPerfMetricInfo = [10]string{
+	0: "averageReadLatency",
+	1: "averageWriteLatency",
+	2: "cpuAllocatedInMhz",
+	3: "cpuUsedInMhz",
+	4: "memoryAllocatedInMb",
+	5: "memoryUsedInMb",
+	6: "storageAllocatedInKb",
+	7: "storageUsedInBytes",
+	8: "totalReadIops",
+	9: "totalWriteIops",
+}
+
+// Decode decodes VirtualMachineVmPerfMetricInfo from json.
+func (s *VirtualMachineVmPerfMetricInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMShutdownGuestOSInternalServerError to nil")
+		return errors.New("invalid: unable to decode VirtualMachineVmPerfMetricInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "averageReadLatency":
+			if err := func() error {
+				s.AverageReadLatency.Reset()
+				if err := s.AverageReadLatency.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"averageReadLatency\"")
+			}
+		case "averageWriteLatency":
+			if err := func() error {
+				s.AverageWriteLatency.Reset()
+				if err := s.AverageWriteLatency.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"averageWriteLatency\"")
+			}
+		case "cpuAllocatedInMhz":
+			if err := func() error {
+				s.CpuAllocatedInMhz.Reset()
+				if err := s.CpuAllocatedInMhz.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cpuAllocatedInMhz\"")
+			}
+		case "cpuUsedInMhz":
+			if err := func() error {
+				s.CpuUsedInMhz.Reset()
+				if err := s.CpuUsedInMhz.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cpuUsedInMhz\"")
+			}
+		case "memoryAllocatedInMb":
+			if err := func() error {
+				s.MemoryAllocatedInMb.Reset()
+				if err := s.MemoryAllocatedInMb.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"memoryAllocatedInMb\"")
+			}
+		case "memoryUsedInMb":
+			if err := func() error {
+				s.MemoryUsedInMb.Reset()
+				if err := s.MemoryUsedInMb.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"memoryUsedInMb\"")
+			}
+		case "storageAllocatedInKb":
+			if err := func() error {
+				s.StorageAllocatedInKb.Reset()
+				if err := s.StorageAllocatedInKb.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"storageAllocatedInKb\"")
+			}
+		case "storageUsedInBytes":
+			if err := func() error {
+				s.StorageUsedInBytes.Reset()
+				if err := s.StorageUsedInBytes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"storageUsedInBytes\"")
+			}
+		case "totalReadIops":
+			if err := func() error {
+				s.TotalReadIops.Reset()
+				if err := s.TotalReadIops.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"totalReadIops\"")
+			}
+		case "totalWriteIops":
+			if err := func() error {
+				s.TotalWriteIops.Reset()
+				if err := s.TotalWriteIops.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"totalWriteIops\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VirtualMachineVmPerfMetricInfo")
 	}
-	*s = VMShutdownGuestOSInternalServerError(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMShutdownGuestOSInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *VirtualMachineVmPerfMetricInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMShutdownGuestOSInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *VirtualMachineVmPerfMetricInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMShutdownGuestOSNotFound as json.
-func (s *VMShutdownGuestOSNotFound) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *VmProtectionGroupInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *VmProtectionGroupInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
 }
 
-// Decode decodes VMShutdownGuestOSNotFound from json.
-func (s *VMShutdownGuestOSNotFound) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfVmProtectionGroupInfo = [4]string{
+	0: "id",
+	1: "name",
+	2: "resourceUri",
+	3: "type",
+}
+
+// Decode decodes VmProtectionGroupInfo from json.
+func (s *VmProtectionGroupInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMShutdownGuestOSNotFound to nil")
+		return errors.New("invalid: unable to decode VmProtectionGroupInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode VmProtectionGroupInfo")
 	}
-	*s = VMShutdownGuestOSNotFound(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMShutdownGuestOSNotFound) MarshalJSON() ([]byte, error) {
+func (s *VmProtectionGroupInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMShutdownGuestOSNotFound) UnmarshalJSON(data []byte) error {
+func (s *VmProtectionGroupInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMShutdownGuestOSServiceUnavailable as json.
-func (s *VMShutdownGuestOSServiceUnavailable) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *VolumeInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *VolumeInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.ScsiIdentifier.Set {
+			e.FieldStart("scsiIdentifier")
+			s.ScsiIdentifier.Encode(e)
+		}
+	}
+	{
+		if s.SizeInBytes.Set {
+			e.FieldStart("sizeInBytes")
+			s.SizeInBytes.Encode(e)
+		}
+	}
+	{
+		if s.StorageFolderInfo.Set {
+			e.FieldStart("storageFolderInfo")
+			s.StorageFolderInfo.Encode(e)
+		}
+	}
+	{
+		if s.StoragePoolInfo.Set {
+			e.FieldStart("storagePoolInfo")
+			s.StoragePoolInfo.Encode(e)
+		}
+	}
+	{
+		if s.StorageSystemInfo.Set {
+			e.FieldStart("storageSystemInfo")
+			s.StorageSystemInfo.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
+	{
+		if s.VolumeSetInfo.Set {
+			e.FieldStart("volumeSetInfo")
+			s.VolumeSetInfo.Encode(e)
+		}
+	}
 }
 
-// Decode decodes VMShutdownGuestOSServiceUnavailable from json.
-func (s *VMShutdownGuestOSServiceUnavailable) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfVolumeInfo = [11]string{
+	0:  "displayName",
+	1:  "id",
+	2:  "name",
+	3:  "resourceUri",
+	4:  "scsiIdentifier",
+	5:  "sizeInBytes",
+	6:  "storageFolderInfo",
+	7:  "storagePoolInfo",
+	8:  "storageSystemInfo",
+	9:  "type",
+	10: "volumeSetInfo",
+}
+
+// Decode decodes VolumeInfo from json.
+func (s *VolumeInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMShutdownGuestOSServiceUnavailable to nil")
+		return errors.New("invalid: unable to decode VolumeInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "displayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"displayName\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "scsiIdentifier":
+			if err := func() error {
+				s.ScsiIdentifier.Reset()
+				if err := s.ScsiIdentifier.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scsiIdentifier\"")
+			}
+		case "sizeInBytes":
+			if err := func() error {
+				s.SizeInBytes.Reset()
+				if err := s.SizeInBytes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sizeInBytes\"")
+			}
+		case "storageFolderInfo":
+			if err := func() error {
+				s.StorageFolderInfo.Reset()
+				if err := s.StorageFolderInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"storageFolderInfo\"")
+			}
+		case "storagePoolInfo":
+			if err := func() error {
+				s.StoragePoolInfo.Reset()
+				if err := s.StoragePoolInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"storagePoolInfo\"")
+			}
+		case "storageSystemInfo":
+			if err := func() error {
+				s.StorageSystemInfo.Reset()
+				if err := s.StorageSystemInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"storageSystemInfo\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "volumeSetInfo":
+			if err := func() error {
+				s.VolumeSetInfo.Reset()
+				if err := s.VolumeSetInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"volumeSetInfo\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VolumeInfo")
 	}
-	*s = VMShutdownGuestOSServiceUnavailable(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMShutdownGuestOSServiceUnavailable) MarshalJSON() ([]byte, error) {
+func (s *VolumeInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMShutdownGuestOSServiceUnavailable) UnmarshalJSON(data []byte) error {
+func (s *VolumeInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes VMShutdownGuestOSUnauthorized as json.
-func (s *VMShutdownGuestOSUnauthorized) Encode(e *jx.Encoder) {
-	unwrapped := (*ErrorResponse)(s)
+// Encode implements json.Marshaler.
+func (s *VolumeSetInfo) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	unwrapped.Encode(e)
+// encodeFields encodes fields.
+func (s *VolumeSetInfo) encodeFields(e *jx.Encoder) {
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("displayName")
+			s.DisplayName.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ResourceUri.Set {
+			e.FieldStart("resourceUri")
+			s.ResourceUri.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
+	}
 }
 
-// Decode decodes VMShutdownGuestOSUnauthorized from json.
-func (s *VMShutdownGuestOSUnauthorized) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfVolumeSetInfo = [5]string{
+	0: "displayName",
+	1: "id",
+	2: "name",
+	3: "resourceUri",
+	4: "type",
+}
+
+// Decode decodes VolumeSetInfo from json.
+func (s *VolumeSetInfo) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode VMShutdownGuestOSUnauthorized to nil")
+		return errors.New("invalid: unable to decode VolumeSetInfo to nil")
 	}
-	var unwrapped ErrorResponse
-	if err := func() error {
-		if err := unwrapped.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "displayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"displayName\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "resourceUri":
+			if err := func() error {
+				s.ResourceUri.Reset()
+				if err := s.ResourceUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resourceUri\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode VolumeSetInfo")
 	}
-	*s = VMShutdownGuestOSUnauthorized(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *VMShutdownGuestOSUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *VolumeSetInfo) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *VMShutdownGuestOSUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *VolumeSetInfo) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -6,10 +6,14 @@ package api
 type OperationName = string
 
 const (
+	CancelVirtualMachineRequestOperation  OperationName = "CancelVirtualMachineRequest"
 	EditVMOperation                       OperationName = "EditVM"
+	GetVirtualMachineOperation            OperationName = "GetVirtualMachine"
+	GetVirtualMachineListOperation        OperationName = "GetVirtualMachineList"
 	GetVirtualMachineRequestOperation     OperationName = "GetVirtualMachineRequest"
 	GetVirtualMachineRequestListOperation OperationName = "GetVirtualMachineRequestList"
 	HCIDeployVMOperation                  OperationName = "HCIDeployVM"
+	RetryVirtualMachineRequestOperation   OperationName = "RetryVirtualMachineRequest"
 	VMDeleteOperation                     OperationName = "VMDelete"
 	VMPowerOffOperation                   OperationName = "VMPowerOff"
 	VMPowerOnOperation                    OperationName = "VMPowerOn"
@@ -59,10 +59,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 			if len(elem) == 0 {
 				switch r.Method {
+				case "GET":
+					s.handleGetVirtualMachineListRequest([0]string{}, elemIsEscaped, w, r)
 				case "POST":
 					s.handleHCIDeployVMRequest([0]string{}, elemIsEscaped, w, r)
 				default:
-					s.notAllowed(w, r, "POST")
+					s.notAllowed(w, r, "GET,POST")
 				}
 
 				return
@@ -96,16 +98,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					// Param: "request-id"
-					// Leaf parameter, slashes are prohibited
+					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
+					if idx < 0 {
+						idx = len(elem)
 					}
-					args[0] = elem
-					elem = ""
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
 						case "GET":
 							s.handleGetVirtualMachineRequestRequest([1]string{
@@ -117,6 +118,66 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "cancel"
+
+							if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleCancelVirtualMachineRequestRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 'r': // Prefix: "retry"
+
+							if l := len("retry"); len(elem) >= l && elem[0:l] == "retry" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleRetryVirtualMachineRequestRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					}
 
 				}
 
@@ -143,8 +204,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						s.handleVMDeleteRequest([1]string{
 							args[0],
 						}, elemIsEscaped, w, r)
+					case "GET":
+						s.handleGetVirtualMachineRequest([1]string{
+							args[0],
+						}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "DELETE")
+						s.notAllowed(w, r, "DELETE,GET")
 					}
 
 					return
@@ -454,6 +519,14 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 			if len(elem) == 0 {
 				switch method {
+				case "GET":
+					r.name = GetVirtualMachineListOperation
+					r.summary = "List virtual machines"
+					r.operationID = "GetVirtualMachineList"
+					r.pathPattern = "/virtualization/v1beta1/virtual-machines"
+					r.args = args
+					r.count = 0
+					return r, true
 				case "POST":
 					r.name = HCIDeployVMOperation
 					r.summary = "Deploy virtual machine"
@@ -499,16 +572,15 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 
 					// Param: "request-id"
-					// Leaf parameter, slashes are prohibited
+					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
+					if idx < 0 {
+						idx = len(elem)
 					}
-					args[0] = elem
-					elem = ""
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch method {
 						case "GET":
 							r.name = GetVirtualMachineRequestOperation
@@ -522,6 +594,70 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "cancel"
+
+							if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = CancelVirtualMachineRequestOperation
+									r.summary = "Cancel a virtual machine request identified by {request-id}"
+									r.operationID = "CancelVirtualMachineRequest"
+									r.pathPattern = "/virtualization/v1beta1/virtual-machines-request/{request-id}/cancel"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'r': // Prefix: "retry"
+
+							if l := len("retry"); len(elem) >= l && elem[0:l] == "retry" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = RetryVirtualMachineRequestOperation
+									r.summary = "Retry a failed virtual machine request identified by {request-id}"
+									r.operationID = "RetryVirtualMachineRequest"
+									r.pathPattern = "/virtualization/v1beta1/virtual-machines-request/{request-id}/retry"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
 
 				}
 
@@ -552,6 +688,14 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						r.args = args
 						r.count = 1
 						return r, true
+					case "GET":
+						r.name = GetVirtualMachineOperation
+						r.summary = "Get a virtual machine"
+						r.operationID = "GetVirtualMachine"
+						r.pathPattern = "/virtualization/v1beta1/virtual-machines/{vm-id}"
+						r.args = args
+						r.count = 1
+						return r, true
 					default:
 						return
 					}
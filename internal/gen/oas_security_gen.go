@@ -35,10 +35,14 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 }
 
 var operationRolesBearer = map[string][]string{
+	CancelVirtualMachineRequestOperation:  []string{},
 	EditVMOperation:                       []string{},
+	GetVirtualMachineOperation:            []string{},
+	GetVirtualMachineListOperation:        []string{},
 	GetVirtualMachineRequestOperation:     []string{},
 	GetVirtualMachineRequestListOperation: []string{},
 	HCIDeployVMOperation:                  []string{},
+	RetryVirtualMachineRequestOperation:   []string{},
 	VMDeleteOperation:                     []string{},
 	VMPowerOffOperation:                   []string{},
 	VMPowerOnOperation:                    []string{},
@@ -8,6 +8,14 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// CancelVirtualMachineRequest implements CancelVirtualMachineRequest operation.
+	//
+	// Cancels a virtual machine request. A New request is cancelled at once, together with its deploy
+	// instances. An in-progress request is asked to stop; deploy instances that have not started are
+	// cancelled and the request moves to Cancelled once the executor reaches its next step.
+	//
+	// POST /virtualization/v1beta1/virtual-machines-request/{request-id}/cancel
+	CancelVirtualMachineRequest(ctx context.Context, params CancelVirtualMachineRequestParams) (CancelVirtualMachineRequestRes, error)
 	// EditVM implements EditVM operation.
 	//
 	// Updates CPU, memory, network adapters, and disks of a virtual machine. This operation can be
@@ -15,6 +23,21 @@ type Handler interface {
 	//
 	// POST /virtualization/v1beta1/virtual-machines/{vm-id}/update-hardware
 	EditVM(ctx context.Context, req *EditVM, params EditVMParams) (EditVMRes, error)
+	// GetVirtualMachine implements GetVirtualMachine operation.
+	//
+	// Details of a virtual machine deployed in the caller's workspace, combining its deploy instance and
+	// request history with live data from vm-monitor.
+	//
+	// GET /virtualization/v1beta1/virtual-machines/{vm-id}
+	GetVirtualMachine(ctx context.Context, params GetVirtualMachineParams) (GetVirtualMachineRes, error)
+	// GetVirtualMachineList implements GetVirtualMachineList operation.
+	//
+	// Page through the virtual machines deployed in the caller's workspace. Each virtual machine
+	// combines what is recorded locally, its deploy instance and request history, with live data from
+	// vm-monitor.
+	//
+	// GET /virtualization/v1beta1/virtual-machines
+	GetVirtualMachineList(ctx context.Context, params GetVirtualMachineListParams) (GetVirtualMachineListRes, error)
 	// GetVirtualMachineRequest implements GetVirtualMachineRequest operation.
 	//
 	// Details of a virtual machine request.
@@ -23,17 +46,27 @@ type Handler interface {
 	GetVirtualMachineRequest(ctx context.Context, params GetVirtualMachineRequestParams) (GetVirtualMachineRequestRes, error)
 	// GetVirtualMachineRequestList implements GetVirtualMachineRequestList operation.
 	//
-	// Details of a virtual machine request.
+	// Page through virtual machine requests, optionally filtered by operation, status, workspace and
+	// creation time.
 	//
 	// GET /virtualization/v1beta1/virtual-machines-request
-	GetVirtualMachineRequestList(ctx context.Context) (GetVirtualMachineRequestListRes, error)
+	GetVirtualMachineRequestList(ctx context.Context, params GetVirtualMachineRequestListParams) (GetVirtualMachineRequestListRes, error)
 	// HCIDeployVM implements HCIDeployVM operation.
 	//
 	// Deploys one or more virtual machines in HCI environment with specified template and storage
 	// provisioning policy.
 	//
 	// POST /virtualization/v1beta1/virtual-machines
-	HCIDeployVM(ctx context.Context, req *HCIDeployVM) (HCIDeployVMRes, error)
+	HCIDeployVM(ctx context.Context, req *HCIDeployVM, params HCIDeployVMParams) (HCIDeployVMRes, error)
+	// RetryVirtualMachineRequest implements RetryVirtualMachineRequest operation.
+	//
+	// Retries a failed virtual machine request. A new request linked to the failed one through
+	// parentRequestId is created from the stored request metadata. For a deploy only the instances that
+	// did not deploy are replayed. A request can be retried once; retry the new request if it fails as
+	// well.
+	//
+	// POST /virtualization/v1beta1/virtual-machines-request/{request-id}/retry
+	RetryVirtualMachineRequest(ctx context.Context, params RetryVirtualMachineRequestParams) (RetryVirtualMachineRequestRes, error)
 	// VMDelete implements VMDelete operation.
 	//
 	// Delete a virtual machine.
@@ -0,0 +1,55 @@
+package handler_impl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	api "vm/internal/gen"
+	"vm/pkg/auth"
+	"vm/pkg/cinterface"
+	"vm/pkg/constants"
+
+	"github.com/go-faster/jx"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+// NewErrorHandler returns the server's ErrorHandler. Security failures are written as an
+// api.ErrorResponse, 403 when the policy denied the operation and 401 otherwise; all other
+// errors keep ogen's default body.
+func NewErrorHandler(logger cinterface.Logger) api.ErrorHandler {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+		var secErr *ogenerrors.SecurityError
+		if !errors.As(err, &secErr) {
+			ogenerrors.DefaultErrorHandler(ctx, w, r, err)
+			return
+		}
+
+		errRes := api.ErrorResponse{
+			ErrorCode: constants.UnauthorizedErrorCode,
+			Message:   "Missing or invalid bearer token",
+		}
+		if errors.Is(err, auth.ErrForbidden) {
+			errRes.ErrorCode = constants.AuthorizationErrorCode
+			errRes.Message = "Token is not permitted to call " + secErr.OperationName()
+		}
+		errRes.HttpStatusCode = constants.ErrorCodeToStatus[errRes.ErrorCode]
+		if reqID, ok := ctx.Value("request-ID").(string); ok {
+			errRes.DebugId = reqID
+		}
+		writeErrorResponse(w, logger, &errRes)
+	}
+}
+
+func writeErrorResponse(w http.ResponseWriter, logger cinterface.Logger, errRes *api.ErrorResponse) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	errRes.Encode(e)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(errRes.HttpStatusCode)
+	if _, err := w.Write(e.Bytes()); err != nil {
+		logger.Error(constants.General, constants.Api, "Failed to write error response", map[constants.ExtraKey]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
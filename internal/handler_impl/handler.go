@@ -3,9 +3,11 @@ package handler_impl
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"strings"
-	"time"
 
+	"vm/internal/client"
 	imagemanager "vm/internal/client/image_manager"
 	inframonitor "vm/internal/client/infra_monitor"
 	vmmonitor "vm/internal/client/vm_monitor"
@@ -32,12 +34,28 @@ func NewHandler(vmService service.VMService, deps *dependency.Dependency) *Handl
 
 // EditVM implements the EditVM operation
 func (h *Handler) EditVM(ctx context.Context, req *api.EditVM, params api.EditVMParams) (api.EditVMRes, error) {
+	fingerprint, replay, idemErr := h.replayIdempotent(ctx, params.IdempotencyKey, constants.VMReconfigure, dto.EditVMMetadata{VMID: string(params.VMID), Spec: req})
+	if idemErr != nil {
+		res := constants.MapServiceError(*idemErr, constants.VMReconfigure, ctx)
+		return res.(api.EditVMRes), nil
+	}
+	if replay != nil {
+		return acceptedResponse(replay), nil
+	}
+
+	if err := validateEditVM(req); err != nil {
+		h.deps.Logger.Warnf("Invalid EditVM Request for VM %s: %s", params.VMID, err.Message)
+		res := constants.MapServiceError(*err, constants.VMReconfigure, ctx)
+		return res.(api.EditVMRes), nil
+	}
+
 	if err := h.validateVMExists(ctx, string(params.VMID), constants.VMReconfigure); err != nil {
 		res := constants.MapServiceError(*err, constants.VMReconfigure, ctx)
 		return res.(api.EditVMRes), nil
 	}
 
-	metadata, err := json.Marshal(params)
+	// Store the full body with the VM id so the executor can apply it.
+	metadata, err := json.Marshal(dto.EditVMMetadata{VMID: string(params.VMID), Spec: req})
 	if err != nil {
 		h.deps.Logger.Errorf("Failed to marshal EditVm Request: %v", err)
 		res := constants.MapServiceError(dto.ApiResponseError{
@@ -46,25 +64,30 @@ func (h *Handler) EditVM(ctx context.Context, req *api.EditVM, params api.EditVM
 		}, constants.VMReconfigure, ctx)
 		return res.(api.EditVMRes), nil
 	}
-	vmRequest, vmRequesterr := h.VMService.CreateVMRequest(ctx, constants.VMReconfigure, constants.StatusNew, string(metadata))
+	vmRequest, vmRequesterr := h.createVMRequest(ctx, params.IdempotencyKey, fingerprint, constants.VMReconfigure, string(metadata))
 	if vmRequesterr != nil {
 		h.deps.Logger.Errorf("Failed to marshal EditVm Request: %v", vmRequesterr)
 		res := constants.MapServiceError(*vmRequesterr, constants.VMReconfigure, ctx)
 		return res.(api.EditVMRes), nil
 	}
 
-	location := constants.VMRequestBasePath + vmRequest.RequestID
-	return &api.EmptyResponseHeaders{
-		Location: api.NewOptString(location),
-		Response: api.EmptyResponse{},
-	}, nil
-
+	return acceptedResponse(vmRequest), nil
 }
 
 // HCIDeployVM implements the HCIDeployVM operation
-func (h *Handler) HCIDeployVM(ctx context.Context, req *api.HCIDeployVM) (api.HCIDeployVMRes, error) {
+func (h *Handler) HCIDeployVM(ctx context.Context, req *api.HCIDeployVM, params api.HCIDeployVMParams) (api.HCIDeployVMRes, error) {
 	h.deps.Logger.Infof("HCIDeployVM handler invoked")
 
+	// Fingerprint the body before validation fills in the image path.
+	fingerprint, replay, idemErr := h.replayIdempotent(ctx, params.IdempotencyKey, constants.VMDeploy, req)
+	if idemErr != nil {
+		res := constants.MapServiceError(*idemErr, constants.VMDeploy, ctx)
+		return res.(api.HCIDeployVMRes), nil
+	}
+	if replay != nil {
+		return acceptedResponse(replay), nil
+	}
+
 	// Validate image and get image path
 	imagePath, err := h.validateImage(ctx, req.ImageSource.Value.ImageId.Value)
 	if err != nil {
@@ -92,7 +115,7 @@ func (h *Handler) HCIDeployVM(ctx context.Context, req *api.HCIDeployVM) (api.HC
 	}
 
 	// Call the service to create the VM request
-	vmRequest, vmRequesterr := h.VMService.CreateVMRequest(ctx, constants.VMDeploy, constants.StatusNew, string(metadata))
+	vmRequest, vmRequesterr := h.createVMRequest(ctx, params.IdempotencyKey, fingerprint, constants.VMDeploy, string(metadata))
 	if vmRequesterr != nil {
 		h.deps.Logger.Errorf("Failed to create VM Deploy request: %v", vmRequesterr)
 		res := constants.MapServiceError(*vmRequesterr, constants.VMDeploy, ctx)
@@ -100,25 +123,30 @@ func (h *Handler) HCIDeployVM(ctx context.Context, req *api.HCIDeployVM) (api.HC
 	}
 
 	// The vmRequest.RequestID is now populated by the BeforeCreate hook.
-	location := constants.VMRequestBasePath + vmRequest.RequestID
-
 	// Return the async response with the Location header
-	return &api.EmptyResponseHeaders{
-		Location: api.NewOptString(location),
-		Response: api.EmptyResponse{},
-	}, nil
+	return acceptedResponse(vmRequest), nil
 }
 
 // VMDelete implements the VMDelete operation
 func (h *Handler) VMDelete(ctx context.Context, params api.VMDeleteParams) (api.VMDeleteRes, error) {
 	h.deps.Logger.Infof("VMDelete handler invoked")
 
-	if err := h.validateVMExists(ctx, string(params.VMID), constants.VMDelete); err != nil {
+	target := dto.VMTargetMetadata{VMID: string(params.VMID), Force: params.Force.Value}
+	fingerprint, replay, idemErr := h.replayIdempotent(ctx, params.IdempotencyKey, constants.VMDelete, target)
+	if idemErr != nil {
+		res := constants.MapServiceError(*idemErr, constants.VMDelete, ctx)
+		return res.(api.VMDeleteRes), nil
+	}
+	if replay != nil {
+		return acceptedResponse(replay), nil
+	}
+
+	if err := h.validateVMPrecondition(ctx, string(params.VMID), constants.VMDelete, target.Force); err != nil {
 		res := constants.MapServiceError(*err, constants.VMDelete, ctx)
 		return res.(api.VMDeleteRes), nil
 	}
 
-	metadata, err := json.Marshal(params)
+	metadata, err := json.Marshal(target)
 	if err != nil {
 		h.deps.Logger.Errorf("Failed to marshal VMDelete Request: %v", err)
 		res := constants.MapServiceError(dto.ApiResponseError{
@@ -127,31 +155,36 @@ func (h *Handler) VMDelete(ctx context.Context, params api.VMDeleteParams) (api.
 		}, constants.VMDelete, ctx)
 		return res.(api.VMDeleteRes), nil
 	}
-	vmRequest, vmRequesterr := h.VMService.CreateVMRequest(ctx, constants.VMDelete, constants.StatusNew, string(metadata))
+	vmRequest, vmRequesterr := h.createVMRequest(ctx, params.IdempotencyKey, fingerprint, constants.VMDelete, string(metadata))
 	if vmRequesterr != nil {
 		h.deps.Logger.Errorf("Failed to create VMDelete Request: %v", vmRequesterr)
 		res := constants.MapServiceError(*vmRequesterr, constants.VMDelete, ctx)
 		return res.(api.VMDeleteRes), nil
 	}
 
-	location := constants.VMRequestBasePath + vmRequest.RequestID
-	return &api.EmptyResponseHeaders{
-		Location: api.NewOptString(location),
-		Response: api.EmptyResponse{},
-	}, nil
+	return acceptedResponse(vmRequest), nil
 }
 
 // VMPowerOff implements the VMPowerOff operation
 func (h *Handler) VMPowerOff(ctx context.Context, params api.VMPowerOffParams) (api.VMPowerOffRes, error) {
 	h.deps.Logger.Infof("VMPowerOff handler invoked")
 
+	fingerprint, replay, idemErr := h.replayIdempotent(ctx, params.IdempotencyKey, constants.VMPowerOff, params.VMID)
+	if idemErr != nil {
+		res := constants.MapServiceError(*idemErr, constants.VMPowerOff, ctx)
+		return res.(api.VMPowerOffRes), nil
+	}
+	if replay != nil {
+		return acceptedResponse(replay), nil
+	}
+
 	if err := h.validateVMExists(ctx, string(params.VMID), constants.VMPowerOff); err != nil {
 		res := constants.MapServiceError(*err, constants.VMPowerOff, ctx)
 		return res.(api.VMPowerOffRes), nil
 
 	}
 
-	metadata, err := json.Marshal(params)
+	metadata, err := json.Marshal(dto.VMTargetMetadata{VMID: string(params.VMID)})
 	if err != nil {
 		h.deps.Logger.Errorf("Failed to marshal VMPowerOff Request: %v", err)
 		res := constants.MapServiceError(dto.ApiResponseError{
@@ -160,31 +193,35 @@ func (h *Handler) VMPowerOff(ctx context.Context, params api.VMPowerOffParams) (
 		}, constants.VMPowerOff, ctx)
 		return res.(api.VMPowerOffRes), nil
 	}
-	vmRequest, vmRequesterr := h.VMService.CreateVMRequest(ctx, constants.VMPowerOff, constants.StatusNew, string(metadata))
+	vmRequest, vmRequesterr := h.createVMRequest(ctx, params.IdempotencyKey, fingerprint, constants.VMPowerOff, string(metadata))
 	if vmRequesterr != nil {
 		h.deps.Logger.Errorf("Failed to create VMPowerOff Request: %v", vmRequesterr)
 		res := constants.MapServiceError(*vmRequesterr, constants.VMPowerOff, ctx)
 		return res.(api.VMPowerOffRes), nil
 	}
 
-	location := constants.VMRequestBasePath + vmRequest.RequestID
-	return &api.EmptyResponseHeaders{
-		Location: api.NewOptString(location),
-		Response: api.EmptyResponse{},
-	}, nil
-
+	return acceptedResponse(vmRequest), nil
 }
 
 // VMPowerOn implements the VMPowerOn operation
 func (h *Handler) VMPowerOn(ctx context.Context, params api.VMPowerOnParams) (api.VMPowerOnRes, error) {
 	h.deps.Logger.Infof("VMPowerOn handler invoked")
 
+	fingerprint, replay, idemErr := h.replayIdempotent(ctx, params.IdempotencyKey, constants.VMPowerOn, params.VMID)
+	if idemErr != nil {
+		res := constants.MapServiceError(*idemErr, constants.VMPowerOn, ctx)
+		return res.(api.VMPowerOnRes), nil
+	}
+	if replay != nil {
+		return acceptedResponse(replay), nil
+	}
+
 	if err := h.validateVMExists(ctx, string(params.VMID), constants.VMPowerOn); err != nil {
 		res := constants.MapServiceError(*err, constants.VMPowerOn, ctx)
 		return res.(api.VMPowerOnRes), nil
 	}
 
-	metadata, err := json.Marshal(params)
+	metadata, err := json.Marshal(dto.VMTargetMetadata{VMID: string(params.VMID)})
 	if err != nil {
 		h.deps.Logger.Errorf("Failed to marshal VMPowerOn params: %v", err)
 		res := constants.MapServiceError(dto.ApiResponseError{
@@ -194,31 +231,35 @@ func (h *Handler) VMPowerOn(ctx context.Context, params api.VMPowerOnParams) (ap
 		return res.(api.VMPowerOnRes), nil
 	}
 
-	vmRequest, vmRequesterr := h.VMService.CreateVMRequest(ctx, constants.VMPowerOn, constants.StatusNew, string(metadata))
+	vmRequest, vmRequesterr := h.createVMRequest(ctx, params.IdempotencyKey, fingerprint, constants.VMPowerOn, string(metadata))
 	if vmRequesterr != nil {
 		h.deps.Logger.Errorf("Failed to create VM power on request: %v", vmRequesterr)
 		res := constants.MapServiceError(*vmRequesterr, constants.VMPowerOn, ctx)
 		return res.(api.VMPowerOnRes), nil
 	}
 
-	location := constants.VMRequestBasePath + vmRequest.RequestID
-	return &api.EmptyResponseHeaders{
-		Location: api.NewOptString(location),
-		Response: api.EmptyResponse{},
-	}, nil
-
+	return acceptedResponse(vmRequest), nil
 }
 
 // VMPowerReset implements the VMPowerReset operation
 func (h *Handler) VMPowerReset(ctx context.Context, params api.VMPowerResetParams) (api.VMPowerResetRes, error) {
 	h.deps.Logger.Infof("VMPowerReset handler invoked")
 
+	fingerprint, replay, idemErr := h.replayIdempotent(ctx, params.IdempotencyKey, constants.VMReset, params.VMID)
+	if idemErr != nil {
+		res := constants.MapServiceError(*idemErr, constants.VMReset, ctx)
+		return res.(api.VMPowerResetRes), nil
+	}
+	if replay != nil {
+		return acceptedResponse(replay), nil
+	}
+
 	if err := h.validateVMExists(ctx, string(params.VMID), constants.VMReset); err != nil {
 		res := constants.MapServiceError(*err, constants.VMReset, ctx)
 		return res.(api.VMPowerResetRes), nil
 	}
 
-	metadata, err := json.Marshal(params)
+	metadata, err := json.Marshal(dto.VMTargetMetadata{VMID: string(params.VMID)})
 	if err != nil {
 		h.deps.Logger.Errorf("Failed to marshal VMPowerReset params: %v", err)
 		res := constants.MapServiceError(dto.ApiResponseError{
@@ -228,31 +269,35 @@ func (h *Handler) VMPowerReset(ctx context.Context, params api.VMPowerResetParam
 		return res.(api.VMPowerResetRes), nil
 	}
 
-	vmRequest, vmRequesterr := h.VMService.CreateVMRequest(ctx, constants.VMReset, constants.StatusNew, string(metadata))
+	vmRequest, vmRequesterr := h.createVMRequest(ctx, params.IdempotencyKey, fingerprint, constants.VMReset, string(metadata))
 	if vmRequesterr != nil {
 		h.deps.Logger.Errorf("Failed to create VM power reset request: %v", vmRequesterr)
 		res := constants.MapServiceError(*vmRequesterr, constants.VMReset, ctx)
 		return res.(api.VMPowerResetRes), nil
 	}
 
-	location := constants.VMRequestBasePath + vmRequest.RequestID
-	return &api.EmptyResponseHeaders{
-		Location: api.NewOptString(location),
-		Response: api.EmptyResponse{},
-	}, nil
-
+	return acceptedResponse(vmRequest), nil
 }
 
 // VMRefresh implements the VMRefresh operation
 func (h *Handler) VMRefresh(ctx context.Context, params api.VMRefreshParams) (api.VMRefreshRes, error) {
 	h.deps.Logger.Infof("VMRefresh handler invoked")
 
+	fingerprint, replay, idemErr := h.replayIdempotent(ctx, params.IdempotencyKey, constants.VMRefresh, params.VMID)
+	if idemErr != nil {
+		res := constants.MapServiceError(*idemErr, constants.VMRefresh, ctx)
+		return res.(api.VMRefreshRes), nil
+	}
+	if replay != nil {
+		return acceptedResponse(replay), nil
+	}
+
 	if err := h.validateVMExists(ctx, string(params.VMID), constants.VMRefresh); err != nil {
 		res := constants.MapServiceError(*err, constants.VMRefresh, ctx)
 		return res.(api.VMRefreshRes), nil
 	}
 
-	metadata, err := json.Marshal(params)
+	metadata, err := json.Marshal(dto.VMTargetMetadata{VMID: string(params.VMID)})
 	if err != nil {
 		h.deps.Logger.Errorf("Failed to marshal VMRefresh params: %v", err)
 		res := constants.MapServiceError(dto.ApiResponseError{
@@ -262,31 +307,35 @@ func (h *Handler) VMRefresh(ctx context.Context, params api.VMRefreshParams) (ap
 		return res.(api.VMRefreshRes), nil
 	}
 
-	vmRequest, vmRequesterr := h.VMService.CreateVMRequest(ctx, constants.VMRefresh, constants.StatusNew, string(metadata))
+	vmRequest, vmRequesterr := h.createVMRequest(ctx, params.IdempotencyKey, fingerprint, constants.VMRefresh, string(metadata))
 	if vmRequesterr != nil {
 		h.deps.Logger.Errorf("Failed to create VM refresh request: %v", vmRequesterr)
 		res := constants.MapServiceError(*vmRequesterr, constants.VMRefresh, ctx)
 		return res.(api.VMRefreshRes), nil
 	}
 
-	location := constants.VMRequestBasePath + vmRequest.RequestID
-	return &api.EmptyResponseHeaders{
-		Location: api.NewOptString(location),
-		Response: api.EmptyResponse{},
-	}, nil
-
+	return acceptedResponse(vmRequest), nil
 }
 
 // VMRestartGuestOS implements the VMRestartGuestOS operation
 func (h *Handler) VMRestartGuestOS(ctx context.Context, params api.VMRestartGuestOSParams) (api.VMRestartGuestOSRes, error) {
 	h.deps.Logger.Infof("VMRestartGuestOS handler invoked")
 
+	fingerprint, replay, idemErr := h.replayIdempotent(ctx, params.IdempotencyKey, constants.VMRestartGuestOS, params.VMID)
+	if idemErr != nil {
+		res := constants.MapServiceError(*idemErr, constants.VMRestartGuestOS, ctx)
+		return res.(api.VMRestartGuestOSRes), nil
+	}
+	if replay != nil {
+		return acceptedResponse(replay), nil
+	}
+
 	if err := h.validateVMExists(ctx, string(params.VMID), constants.VMRestartGuestOS); err != nil {
 		res := constants.MapServiceError(*err, constants.VMRestartGuestOS, ctx)
 		return res.(api.VMRestartGuestOSRes), nil
 	}
 
-	metadata, err := json.Marshal(params)
+	metadata, err := json.Marshal(dto.VMTargetMetadata{VMID: string(params.VMID)})
 	if err != nil {
 		h.deps.Logger.Errorf("Failed to marshal VMRestartGuestOS params: %v", err)
 		res := constants.MapServiceError(dto.ApiResponseError{
@@ -296,31 +345,35 @@ func (h *Handler) VMRestartGuestOS(ctx context.Context, params api.VMRestartGues
 		return res.(api.VMRestartGuestOSRes), nil
 	}
 
-	vmRequest, vmRequesterr := h.VMService.CreateVMRequest(ctx, constants.VMRestartGuestOS, constants.StatusNew, string(metadata))
+	vmRequest, vmRequesterr := h.createVMRequest(ctx, params.IdempotencyKey, fingerprint, constants.VMRestartGuestOS, string(metadata))
 	if vmRequesterr != nil {
 		h.deps.Logger.Errorf("Failed to create VM restart guest OS request: %v", vmRequesterr)
 		res := constants.MapServiceError(*vmRequesterr, constants.VMRestartGuestOS, ctx)
 		return res.(api.VMRestartGuestOSRes), nil
 	}
 
-	location := constants.VMRequestBasePath + vmRequest.RequestID
-	return &api.EmptyResponseHeaders{
-		Location: api.NewOptString(location),
-		Response: api.EmptyResponse{},
-	}, nil
-
+	return acceptedResponse(vmRequest), nil
 }
 
 // VMShutdownGuestOS implements the VMShutdownGuestOS operation
 func (h *Handler) VMShutdownGuestOS(ctx context.Context, params api.VMShutdownGuestOSParams) (api.VMShutdownGuestOSRes, error) {
 	h.deps.Logger.Infof("VMShutdownGuestOS handler invoked")
 
+	fingerprint, replay, idemErr := h.replayIdempotent(ctx, params.IdempotencyKey, constants.VMShutdownGuestOS, params.VMID)
+	if idemErr != nil {
+		res := constants.MapServiceError(*idemErr, constants.VMShutdownGuestOS, ctx)
+		return res.(api.VMShutdownGuestOSRes), nil
+	}
+	if replay != nil {
+		return acceptedResponse(replay), nil
+	}
+
 	if err := h.validateVMExists(ctx, string(params.VMID), constants.VMShutdownGuestOS); err != nil {
 		res := constants.MapServiceError(*err, constants.VMShutdownGuestOS, ctx)
 		return res.(api.VMShutdownGuestOSRes), nil
 	}
 
-	metadata, err := json.Marshal(params)
+	metadata, err := json.Marshal(dto.VMTargetMetadata{VMID: string(params.VMID)})
 	if err != nil {
 		h.deps.Logger.Errorf("Failed to marshal VMShutdownGuestOS params: %v", err)
 		res := constants.MapServiceError(dto.ApiResponseError{
@@ -330,19 +383,14 @@ func (h *Handler) VMShutdownGuestOS(ctx context.Context, params api.VMShutdownGu
 		return res.(api.VMShutdownGuestOSRes), nil
 	}
 
-	vmRequest, vmRequesterr := h.VMService.CreateVMRequest(ctx, constants.VMShutdownGuestOS, constants.StatusNew, string(metadata))
+	vmRequest, vmRequesterr := h.createVMRequest(ctx, params.IdempotencyKey, fingerprint, constants.VMShutdownGuestOS, string(metadata))
 	if vmRequesterr != nil {
 		h.deps.Logger.Errorf("Failed to create VM shutdown guest OS request: %v", vmRequesterr)
 		res := constants.MapServiceError(*vmRequesterr, constants.VMShutdownGuestOS, ctx)
 		return res.(api.VMShutdownGuestOSRes), nil
 	}
 
-	location := constants.VMRequestBasePath + vmRequest.RequestID
-	return &api.EmptyResponseHeaders{
-		Location: api.NewOptString(location),
-		Response: api.EmptyResponse{},
-	}, nil
-
+	return acceptedResponse(vmRequest), nil
 }
 
 // GetVirtualMachineRequest implements the GetVirtualMachineRequest operation
@@ -370,10 +418,14 @@ func (h *Handler) GetVirtualMachineRequest(ctx context.Context, params api.GetVi
 		DatacenterId:    api.NewOptString(vmRequest.DatacenterId),
 		CreatedAt:       vmRequest.CreatedAt,
 		RequestMetadata: vmRequest.RequestMetadata,
+		CancelRequested: api.NewOptBool(vmRequest.CancelRequested),
 	}
 	if vmRequest.CompletedAt != nil {
 		apiVMRequest.CompletedAt = api.NewOptNilDateTime(*vmRequest.CompletedAt)
 	}
+	if vmRequest.ParentRequestID != nil {
+		apiVMRequest.ParentRequestId = api.NewOptString(*vmRequest.ParentRequestID)
+	}
 
 	apiDeployList := make([]api.VMDeployInstance, len(deployInstances))
 	for i, inst := range deployInstances {
@@ -389,18 +441,72 @@ func (h *Handler) GetVirtualMachineRequest(ctx context.Context, params api.GetVi
 		}
 	}
 
-	return &api.VMRequestWithDeploy{
+	retry, retryErr := h.VMService.GetVMRequestRetry(ctx, params.RequestID)
+	if retryErr != nil {
+		h.deps.Logger.Errorf("Failed to get retry of VM request: %v", retryErr)
+		res := constants.MapServiceError(*retryErr, constants.VMMachine, ctx)
+		return res.(api.GetVirtualMachineRequestRes), nil
+	}
+
+	response := &api.VMRequestWithDeploy{
 		VMRequest:    apiVMRequest,
 		VMDeployList: apiDeployList,
-	}, nil
+	}
+	if retry != nil {
+		response.RetryRequestID = api.NewOptString(retry.RequestID)
+	}
+	return response, nil
+}
+
+// CancelVirtualMachineRequest implements the CancelVirtualMachineRequest operation.
+func (h *Handler) CancelVirtualMachineRequest(ctx context.Context, params api.CancelVirtualMachineRequestParams) (api.CancelVirtualMachineRequestRes, error) {
+	h.deps.Logger.Infof("CancelVirtualMachineRequest handler invoked")
+
+	vmRequest, err := h.VMService.CancelVMRequest(ctx, params.RequestID)
+	if err != nil {
+		h.deps.Logger.Errorf("Failed to cancel VM request %s: %v", params.RequestID, err)
+		res := constants.MapServiceError(*err, constants.VMMachineCancel, ctx)
+		return res.(api.CancelVirtualMachineRequestRes), nil
+	}
+
+	return acceptedResponse(vmRequest), nil
+}
+
+// RetryVirtualMachineRequest implements the RetryVirtualMachineRequest operation.
+func (h *Handler) RetryVirtualMachineRequest(ctx context.Context, params api.RetryVirtualMachineRequestParams) (api.RetryVirtualMachineRequestRes, error) {
+	h.deps.Logger.Infof("RetryVirtualMachineRequest handler invoked")
+
+	vmRequest, err := h.VMService.RetryVMRequest(ctx, params.RequestID)
+	if err != nil {
+		h.deps.Logger.Errorf("Failed to retry VM request %s: %v", params.RequestID, err)
+		res := constants.MapServiceError(*err, constants.VMMachineRetry, ctx)
+		return res.(api.RetryVirtualMachineRequestRes), nil
+	}
+
+	return acceptedResponse(vmRequest), nil
 }
 
 // GetVirtualMachineRequestList implements the GetVirtualMachineRequestList operation.
-func (h *Handler) GetVirtualMachineRequestList(ctx context.Context) (api.GetVirtualMachineRequestListRes, error) {
+func (h *Handler) GetVirtualMachineRequestList(ctx context.Context, params api.GetVirtualMachineRequestListParams) (api.GetVirtualMachineRequestListRes, error) {
 	h.deps.Logger.Infof("GetVirtualMachineRequestList handler invoked")
 
-	// Call the service to get the list of VM requests and their instances
-	vmRequests, deployInstances, reqCount, instCount, err := h.VMService.GetAllVMRequestsWithInstances(ctx)
+	filter := dto.VMRequestFilter{
+		Operation:   string(params.Operation.Value),
+		Status:      string(params.Status.Value),
+		WorkspaceID: params.WorkspaceId.Value,
+		Ascending:   params.Sort.Value == api.GetVirtualMachineRequestListSortAsc,
+		Limit:       params.Limit.Or(service.DefaultPageLimit),
+		Offset:      params.Offset.Value,
+	}
+	if createdAfter, ok := params.CreatedAfter.Get(); ok {
+		filter.CreatedAfter = &createdAfter
+	}
+	if createdBefore, ok := params.CreatedBefore.Get(); ok {
+		filter.CreatedBefore = &createdBefore
+	}
+
+	// Call the service to get one page of VM requests and their instances
+	vmRequests, deployInstances, reqCount, instCount, err := h.VMService.GetAllVMRequestsWithInstances(ctx, filter)
 	if err != nil {
 		h.deps.Logger.Errorf("Failed to get VM request list: %v", err)
 		res := constants.MapServiceError(*err, constants.VMMachineList, ctx)
@@ -418,10 +524,14 @@ func (h *Handler) GetVirtualMachineRequestList(ctx context.Context) (api.GetVirt
 			DatacenterId:    api.NewOptString(vmRequest.DatacenterId),
 			CreatedAt:       vmRequest.CreatedAt,
 			RequestMetadata: vmRequest.RequestMetadata,
+			CancelRequested: api.NewOptBool(vmRequest.CancelRequested),
 		}
 		if vmRequest.CompletedAt != nil {
 			apiVMRequests[i].CompletedAt = api.NewOptNilDateTime(*vmRequest.CompletedAt)
 		}
+		if vmRequest.ParentRequestID != nil {
+			apiVMRequests[i].ParentRequestId = api.NewOptString(*vmRequest.ParentRequestID)
+		}
 	}
 
 	// Create the response structure for VM deploy instances
@@ -442,6 +552,8 @@ func (h *Handler) GetVirtualMachineRequestList(ctx context.Context) (api.GetVirt
 	return &api.VMRequestsList{
 		VMMinusRequestsListCount: api.NewOptInt(reqCount),
 		VMMinusDeployListCount:   api.NewOptInt(instCount),
+		Limit:                    api.NewOptInt(filter.Limit),
+		Offset:                   api.NewOptInt(filter.Offset),
 		Items: api.NewOptVMRequestsListItems(api.VMRequestsListItems{
 			VMRequetsList: apiVMRequests,
 			VMDeployList:  apiDeployList,
@@ -451,7 +563,7 @@ func (h *Handler) GetVirtualMachineRequestList(ctx context.Context) (api.GetVirt
 
 // validateImage checks if an image exists and returns its path.
 func (h *Handler) validateImage(ctx context.Context, imageID string) (string, *dto.ApiResponseError) {
-	if !h.deps.Config.App.Application.ValidateClientRequest {
+	if !h.deps.AppConfig().Application.ValidateClientRequest {
 		return "", nil
 	}
 
@@ -459,10 +571,7 @@ func (h *Handler) validateImage(ctx context.Context, imageID string) (string, *d
 	res, err := imageClient.GetAvailableImages(ctx)
 	if err != nil {
 		h.deps.Logger.Errorf("Failed to get image %s: %v", imageID, err)
-		return "", &dto.ApiResponseError{
-			ErrorCode: constants.InternalServerErrorCode,
-			Message:   err.Error(),
-		}
+		return "", clientError(err)
 	}
 
 	var matchedImage *imagemanager.HypervisorImage
@@ -487,7 +596,7 @@ func (h *Handler) validateImage(ctx context.Context, imageID string) (string, *d
 
 // validateHost checks if a host and cluster are active.
 func (h *Handler) validateHost(ctx context.Context, hostID, clusterID string) *dto.ApiResponseError {
-	if !h.deps.Config.App.Application.ValidateClientRequest {
+	if !h.deps.AppConfig().Application.ValidateClientRequest {
 		return nil
 	}
 
@@ -497,10 +606,7 @@ func (h *Handler) validateHost(ctx context.Context, hostID, clusterID string) *d
 	hostRes, err := infraClient.GetHypervisorHosts(ctx)
 	if err != nil {
 		h.deps.Logger.Errorf("Failed to get host %s: %v", hostID, err)
-		return &dto.ApiResponseError{
-			ErrorCode: constants.InternalServerErrorCode,
-			Message:   err.Error(),
-		}
+		return clientError(err)
 	}
 
 	var matchedHost *inframonitor.HypervisorHost
@@ -531,10 +637,7 @@ func (h *Handler) validateHost(ctx context.Context, hostID, clusterID string) *d
 	clusterRes, clustererr := infraClient.GetHypervisorClusters(ctx)
 	if clustererr != nil {
 		h.deps.Logger.Errorf("Failed to get cluster %s: %v", clusterID, clustererr)
-		return &dto.ApiResponseError{
-			ErrorCode: constants.InternalServerErrorCode,
-			Message:   clustererr.Error(),
-		}
+		return clientError(clustererr)
 	}
 	var matchedcluster *inframonitor.HypervisorCluster
 	for _, cluster := range clusterRes {
@@ -563,39 +666,75 @@ func (h *Handler) validateHost(ctx context.Context, hostID, clusterID string) *d
 	return nil
 }
 
-// validateVMExists checks if a VM exists using the vm_monitor client.
+// clientError is the response to a failed call to a downstream service: 503 while its circuit
+// breaker is open, 500 otherwise.
+func clientError(err error) *dto.ApiResponseError {
+	if errors.Is(err, client.ErrCircuitOpen) {
+		return &dto.ApiResponseError{
+			ErrorCode: constants.ServiceUnavailableErrorCode,
+			Message:   err.Error(),
+		}
+	}
+	return &dto.ApiResponseError{
+		ErrorCode: constants.InternalServerErrorCode,
+		Message:   err.Error(),
+	}
+}
+
+// validateVMExists checks that a VM exists and is in a state vmOperation may run in. The
+// VM is looked up in the local inventory first and in vm-monitor when the inventory has no
+// record of it.
 func (h *Handler) validateVMExists(ctx context.Context, vmID string, vmOperation constants.OperationType) *dto.ApiResponseError {
-	if !h.deps.Config.App.Application.ValidateClientRequest {
-		h.deps.Logger.Infof("validate client request", h.deps.Config.App.Application.ValidateClientRequest)
+	return h.validateVMPrecondition(ctx, vmID, vmOperation, false)
+}
+
+// validateVMPrecondition is validateVMExists for operations the caller may force.
+func (h *Handler) validateVMPrecondition(ctx context.Context, vmID string, vmOperation constants.OperationType, force bool) *dto.ApiResponseError {
+	if !h.deps.AppConfig().Application.ValidateClientRequest {
+		h.deps.Logger.Infof("Skipping VM validation, validate_client_request=%t", h.deps.AppConfig().Application.ValidateClientRequest)
 		return nil
 	}
 
-	vmClient := h.deps.ClientDependency.VmMonitorClient
-	timeoutCtx, cancel := context.WithTimeout(ctx, 60*time.Second)
-	defer cancel()
-
-	res, err := vmClient.GetVmMetrics(timeoutCtx, vmmonitor.GetVmMetricsParams{VMID: vmID})
-	// This prevents transient issues with the vm_monitor from blocking the request.
+	runtime, err := h.vmRuntime(ctx, vmID)
 	if err != nil {
-		h.deps.Logger.Errorf("Error validating VM %s: %v", vmID, err)
-		return &dto.ApiResponseError{
-			ErrorCode: constants.InternalServerErrorCode,
-			Message:   err.Error(),
-		}
+		return err
 	}
-	switch vmOperation {
-	case constants.VMReconfigure:
-		h.deps.Logger.Warnf("VM status: %s", res.Powerstate)
-		if strings.EqualFold(string(constants.OperationType(res.Powerstate)), string(constants.VMPowerOff)) {
-			h.deps.Logger.Warnf("VM %s is powered off and cannot be reconfigured", vmID)
-			return &dto.ApiResponseError{
-				ErrorCode: constants.InternalServerErrorCode,
-				Message:   "VM is powered off and cannot be reconfigured",
-			}
-		}
+	if err := checkPrecondition(vmOperation, runtime, force); err != nil {
+		h.deps.Logger.Warnf("VM %s failed the %s precondition: %s", vmID, vmOperation, err.Message)
+		return err
 	}
 
-	h.deps.Logger.Infof("Successfully validated VM %s, response: %+v", vmID, res)
+	h.deps.Logger.Infof("Successfully validated VM %s, power state: %s", vmID, runtime.PowerState)
 	return nil
+}
 
+// vmRuntime returns the live state of vmID from the inventory. A VM the inventory has no
+// synced record of, or an inventory that cannot be read, falls back to vm-monitor: until
+// the reconciler syncs a VM, its record has no guest network address and says nothing
+// about the guest tools.
+func (h *Handler) vmRuntime(ctx context.Context, vmID string) (vmRuntime, *dto.ApiResponseError) {
+	vm, apiErr := h.VMService.GetInventoryVM(ctx, vmID)
+	if apiErr != nil {
+		h.deps.Logger.Warnf("Failed to read VM %s from the inventory, asking vm-monitor: %s", vmID, apiErr.Message)
+	} else if vm != nil {
+		if constants.VMState(vm.State) == constants.VMStateDeleted {
+			h.deps.Logger.Warnf("VM %s was deleted", vmID)
+			return vmRuntime{}, &dto.ApiResponseError{
+				ErrorCode: constants.SQLRecordNotFoundErrorCode,
+				Message:   fmt.Sprintf("VM %s was deleted", vmID),
+			}
+		}
+		if vm.SyncedAt != nil && vm.SyncError == "" {
+			return newVMRuntime(vm.PowerState, vm.NetworkAddress), nil
+		}
+		h.deps.Logger.Infof("VM %s has not been synced to the inventory, asking vm-monitor", vmID)
+	}
+
+	vmClient := h.deps.ClientDependency.VmMonitorClient
+	res, err := vmClient.GetVmMetrics(ctx, vmmonitor.GetVmMetricsParams{VMID: vmID})
+	if err != nil {
+		h.deps.Logger.Errorf("Error validating VM %s: %v", vmID, err)
+		return vmRuntime{}, clientError(err)
+	}
+	return newVMRuntime(res.Powerstate, res.NetworkAddress), nil
 }
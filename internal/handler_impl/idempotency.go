@@ -0,0 +1,54 @@
+package handler_impl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	dto "vm/internal/dtos"
+	api "vm/internal/gen"
+	"vm/internal/modals"
+	"vm/pkg/constants"
+)
+
+// idempotencyFingerprint hashes the operation and the request it was called with, before
+// any server side enrichment, so a replay can be told apart from a reused key.
+func idempotencyFingerprint(operation constants.OperationType, request interface{}) (string, *dto.ApiResponseError) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return "", &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "Failed to fingerprint request"}
+	}
+	sum := sha256.Sum256(append([]byte(string(operation)+"\n"), body...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// replayIdempotent fingerprints request and returns the request created by an earlier call
+// with the same Idempotency-Key, or nil when no key was sent or the key is new.
+func (h *Handler) replayIdempotent(ctx context.Context, key api.OptString, operation constants.OperationType, request interface{}) (string, *modals.VMRequest, *dto.ApiResponseError) {
+	k, ok := key.Get()
+	if !ok {
+		return "", nil, nil
+	}
+	fingerprint, err := idempotencyFingerprint(operation, request)
+	if err != nil {
+		return "", nil, err
+	}
+	vmRequest, err := h.VMService.FindIdempotentVMRequest(ctx, k, operation, fingerprint)
+	return fingerprint, vmRequest, err
+}
+
+// createVMRequest creates a New VM request, recording it against the Idempotency-Key when one was sent.
+func (h *Handler) createVMRequest(ctx context.Context, key api.OptString, fingerprint string, operation constants.OperationType, metadata string) (*modals.VMRequest, *dto.ApiResponseError) {
+	if k, ok := key.Get(); ok {
+		return h.VMService.CreateIdempotentVMRequest(ctx, k, fingerprint, operation, constants.StatusNew, metadata)
+	}
+	return h.VMService.CreateVMRequest(ctx, operation, constants.StatusNew, metadata)
+}
+
+// acceptedResponse is the 202 returned for an accepted VM request.
+func acceptedResponse(vmRequest *modals.VMRequest) *api.EmptyResponseHeaders {
+	return &api.EmptyResponseHeaders{
+		Location: api.NewOptString(constants.VMRequestBasePath + vmRequest.RequestID),
+		Response: api.EmptyResponse{},
+	}
+}
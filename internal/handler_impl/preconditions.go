@@ -0,0 +1,97 @@
+package handler_impl
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	dto "vm/internal/dtos"
+	api "vm/internal/gen"
+	"vm/pkg/constants"
+)
+
+// vmRuntime is the live state of a VM that operation preconditions are checked against.
+type vmRuntime struct {
+	PowerState api.VirtualMachinePowerState
+	// GuestToolsRunning is inferred from a reported guest network address, which only the
+	// guest tools can supply. vm-monitor does not report the tools status itself.
+	GuestToolsRunning bool
+}
+
+// newVMRuntime builds a vmRuntime from the power state and network address vm-monitor reports.
+func newVMRuntime(powerState, networkAddress string) vmRuntime {
+	return vmRuntime{
+		PowerState:        normalizePowerState(powerState),
+		GuestToolsRunning: networkAddress != "" && !strings.EqualFold(networkAddress, "N/A"),
+	}
+}
+
+// precondition is the state an operation requires a VM to be in.
+type precondition struct {
+	powerStates []api.VirtualMachinePowerState
+	guestTools  bool
+	// forcible operations skip the check when the caller forces them.
+	forcible bool
+}
+
+// operationPreconditions is the precondition matrix of the operations on an existing VM.
+// Operations missing from it, such as refresh, may run in any state.
+var operationPreconditions = map[constants.OperationType]precondition{
+	constants.VMPowerOn:         {powerStates: []api.VirtualMachinePowerState{api.VirtualMachinePowerStatePOWEREDOFF, api.VirtualMachinePowerStateSUSPENDED}},
+	constants.VMPowerOff:        {powerStates: []api.VirtualMachinePowerState{api.VirtualMachinePowerStatePOWEREDON, api.VirtualMachinePowerStateSUSPENDED}},
+	constants.VMReset:           {powerStates: []api.VirtualMachinePowerState{api.VirtualMachinePowerStatePOWEREDON}},
+	constants.VMRestartGuestOS:  {powerStates: []api.VirtualMachinePowerState{api.VirtualMachinePowerStatePOWEREDON}, guestTools: true},
+	constants.VMShutdownGuestOS: {powerStates: []api.VirtualMachinePowerState{api.VirtualMachinePowerStatePOWEREDON}, guestTools: true},
+	constants.VMReconfigure:     {powerStates: []api.VirtualMachinePowerState{api.VirtualMachinePowerStatePOWEREDOFF}},
+	constants.VMDelete:          {powerStates: []api.VirtualMachinePowerState{api.VirtualMachinePowerStatePOWEREDOFF}, forcible: true},
+}
+
+// checkPrecondition returns a conflict when vm is not in a state operation may run in.
+func checkPrecondition(operation constants.OperationType, vm vmRuntime, force bool) *dto.ApiResponseError {
+	pre, ok := operationPreconditions[operation]
+	if !ok || (force && pre.forcible) {
+		return nil
+	}
+
+	var reason string
+	if !slices.Contains(pre.powerStates, vm.PowerState) {
+		states := make([]string, len(pre.powerStates))
+		for i, state := range pre.powerStates {
+			states[i] = string(state)
+		}
+		reason = fmt.Sprintf("%s requires the VM to be %s, but it is %s", operation, strings.Join(states, " or "), vm.PowerState)
+	} else if pre.guestTools && !vm.GuestToolsRunning {
+		reason = fmt.Sprintf("%s requires the guest tools to be running", operation)
+	} else {
+		return nil
+	}
+	if pre.forcible {
+		reason += "; set force=true to override"
+	}
+	return &dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: reason}
+}
+
+// allowedOperationItems pairs the operations reported in VirtualMachine.allowedOperations
+// with their API names, in the order they are listed.
+var allowedOperationItems = []struct {
+	operation constants.OperationType
+	item      api.VirtualMachineAllowedOperationsItem
+}{
+	{constants.VMPowerOn, api.VirtualMachineAllowedOperationsItemVIRTUALMACHINEPOWERON},
+	{constants.VMPowerOff, api.VirtualMachineAllowedOperationsItemVIRTUALMACHINEPOWEROFF},
+	{constants.VMReset, api.VirtualMachineAllowedOperationsItemVIRTUALMACHINERESET},
+	{constants.VMShutdownGuestOS, api.VirtualMachineAllowedOperationsItemVIRTUALMACHINESHUTDOWNGUESTOS},
+	{constants.VMRestartGuestOS, api.VirtualMachineAllowedOperationsItemVIRTUALMACHINERESTARTGUESTOS},
+	{constants.VMDelete, api.VirtualMachineAllowedOperationsItemVIRTUALMACHINEDELETE},
+}
+
+// allowedOperations lists the operations whose preconditions vm meets without being forced.
+func allowedOperations(vm vmRuntime) []api.VirtualMachineAllowedOperationsItem {
+	var allowed []api.VirtualMachineAllowedOperationsItem
+	for _, op := range allowedOperationItems {
+		if checkPrecondition(op.operation, vm, false) == nil {
+			allowed = append(allowed, op.item)
+		}
+	}
+	return allowed
+}
@@ -0,0 +1,174 @@
+package handler_impl_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	api "vm/internal/gen"
+	"vm/internal/handler_impl"
+	"vm/internal/modals"
+	mock_service "vm/internal/service/mock"
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/constants"
+	"vm/pkg/dependency"
+	mock_logger "vm/pkg/logger/mock"
+)
+
+// preconditionCall runs one VM operation handler against vmID.
+type preconditionCall struct {
+	call     func(h *handler_impl.Handler, vmID string, force bool) (any, error)
+	conflict any
+}
+
+var preconditionCalls = map[constants.OperationType]preconditionCall{
+	constants.VMPowerOn: {
+		call: func(h *handler_impl.Handler, vmID string, _ bool) (any, error) {
+			return h.VMPowerOn(context.Background(), api.VMPowerOnParams{VMID: api.ID(vmID)})
+		},
+		conflict: &api.VMPowerOnConflict{},
+	},
+	constants.VMPowerOff: {
+		call: func(h *handler_impl.Handler, vmID string, _ bool) (any, error) {
+			return h.VMPowerOff(context.Background(), api.VMPowerOffParams{VMID: api.ID(vmID)})
+		},
+		conflict: &api.VMPowerOffConflict{},
+	},
+	constants.VMReset: {
+		call: func(h *handler_impl.Handler, vmID string, _ bool) (any, error) {
+			return h.VMPowerReset(context.Background(), api.VMPowerResetParams{VMID: api.ID(vmID)})
+		},
+		conflict: &api.VMPowerResetConflict{},
+	},
+	constants.VMRefresh: {
+		call: func(h *handler_impl.Handler, vmID string, _ bool) (any, error) {
+			return h.VMRefresh(context.Background(), api.VMRefreshParams{VMID: api.ID(vmID)})
+		},
+		conflict: &api.VMRefreshConflict{},
+	},
+	constants.VMRestartGuestOS: {
+		call: func(h *handler_impl.Handler, vmID string, _ bool) (any, error) {
+			return h.VMRestartGuestOS(context.Background(), api.VMRestartGuestOSParams{VMID: api.ID(vmID)})
+		},
+		conflict: &api.VMRestartGuestOSConflict{},
+	},
+	constants.VMShutdownGuestOS: {
+		call: func(h *handler_impl.Handler, vmID string, _ bool) (any, error) {
+			return h.VMShutdownGuestOS(context.Background(), api.VMShutdownGuestOSParams{VMID: api.ID(vmID)})
+		},
+		conflict: &api.VMShutdownGuestOSConflict{},
+	},
+	constants.VMReconfigure: {
+		call: func(h *handler_impl.Handler, vmID string, _ bool) (any, error) {
+			req := &api.EditVM{CpuMemConfig: api.NewOptEditVMCpuMemConfig(api.EditVMCpuMemConfig{
+				CPU: api.NewOptEditVMCpuMemConfigCPU(api.EditVMCpuMemConfigCPU{NumOfCpus: api.NewOptInt(4)}),
+			})}
+			return h.EditVM(context.Background(), req, api.EditVMParams{VMID: api.ID(vmID)})
+		},
+		conflict: &api.EditVMConflict{},
+	},
+	constants.VMDelete: {
+		call: func(h *handler_impl.Handler, vmID string, force bool) (any, error) {
+			return h.VMDelete(context.Background(), api.VMDeleteParams{VMID: api.ID(vmID), Force: api.NewOptBool(force)})
+		},
+		conflict: &api.VMDeleteConflict{},
+	},
+}
+
+func TestHandler_OperationPreconditions(t *testing.T) {
+	const (
+		on        = "POWERED_ON"
+		off       = "POWERED_OFF"
+		suspended = "SUSPENDED"
+		unknown   = "unknown"
+		ip        = "10.0.0.5"
+	)
+
+	tests := []struct {
+		name           string
+		operation      constants.OperationType
+		powerState     string
+		networkAddress string
+		force          bool
+		wantMessage    string
+	}{
+		{name: "power on an off VM", operation: constants.VMPowerOn, powerState: off},
+		{name: "power on a suspended VM", operation: constants.VMPowerOn, powerState: suspended},
+		{name: "power on a running VM", operation: constants.VMPowerOn, powerState: on,
+			wantMessage: "vmPowerOn requires the VM to be POWERED_OFF or SUSPENDED, but it is POWERED_ON"},
+		{name: "power off a running VM", operation: constants.VMPowerOff, powerState: "poweredOn"},
+		{name: "power off an off VM", operation: constants.VMPowerOff, powerState: off,
+			wantMessage: "vmPowerOff requires the VM to be POWERED_ON or SUSPENDED, but it is POWERED_OFF"},
+		{name: "reset a running VM", operation: constants.VMReset, powerState: on},
+		{name: "reset a suspended VM", operation: constants.VMReset, powerState: suspended,
+			wantMessage: "vmReset requires the VM to be POWERED_ON, but it is SUSPENDED"},
+		{name: "refresh in any state", operation: constants.VMRefresh, powerState: unknown},
+		{name: "shut down a running VM with tools", operation: constants.VMShutdownGuestOS, powerState: on, networkAddress: ip},
+		{name: "shut down a running VM without tools", operation: constants.VMShutdownGuestOS, powerState: on, networkAddress: "N/A",
+			wantMessage: "vmShutdown requires the guest tools to be running"},
+		{name: "shut down an off VM", operation: constants.VMShutdownGuestOS, powerState: off, networkAddress: ip,
+			wantMessage: "vmShutdown requires the VM to be POWERED_ON, but it is POWERED_OFF"},
+		{name: "restart a running VM with tools", operation: constants.VMRestartGuestOS, powerState: on, networkAddress: ip},
+		{name: "restart a running VM without tools", operation: constants.VMRestartGuestOS, powerState: on,
+			wantMessage: "vmRestart requires the guest tools to be running"},
+		{name: "reconfigure an off VM", operation: constants.VMReconfigure, powerState: off},
+		{name: "reconfigure a running VM", operation: constants.VMReconfigure, powerState: on,
+			wantMessage: "vmReconfigure requires the VM to be POWERED_OFF, but it is POWERED_ON"},
+		{name: "delete an off VM", operation: constants.VMDelete, powerState: off},
+		{name: "delete a running VM", operation: constants.VMDelete, powerState: on,
+			wantMessage: "vmDelete requires the VM to be POWERED_OFF, but it is POWERED_ON; set force=true to override"},
+		{name: "force delete a running VM", operation: constants.VMDelete, powerState: on, force: true},
+		{name: "power on a VM in an unknown state", operation: constants.VMPowerOn, powerState: unknown,
+			wantMessage: "vmPowerOn requires the VM to be POWERED_OFF or SUSPENDED, but it is UNKNOWN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockVMService := mock_service.NewMockVMService(ctrl)
+			deps := &dependency.Dependency{
+				Ctx:    context.Background(),
+				Logger: &mock_logger.StubLogger{},
+				Config: &configmanager.Config{
+					App: configmanager.ApplicationConfig{
+						Application: configmanager.Application{ValidateClientRequest: true},
+					},
+				},
+				ClientDependency: &dependency.ClientDependency{},
+			}
+			handler := handler_impl.NewHandler(mockVMService, deps)
+
+			syncedAt := time.Now().UTC()
+			mockVMService.EXPECT().GetInventoryVM(gomock.Any(), "vm-001").Return(&modals.VirtualMachine{
+				VMID:           "vm-001",
+				State:          string(constants.VMStateActive),
+				PowerState:     tt.powerState,
+				NetworkAddress: tt.networkAddress,
+				SyncedAt:       &syncedAt,
+			}, nil)
+			if tt.wantMessage == "" {
+				mockVMService.EXPECT().CreateVMRequest(gomock.Any(), tt.operation, constants.StatusNew, gomock.Any()).
+					Return(&modals.VMRequest{RequestID: "req-001"}, nil)
+			}
+
+			call := preconditionCalls[tt.operation]
+			res, err := call.call(handler, "vm-001", tt.force)
+			assert.NoError(t, err)
+			if tt.wantMessage == "" {
+				assert.IsType(t, &api.EmptyResponseHeaders{}, res)
+				return
+			}
+			if assert.IsType(t, call.conflict, res) {
+				conflict := reflect.ValueOf(res).Elem()
+				assert.Equal(t, constants.LoadStatusConflictErrorCode, conflict.FieldByName("ErrorCode").String())
+				assert.Equal(t, tt.wantMessage, conflict.FieldByName("Message").String())
+			}
+		})
+	}
+}
@@ -4,50 +4,54 @@ import (
 	"context"
 	"errors"
 	api "vm/internal/gen"
+	"vm/pkg/auth"
 	"vm/pkg/cinterface"
 	"vm/pkg/constants"
 	"vm/pkg/utils"
-
-	"github.com/golang-jwt/jwt/v4"
 )
 
 type SecurityHandler struct {
-	logger cinterface.Logger
+	logger     cinterface.Logger
+	verifier   auth.Verifier
+	authorizer auth.Authorizer
 }
 
-func NewSecurityHandler(logger cinterface.Logger) *SecurityHandler {
+func NewSecurityHandler(logger cinterface.Logger, verifier auth.Verifier, authorizer auth.Authorizer) *SecurityHandler {
 	return &SecurityHandler{
-		logger: logger,
+		logger:     logger,
+		verifier:   verifier,
+		authorizer: authorizer,
 	}
 }
 
 func (h *SecurityHandler) HandleBearer(ctx context.Context, operationName api.OperationName, t api.Bearer) (context.Context, error) {
 	if t.Token == "" {
-		h.logger.Error(constants.General, constants.Api, "Missing Bearer token", nil)
+		h.logger.Error(constants.General, constants.Auth, "Missing Bearer token", nil)
 		return nil, errors.New("Missing Bearer token")
 	}
-	ctx = context.WithValue(ctx, constants.BearerTokenKey, t.Token)
 
-	// Parse token without verifying signature (for testing only)
-	token, _, err := new(jwt.Parser).ParseUnverified(t.Token, jwt.MapClaims{})
+	claims, err := h.verifier.Verify(ctx, t.Token)
 	if err != nil {
-		h.logger.Error(constants.General, constants.Api, "Failed to parse token", map[constants.ExtraKey]interface{}{
-			"error": err,
+		h.logger.Error(constants.General, constants.Auth, "Bearer token rejected", map[constants.ExtraKey]interface{}{
+			"operation": operationName,
+			"error":     err.Error(),
 		})
-		return ctx, nil
+		return nil, err
 	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		h.logger.Error(constants.General, constants.Api, "Invalid token claims", nil)
-		return ctx, nil
+	if err := h.authorizer.Authorize(operationName, claims); err != nil {
+		h.logger.Error(constants.General, constants.Auth, "Operation not permitted for token", map[constants.ExtraKey]interface{}{
+			"operation": operationName,
+			"error":     err.Error(),
+		})
+		return nil, err
 	}
+	ctx = context.WithValue(ctx, constants.BearerTokenKey, t.Token)
 
 	// Extract "id" from claims
 	if id, ok := claims["id"].(string); ok && id != "" {
 		ctx = context.WithValue(ctx, utils.WorkspaceIDKey, id)
 	} else {
-		h.logger.Info(constants.General, constants.Api, "Token does not contain 'id' claim", nil)
+		h.logger.Info(constants.General, constants.Auth, "Token does not contain 'id' claim", nil)
 	}
 
 	return ctx, nil
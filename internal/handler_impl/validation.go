@@ -0,0 +1,129 @@
+package handler_impl
+
+import (
+	"fmt"
+	"strings"
+
+	dto "vm/internal/dtos"
+	api "vm/internal/gen"
+	"vm/pkg/constants"
+)
+
+// validateEditVM checks the EditVM body against the rules in the API spec and
+// returns every violation in a single InvalidRequestErrorCode error.
+func validateEditVM(req *api.EditVM) *dto.ApiResponseError {
+	var violations []string
+	if !req.CpuMemConfig.Set && len(req.NetworkAdapters) == 0 && len(req.VirtualDisks) == 0 {
+		violations = append(violations, "at least one of cpuMemConfig, networkAdapters or virtualDisks is required")
+	}
+	if req.CpuMemConfig.Set {
+		violations = append(violations, validateCpuMemConfig(req.CpuMemConfig.Value)...)
+	}
+	for i, adapter := range req.NetworkAdapters {
+		violations = append(violations, validateNetworkAdapter(i, adapter)...)
+	}
+	for i, disk := range req.VirtualDisks {
+		violations = append(violations, validateVirtualDisk(i, disk)...)
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return &dto.ApiResponseError{
+		ErrorCode: constants.InvalidRequestErrorCode,
+		Message:   strings.Join(violations, "; "),
+	}
+}
+
+func validateCpuMemConfig(cfg api.EditVMCpuMemConfig) []string {
+	var violations []string
+	if cpu, ok := cfg.CPU.Get(); ok {
+		cores, coresSet := cpu.NumOfCoresPerSocket.Get()
+		cpus, cpusSet := cpu.NumOfCpus.Get()
+		if coresSet && cores <= 0 {
+			violations = append(violations, "cpuMemConfig.cpu.numOfCoresPerSocket must be greater than 0")
+		}
+		if cpusSet && cpus <= 0 {
+			violations = append(violations, "cpuMemConfig.cpu.numOfCpus must be greater than 0")
+		}
+		if coresSet && cpusSet && cores > 0 && cpus%cores != 0 {
+			violations = append(violations, fmt.Sprintf("cpuMemConfig.cpu.numOfCpus (%d) must be a multiple of numOfCoresPerSocket (%d)", cpus, cores))
+		}
+	}
+	if memory, ok := cfg.Memory.Get(); ok {
+		if mb, set := memory.MemoryInMb.Get(); set && mb <= 0 {
+			violations = append(violations, "cpuMemConfig.memory.memoryInMb must be greater than 0")
+		}
+	}
+	return violations
+}
+
+func validateNetworkAdapter(i int, adapter api.EditVMNetworkAdaptersItem) []string {
+	field := fmt.Sprintf("networkAdapters[%d]", i)
+	op, ok := adapter.Operation.Get()
+	if !ok {
+		return []string{field + ".operation is required"}
+	}
+
+	var violations []string
+	hasName := adapter.Name.Set && adapter.Name.Value != ""
+	details, hasDetails := adapter.NetworkDetails.Get()
+	hasNetworkName := hasDetails && details.Name.Set && details.Name.Value != ""
+	switch op {
+	case api.EditVMNetworkAdaptersItemOperationADD:
+		if !adapter.Type.Set {
+			violations = append(violations, field+".type is required for ADD")
+		}
+		if !hasNetworkName {
+			violations = append(violations, field+".networkDetails.name is required for ADD")
+		}
+	case api.EditVMNetworkAdaptersItemOperationEDIT:
+		if !hasName {
+			violations = append(violations, field+".name is required for EDIT")
+		}
+		if !hasNetworkName {
+			violations = append(violations, field+".networkDetails.name is required for EDIT")
+		}
+	case api.EditVMNetworkAdaptersItemOperationDELETE:
+		if !hasName {
+			violations = append(violations, field+".name is required for DELETE")
+		}
+	}
+	return violations
+}
+
+func validateVirtualDisk(i int, disk api.EditVMVirtualDisksItem) []string {
+	field := fmt.Sprintf("virtualDisks[%d]", i)
+	op, ok := disk.Operation.Get()
+	if !ok {
+		return []string{field + ".operation is required"}
+	}
+
+	var violations []string
+	cfg := disk.DiskConfig.Value
+	hasID := disk.DiskConfig.Set && cfg.ID.Set && cfg.ID.Value != ""
+	checkCapacity := func() {
+		if capacity, set := cfg.CapacityInMb.Get(); !disk.DiskConfig.Set || !set {
+			violations = append(violations, fmt.Sprintf("%s.diskConfig.capacityInMb is required for %s", field, op))
+		} else if capacity < 1 {
+			violations = append(violations, field+".diskConfig.capacityInMb must be at least 1")
+		}
+	}
+	switch op {
+	case api.EditVMVirtualDisksItemOperationADD:
+		checkCapacity()
+	case api.EditVMVirtualDisksItemOperationEDIT:
+		if !hasID {
+			violations = append(violations, field+".diskConfig.id is required for EDIT")
+		}
+		checkCapacity()
+	case api.EditVMVirtualDisksItemOperationDELETE:
+		if !hasID {
+			violations = append(violations, field+".diskConfig.id is required for DELETE")
+		}
+		if !disk.DiskConfig.Set || !cfg.RetainFiles.Set {
+			violations = append(violations, field+".diskConfig.retainFiles is required for DELETE")
+		}
+	}
+	return violations
+}
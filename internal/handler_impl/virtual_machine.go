@@ -0,0 +1,237 @@
+package handler_impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	vmmonitor "vm/internal/client/vm_monitor"
+	dto "vm/internal/dtos"
+	api "vm/internal/gen"
+	"vm/internal/service"
+	"vm/pkg/constants"
+)
+
+const (
+	virtualMachineType = "virtualization/virtual-machine"
+	vmMetricsTimeout   = 10 * time.Second
+	vmMetricsWorkers   = 8
+)
+
+// GetVirtualMachineList implements the GetVirtualMachineList operation.
+func (h *Handler) GetVirtualMachineList(ctx context.Context, params api.GetVirtualMachineListParams) (api.GetVirtualMachineListRes, error) {
+	h.deps.Logger.Infof("GetVirtualMachineList handler invoked")
+
+	limit := params.Limit.Or(service.DefaultPageLimit)
+	records, total, err := h.VMService.ListVMs(ctx, limit, params.Offset.Value)
+	if err != nil {
+		h.deps.Logger.Errorf("Failed to list VMs: %v", err)
+		res := constants.MapServiceError(*err, constants.VMList, ctx)
+		return res.(api.GetVirtualMachineListRes), nil
+	}
+
+	metrics, metricsErrs := h.vmMetrics(ctx, records)
+	items := make([]api.VirtualMachine, len(records))
+	for i, record := range records {
+		items[i] = buildVirtualMachine(record, metrics[i], metricsErrs[i])
+	}
+
+	return &api.VirtualMachineList{
+		Items:  items,
+		Count:  len(items),
+		Total:  total,
+		Limit:  limit,
+		Offset: params.Offset.Value,
+	}, nil
+}
+
+// GetVirtualMachine implements the GetVirtualMachine operation.
+func (h *Handler) GetVirtualMachine(ctx context.Context, params api.GetVirtualMachineParams) (api.GetVirtualMachineRes, error) {
+	h.deps.Logger.Infof("GetVirtualMachine handler invoked")
+
+	record, err := h.VMService.GetVM(ctx, string(params.VMID))
+	if err != nil {
+		h.deps.Logger.Errorf("Failed to get VM %s: %v", params.VMID, err)
+		res := constants.MapServiceError(*err, constants.VMGet, ctx)
+		return res.(api.GetVirtualMachineRes), nil
+	}
+
+	metrics, metricsErrs := h.vmMetrics(ctx, []*dto.VMRecord{record})
+	vm := buildVirtualMachine(record, metrics[0], metricsErrs[0])
+	return &vm, nil
+}
+
+// vmMetrics fetches the live vm-monitor data of every VM that has not been deleted. A VM
+// vm-monitor cannot describe gets an error instead, so one bad VM does not fail a whole page.
+func (h *Handler) vmMetrics(ctx context.Context, records []*dto.VMRecord) ([]*vmmonitor.VmMetrics, []error) {
+	metrics := make([]*vmmonitor.VmMetrics, len(records))
+	errs := make([]error, len(records))
+
+	vmClient := h.deps.ClientDependency.VmMonitorClient
+	if vmClient == nil {
+		for i := range errs {
+			errs[i] = errors.New("vm-monitor client is not configured")
+		}
+		return metrics, errs
+	}
+
+	sem := make(chan struct{}, vmMetricsWorkers)
+	var wg sync.WaitGroup
+	for i, record := range records {
+		if vmDeleted(record) {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, vmID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			timeoutCtx, cancel := context.WithTimeout(ctx, vmMetricsTimeout)
+			defer cancel()
+			metrics[i], errs[i] = vmClient.GetVmMetrics(timeoutCtx, vmmonitor.GetVmMetricsParams{VMID: vmID})
+			if errs[i] != nil {
+				h.deps.Logger.Warnf("Failed to get metrics of VM %s: %v", vmID, errs[i])
+			}
+		}(i, record.Instance.VMID)
+	}
+	wg.Wait()
+	return metrics, errs
+}
+
+// buildVirtualMachine combines the local record of a VM with its vm-monitor metrics.
+func buildVirtualMachine(record *dto.VMRecord, metrics *vmmonitor.VmMetrics, metricsErr error) api.VirtualMachine {
+	inst := record.Instance
+	vm := api.VirtualMachine{
+		ID:          inst.VMID,
+		Name:        api.NewOptString(inst.VMName),
+		DisplayName: api.NewOptString(inst.VMName),
+		Type:        virtualMachineType,
+		ResourceUri: api.NewOptString(constants.VMBasePath + inst.VMID),
+		// Every request on the VM may have changed it.
+		Generation: api.Generation(len(record.Requests) + 1),
+		PowerState: api.NewOptVirtualMachinePowerState(api.VirtualMachinePowerStateUNKNOWN),
+	}
+	if inst.CompletedAt != nil {
+		vm.CreatedAt = *inst.CompletedAt
+	}
+	vm.UpdatedAt = vm.CreatedAt
+	if len(record.Requests) > 0 {
+		latest := record.Requests[0]
+		if latest.CompletedAt != nil && latest.CompletedAt.After(vm.UpdatedAt) {
+			vm.UpdatedAt = *latest.CompletedAt
+		} else if latest.CreatedAt.After(vm.UpdatedAt) {
+			vm.UpdatedAt = latest.CreatedAt
+		}
+	}
+
+	if metrics != nil {
+		if metrics.VMName != "" {
+			vm.Name = api.NewOptString(metrics.VMName)
+		}
+		vm.PowerState = api.NewOptVirtualMachinePowerState(normalizePowerState(metrics.Powerstate))
+		vm.ComputeInfo = api.NewOptVirtualMachineComputeInfo(api.VirtualMachineComputeInfo{
+			NumCpuCores:     api.NewOptInt(metrics.CPUCores),
+			MemorySizeInMib: api.NewOptString(fmt.Sprintf("%g", metrics.MemSize)),
+		})
+		if metrics.Guest != "" {
+			vm.GuestInfo = api.NewOptVirtualMachineGuestInfo(api.VirtualMachineGuestInfo{
+				Name: api.NewOptString(metrics.Guest),
+				Type: api.NewOptVirtualMachineGuestInfoType(guestType(metrics.Guest)),
+			})
+		}
+		if metrics.NetworkAddress != "" {
+			vm.NetworkAddress = api.NewOptString(metrics.NetworkAddress)
+		}
+		if metrics.UpdatedAt.After(vm.UpdatedAt) {
+			vm.UpdatedAt = metrics.UpdatedAt
+		}
+	}
+
+	state, status, reason := vmState(record, metricsErr)
+	vm.State = api.NewOptVirtualMachineState(state)
+	vm.Status = api.NewOptVirtualMachineStatus(status)
+	if reason != "" {
+		vm.StateReason = api.NewOptString(reason)
+	}
+	if state == api.VirtualMachineStateOK && metrics != nil {
+		vm.AllowedOperations = allowedOperations(newVMRuntime(metrics.Powerstate, metrics.NetworkAddress))
+	}
+	if vm.AllowedOperations == nil {
+		vm.AllowedOperations = []api.VirtualMachineAllowedOperationsItem{}
+	}
+	return vm
+}
+
+// vmDeleted reports whether the latest request on the VM deleted it.
+func vmDeleted(record *dto.VMRecord) bool {
+	if len(record.Requests) == 0 {
+		return false
+	}
+	latest := record.Requests[0]
+	return constants.OperationType(latest.Operation) == constants.VMDelete &&
+		constants.RequestStatus(latest.RequestStatus) == constants.StatusSuccess
+}
+
+// vmState derives the state, status and state reason of a VM from its latest request and
+// whether vm-monitor could describe it.
+func vmState(record *dto.VMRecord, metricsErr error) (api.VirtualMachineState, api.VirtualMachineStatus, string) {
+	if vmDeleted(record) {
+		return api.VirtualMachineStateDELETED, api.VirtualMachineStatusOK, "Deleted by request " + record.Requests[0].RequestID
+	}
+	if len(record.Requests) > 0 {
+		latest := record.Requests[0]
+		switch constants.RequestStatus(latest.RequestStatus) {
+		case constants.StatusNew, constants.StatusInProgress:
+			reason := fmt.Sprintf("Request %s (%s) is %s", latest.RequestID, latest.Operation, latest.RequestStatus)
+			switch constants.OperationType(latest.Operation) {
+			case constants.VMDelete:
+				return api.VirtualMachineStateDELETING, api.VirtualMachineStatusOK, reason
+			case constants.VMRefresh:
+				return api.VirtualMachineStateREFRESHING, api.VirtualMachineStatusOK, reason
+			default:
+				return api.VirtualMachineStateUPDATING, api.VirtualMachineStatusOK, reason
+			}
+		}
+	}
+	if metricsErr != nil {
+		return api.VirtualMachineStateUNAVAILABLE, api.VirtualMachineStatusERROR, "vm-monitor: " + metricsErr.Error()
+	}
+	if len(record.Requests) > 0 && constants.RequestStatus(record.Requests[0].RequestStatus) == constants.StatusFailure {
+		latest := record.Requests[0]
+		return api.VirtualMachineStateOK, api.VirtualMachineStatusWARNING, fmt.Sprintf("Request %s (%s) failed", latest.RequestID, latest.Operation)
+	}
+	return api.VirtualMachineStateOK, api.VirtualMachineStatusOK, ""
+}
+
+// normalizePowerState maps the power states vm-monitor reports, such as "POWERED_ON",
+// "poweredOn" or "on", to the API enum.
+func normalizePowerState(powerstate string) api.VirtualMachinePowerState {
+	normalized := strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToUpper(powerstate))
+	switch normalized {
+	case "POWEREDON", "ON", "RUNNING":
+		return api.VirtualMachinePowerStatePOWEREDON
+	case "POWEREDOFF", "OFF", "STOPPED":
+		return api.VirtualMachinePowerStatePOWEREDOFF
+	case "SUSPENDED":
+		return api.VirtualMachinePowerStateSUSPENDED
+	default:
+		return api.VirtualMachinePowerStateUNKNOWN
+	}
+}
+
+// guestType classifies the guest OS name vm-monitor reports.
+func guestType(guest string) api.VirtualMachineGuestInfoType {
+	lower := strings.ToLower(guest)
+	switch {
+	case strings.Contains(lower, "windows"):
+		return api.VirtualMachineGuestInfoTypeWINDOWS
+	case strings.Contains(lower, "linux"), strings.Contains(lower, "ubuntu"), strings.Contains(lower, "centos"),
+		strings.Contains(lower, "red hat"), strings.Contains(lower, "rhel"), strings.Contains(lower, "debian"), strings.Contains(lower, "suse"):
+		return api.VirtualMachineGuestInfoTypeLINUX
+	default:
+		return api.VirtualMachineGuestInfoTypeOTHERS
+	}
+}
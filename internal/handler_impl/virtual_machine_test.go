@@ -0,0 +1,235 @@
+package handler_impl_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"vm/internal/client"
+	vmmonitor "vm/internal/client/vm_monitor"
+	dto "vm/internal/dtos"
+	api "vm/internal/gen"
+	"vm/internal/handler_impl"
+	"vm/internal/modals"
+	mock_service "vm/internal/service/mock"
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/constants"
+	mock_db "vm/pkg/db/mock"
+	"vm/pkg/dependency"
+	mock_logger "vm/pkg/logger/mock"
+)
+
+// newVMMonitor serves vm-monitor metrics for the VMs in powerStates and 404 for any other VM.
+func newVMMonitor(t *testing.T, powerStates map[string]string) *vmmonitor.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vmID := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		powerState, ok := powerStates[vmID]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"vm-id":"` + vmID + `","vm_name":"` + vmID + `-live","powerstate":"` + powerState + `",` +
+			`"cpu_cores":4,"mem_size":8192,"guest":"Ubuntu Linux (64-bit)","network_address":"10.0.0.5",` +
+			`"cpu_usage":0,"mem_usage":0,"network_usage":0,"uptime":0,"vm_metadata":"","vm_stat":"",` +
+			`"observation_time":"2026-01-01T00:00:00Z","updated_at":"2026-01-01T00:00:00Z"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	vmClient, err := vmmonitor.NewClient(server.URL, &client.VmMonitorSecuritySource{})
+	assert.NoError(t, err)
+	return vmClient
+}
+
+func vmRecord(vmID string, requests ...*modals.VMRequest) *dto.VMRecord {
+	completedAt := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	return &dto.VMRecord{
+		Instance: &modals.VMDeployInstance{
+			RequestID:   "req-deploy",
+			VMName:      "web_1",
+			VMID:        vmID,
+			VMStatus:    string(constants.StatusSuccess),
+			CompletedAt: &completedAt,
+		},
+		Requests: requests,
+	}
+}
+
+func TestHandler_GetVirtualMachine(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVMService := mock_service.NewMockVMService(ctrl)
+	deps := &dependency.Dependency{
+		Ctx:      context.Background(),
+		Logger:   &mock_logger.StubLogger{},
+		Database: mock_db.NewMockDatabase(ctrl),
+		Config:   &configmanager.Config{},
+		ClientDependency: &dependency.ClientDependency{
+			VmMonitorClient: newVMMonitor(t, map[string]string{"vm-on": "poweredOn", "vm-off": "POWERED_OFF"}),
+		},
+	}
+	handler := handler_impl.NewHandler(mockVMService, deps)
+
+	t.Run("Combines the deploy instance with live metrics", func(t *testing.T) {
+		mockVMService.EXPECT().GetVM(gomock.Any(), "vm-on").Return(vmRecord("vm-on"), nil)
+
+		res, err := handler.GetVirtualMachine(context.Background(), api.GetVirtualMachineParams{VMID: "vm-on"})
+		assert.NoError(t, err)
+		vm := res.(*api.VirtualMachine)
+		assert.Equal(t, "vm-on", vm.ID)
+		assert.Equal(t, "vm-on-live", vm.Name.Value)
+		assert.Equal(t, "web_1", vm.DisplayName.Value)
+		assert.Equal(t, constants.VMBasePath+"vm-on", vm.ResourceUri.Value)
+		assert.Equal(t, api.VirtualMachinePowerStatePOWEREDON, vm.PowerState.Value)
+		assert.Equal(t, api.VirtualMachineStateOK, vm.State.Value)
+		assert.Equal(t, 4, vm.ComputeInfo.Value.NumCpuCores.Value)
+		assert.Equal(t, "8192", vm.ComputeInfo.Value.MemorySizeInMib.Value)
+		assert.Equal(t, api.VirtualMachineGuestInfoTypeLINUX, vm.GuestInfo.Value.Type.Value)
+		assert.Equal(t, "10.0.0.5", vm.NetworkAddress.Value)
+		assert.Contains(t, vm.AllowedOperations, api.VirtualMachineAllowedOperationsItemVIRTUALMACHINEPOWEROFF)
+		assert.NotContains(t, vm.AllowedOperations, api.VirtualMachineAllowedOperationsItemVIRTUALMACHINEDELETE)
+		assert.Equal(t, api.Generation(1), vm.Generation)
+	})
+
+	t.Run("In-flight request marks the VM busy", func(t *testing.T) {
+		mockVMService.EXPECT().GetVM(gomock.Any(), "vm-off").Return(vmRecord("vm-off", &modals.VMRequest{
+			RequestID:     "req-del",
+			Operation:     string(constants.VMDelete),
+			RequestStatus: string(constants.StatusInProgress),
+		}), nil)
+
+		res, err := handler.GetVirtualMachine(context.Background(), api.GetVirtualMachineParams{VMID: "vm-off"})
+		assert.NoError(t, err)
+		vm := res.(*api.VirtualMachine)
+		assert.Equal(t, api.VirtualMachineStateDELETING, vm.State.Value)
+		assert.Equal(t, api.VirtualMachinePowerStatePOWEREDOFF, vm.PowerState.Value)
+		assert.Empty(t, vm.AllowedOperations)
+		assert.Equal(t, api.Generation(2), vm.Generation)
+	})
+
+	t.Run("Deleted VM is not looked up in vm-monitor", func(t *testing.T) {
+		mockVMService.EXPECT().GetVM(gomock.Any(), "vm-gone").Return(vmRecord("vm-gone", &modals.VMRequest{
+			RequestID:     "req-del",
+			Operation:     string(constants.VMDelete),
+			RequestStatus: string(constants.StatusSuccess),
+		}), nil)
+
+		res, err := handler.GetVirtualMachine(context.Background(), api.GetVirtualMachineParams{VMID: "vm-gone"})
+		assert.NoError(t, err)
+		vm := res.(*api.VirtualMachine)
+		assert.Equal(t, api.VirtualMachineStateDELETED, vm.State.Value)
+		assert.Equal(t, api.VirtualMachinePowerStateUNKNOWN, vm.PowerState.Value)
+	})
+
+	t.Run("VM unknown to vm-monitor is unavailable", func(t *testing.T) {
+		mockVMService.EXPECT().GetVM(gomock.Any(), "vm-lost").Return(vmRecord("vm-lost"), nil)
+
+		res, err := handler.GetVirtualMachine(context.Background(), api.GetVirtualMachineParams{VMID: "vm-lost"})
+		assert.NoError(t, err)
+		vm := res.(*api.VirtualMachine)
+		assert.Equal(t, api.VirtualMachineStateUNAVAILABLE, vm.State.Value)
+		assert.Equal(t, api.VirtualMachineStatusERROR, vm.Status.Value)
+		assert.True(t, vm.StateReason.Set)
+		assert.Empty(t, vm.AllowedOperations)
+	})
+
+	t.Run("VM not deployed in the workspace", func(t *testing.T) {
+		mockVMService.EXPECT().GetVM(gomock.Any(), "vm-other").
+			Return(nil, &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "VM not found"})
+
+		res, err := handler.GetVirtualMachine(context.Background(), api.GetVirtualMachineParams{VMID: "vm-other"})
+		assert.NoError(t, err)
+		assert.IsType(t, &api.GetVirtualMachineNotFound{}, res)
+	})
+}
+
+func TestHandler_GetVirtualMachineList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockVMService := mock_service.NewMockVMService(ctrl)
+	deps := &dependency.Dependency{
+		Ctx:      context.Background(),
+		Logger:   &mock_logger.StubLogger{},
+		Database: mock_db.NewMockDatabase(ctrl),
+		Config:   &configmanager.Config{},
+		ClientDependency: &dependency.ClientDependency{
+			VmMonitorClient: newVMMonitor(t, map[string]string{"vm-1": "POWERED_ON", "vm-2": "POWERED_OFF"}),
+		},
+	}
+	handler := handler_impl.NewHandler(mockVMService, deps)
+
+	t.Run("Success", func(t *testing.T) {
+		mockVMService.EXPECT().ListVMs(gomock.Any(), 2, 4).
+			Return([]*dto.VMRecord{vmRecord("vm-1"), vmRecord("vm-2"), vmRecord("vm-3")}, 7, nil)
+
+		res, err := handler.GetVirtualMachineList(context.Background(), api.GetVirtualMachineListParams{
+			Limit:  api.NewOptInt(2),
+			Offset: api.NewOptInt(4),
+		})
+		assert.NoError(t, err)
+		list := res.(*api.VirtualMachineList)
+		assert.Equal(t, 3, list.Count)
+		assert.Equal(t, 7, list.Total)
+		assert.Equal(t, 2, list.Limit)
+		assert.Equal(t, 4, list.Offset)
+		assert.Equal(t, api.VirtualMachinePowerStatePOWEREDON, list.Items[0].PowerState.Value)
+		assert.Equal(t, api.VirtualMachinePowerStatePOWEREDOFF, list.Items[1].PowerState.Value)
+		assert.Equal(t, api.VirtualMachineStateUNAVAILABLE, list.Items[2].State.Value)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		mockVMService.EXPECT().ListVMs(gomock.Any(), 50, 0).
+			Return(nil, 0, &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "db error"})
+
+		res, err := handler.GetVirtualMachineList(context.Background(), api.GetVirtualMachineListParams{})
+		assert.NoError(t, err)
+		assert.IsType(t, &api.GetVirtualMachineListInternalServerError{}, res)
+	})
+}
+
+func TestVMPowerOff_VMMonitorCircuitOpen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	settings := configmanager.Client{ConnectTimeout: 1, Timeout: 5, BreakerThreshold: 1, BreakerCooldown: 60}
+	httpClient := client.NewHTTPClient("vm-monitor", func() configmanager.Client { return settings }, &mock_logger.StubLogger{})
+	vmClient, err := vmmonitor.NewClient(server.URL, &client.VmMonitorSecuritySource{}, vmmonitor.WithClient(httpClient))
+	assert.NoError(t, err)
+
+	mockVMService := mock_service.NewMockVMService(ctrl)
+	deps := &dependency.Dependency{
+		Ctx:    context.Background(),
+		Logger: &mock_logger.StubLogger{},
+		Config: &configmanager.Config{
+			App: configmanager.ApplicationConfig{
+				Application: configmanager.Application{ValidateClientRequest: true},
+			},
+		},
+		ClientDependency: &dependency.ClientDependency{VmMonitorClient: vmClient},
+	}
+	handler := handler_impl.NewHandler(mockVMService, deps)
+	mockVMService.EXPECT().GetInventoryVM(gomock.Any(), "vm-001").Return(nil, nil).Times(2)
+
+	res, err := handler.VMPowerOff(context.Background(), api.VMPowerOffParams{VMID: "vm-001"})
+	assert.NoError(t, err)
+	assert.IsType(t, &api.VMPowerOffInternalServerError{}, res, "a failing vm-monitor is an internal error")
+
+	res, err = handler.VMPowerOff(context.Background(), api.VMPowerOffParams{VMID: "vm-001"})
+	assert.NoError(t, err)
+	if assert.IsType(t, &api.VMPowerOffServiceUnavailable{}, res, "an open breaker is a 503") {
+		assert.Equal(t, http.StatusServiceUnavailable, res.(*api.VMPowerOffServiceUnavailable).HttpStatusCode)
+	}
+}
@@ -0,0 +1,187 @@
+package inventory
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	vmmonitor "vm/internal/client/vm_monitor"
+	"vm/internal/modals"
+	"vm/internal/repo"
+	"vm/pkg/cinterface"
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/constants"
+	"vm/pkg/utils"
+)
+
+const (
+	defaultSyncInterval = 60 * time.Second
+	defaultBatchSize    = 50
+	defaultWorkers      = 4
+	metricsTimeout      = 10 * time.Second
+)
+
+// MetricsSource reads the live state of a VM. *vmmonitor.Client implements it.
+type MetricsSource interface {
+	GetVmMetrics(ctx context.Context, params vmmonitor.GetVmMetricsParams) (*vmmonitor.VmMetrics, error)
+}
+
+// Reconciler keeps the power state and metrics of the Active VMs in the inventory in step
+// with vm-monitor.
+type Reconciler interface {
+	Start(ctx context.Context)
+	Stop()
+	RunOnce(ctx context.Context) int
+}
+
+// reconciler implements the Reconciler interface.
+type reconciler struct {
+	vmRepo   repo.VMRepository
+	source   MetricsSource
+	logger   cinterface.Logger
+	interval time.Duration
+	batch    int
+	workers  int
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewReconciler creates a new Reconciler.
+func NewReconciler(vmRepo repo.VMRepository, source MetricsSource, cfg configmanager.Inventory, logger cinterface.Logger) Reconciler {
+	r := &reconciler{
+		vmRepo:   vmRepo,
+		source:   source,
+		logger:   logger,
+		interval: time.Duration(cfg.SyncInterval) * time.Second,
+		batch:    cfg.BatchSize,
+		workers:  cfg.Workers,
+	}
+	if r.interval <= 0 {
+		r.interval = defaultSyncInterval
+	}
+	if r.batch <= 0 {
+		r.batch = defaultBatchSize
+	}
+	if r.workers <= 0 {
+		r.workers = defaultWorkers
+	}
+	return r
+}
+
+// Start syncs the inventory in the background until Stop is called.
+func (r *reconciler) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.logger.Info(constants.Internal, constants.Inventory, "VM inventory reconciler started", map[constants.ExtraKey]interface{}{
+			"interval": r.interval.String(),
+			"batch":    r.batch,
+			"workers":  r.workers,
+		})
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			// Synced VMs drop out of the listing, so a full batch means more are due.
+			for r.RunOnce(ctx) == r.batch && ctx.Err() == nil {
+			}
+			select {
+			case <-ctx.Done():
+				r.logger.Info(constants.Internal, constants.Inventory, "VM inventory reconciler stopped", nil)
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops syncing and waits for the current batch to finish.
+func (r *reconciler) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
+
+// RunOnce syncs one batch of the VMs not synced within the last interval and returns how
+// many it stored.
+func (r *reconciler) RunOnce(ctx context.Context) int {
+	if ctx.Err() != nil {
+		return 0
+	}
+	vms, err := r.vmRepo.ListVirtualMachinesToSync(ctx, time.Now().UTC().Add(-r.interval), r.batch)
+	if err != nil {
+		r.logger.Error(constants.Internal, constants.Inventory, "Failed to list VMs to sync", map[constants.ExtraKey]interface{}{
+			"error": err.Message,
+		})
+		return 0
+	}
+
+	var stored atomic.Int64
+	sem := make(chan struct{}, r.workers)
+	var wg sync.WaitGroup
+	for _, vm := range vms {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(vm *modals.VirtualMachine) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if r.sync(ctx, vm) {
+				stored.Add(1)
+			}
+		}(vm)
+	}
+	wg.Wait()
+	return int(stored.Load())
+}
+
+// sync reads vm from vm-monitor and stores the result. A VM vm-monitor cannot describe keeps
+// its last known state and records the error, so it is retried next interval. sync reports
+// whether the result was stored.
+func (r *reconciler) sync(ctx context.Context, vm *modals.VirtualMachine) bool {
+	// Repository calls are scoped to the workspace that owns the VM.
+	ctx = context.WithValue(ctx, utils.WorkspaceIDKey, vm.WorkspaceId)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, metricsTimeout)
+	metrics, err := r.source.GetVmMetrics(timeoutCtx, vmmonitor.GetVmMetricsParams{VMID: vm.VMID})
+	cancel()
+
+	syncedAt := time.Now().UTC()
+	vm.SyncedAt = &syncedAt
+	if err != nil {
+		r.logger.Warn(constants.Internal, constants.Inventory, "Failed to get VM metrics", map[constants.ExtraKey]interface{}{
+			"vmID":  vm.VMID,
+			"error": err.Error(),
+		})
+		vm.SyncError = err.Error()
+	} else {
+		applyMetrics(vm, metrics)
+	}
+
+	if apiErr := r.vmRepo.SyncVirtualMachine(ctx, vm); apiErr != nil {
+		r.logger.Error(constants.Internal, constants.Inventory, "Failed to store VM metrics", map[constants.ExtraKey]interface{}{
+			"vmID":  vm.VMID,
+			"error": apiErr.Message,
+		})
+		return false
+	}
+	return true
+}
+
+// applyMetrics copies the vm-monitor view of a VM onto its inventory record.
+func applyMetrics(vm *modals.VirtualMachine, metrics *vmmonitor.VmMetrics) {
+	if metrics.VMName != "" {
+		vm.VMName = metrics.VMName
+	}
+	vm.PowerState = metrics.Powerstate
+	vm.CPUCores = metrics.CPUCores
+	vm.CPUUsage = metrics.CPUUsage
+	vm.MemSize = metrics.MemSize
+	vm.MemUsage = metrics.MemUsage
+	vm.Guest = metrics.Guest
+	vm.NetworkAddress = metrics.NetworkAddress
+	vm.SyncError = ""
+}
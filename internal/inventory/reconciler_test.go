@@ -0,0 +1,165 @@
+package inventory_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	vmmonitor "vm/internal/client/vm_monitor"
+	dto "vm/internal/dtos"
+	"vm/internal/inventory"
+	"vm/internal/modals"
+	mock_repo "vm/internal/repo/mock"
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/constants"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+// fakeSource serves canned vm-monitor metrics and errors by VM id.
+type fakeSource struct {
+	mu      sync.Mutex
+	metrics map[string]*vmmonitor.VmMetrics
+	errs    map[string]error
+	calls   int
+}
+
+func (s *fakeSource) GetVmMetrics(ctx context.Context, params vmmonitor.GetVmMetricsParams) (*vmmonitor.VmMetrics, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	if err := s.errs[params.VMID]; err != nil {
+		return nil, err
+	}
+	return s.metrics[params.VMID], nil
+}
+
+func TestReconciler_RunOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := &mock_logger.StubLogger{}
+	ctx := context.Background()
+	cfg := configmanager.Inventory{SyncInterval: 60, BatchSize: 10, Workers: 2}
+
+	t.Run("Stores the metrics vm-monitor reports", func(t *testing.T) {
+		mockRepo := mock_repo.NewMockVMRepository(ctrl)
+		source := &fakeSource{metrics: map[string]*vmmonitor.VmMetrics{
+			"vm-001": {VMName: "web_1", Powerstate: "POWERED_OFF", CPUCores: 4, MemSize: 8192, Guest: "Ubuntu Linux", NetworkAddress: "10.0.0.5"},
+		}}
+		rec := inventory.NewReconciler(mockRepo, source, cfg, logger)
+
+		before := time.Now().UTC()
+		mockRepo.EXPECT().ListVirtualMachinesToSync(gomock.Any(), gomock.Any(), 10).
+			DoAndReturn(func(_ context.Context, syncedBefore time.Time, _ int) ([]*modals.VirtualMachine, *dto.ApiResponseError) {
+				// Only VMs not synced within the last interval are due.
+				assert.WithinDuration(t, before.Add(-60*time.Second), syncedBefore, 5*time.Second)
+				return []*modals.VirtualMachine{{
+					VMID:        "vm-001",
+					WorkspaceId: "workspace-001",
+					State:       string(constants.VMStateActive),
+					PowerState:  "POWERED_ON",
+					SyncError:   "timeout",
+				}}, nil
+			})
+		mockRepo.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, vm *modals.VirtualMachine) *dto.ApiResponseError {
+				workspaceID, err := utils.GetWorkspaceIDFromContext(ctx)
+				assert.NoError(t, err)
+				assert.Equal(t, "workspace-001", workspaceID)
+				assert.Equal(t, "web_1", vm.VMName)
+				assert.Equal(t, "POWERED_OFF", vm.PowerState)
+				assert.Equal(t, 4, vm.CPUCores)
+				assert.Equal(t, float32(8192), vm.MemSize)
+				assert.Equal(t, "Ubuntu Linux", vm.Guest)
+				assert.Equal(t, "10.0.0.5", vm.NetworkAddress)
+				assert.Empty(t, vm.SyncError)
+				assert.NotNil(t, vm.SyncedAt)
+				return nil
+			})
+
+		assert.Equal(t, 1, rec.RunOnce(ctx))
+	})
+
+	t.Run("vm-monitor failure keeps the last known state", func(t *testing.T) {
+		mockRepo := mock_repo.NewMockVMRepository(ctrl)
+		source := &fakeSource{errs: map[string]error{"vm-001": errors.New("connection refused")}}
+		rec := inventory.NewReconciler(mockRepo, source, cfg, logger)
+
+		mockRepo.EXPECT().ListVirtualMachinesToSync(gomock.Any(), gomock.Any(), 10).
+			Return([]*modals.VirtualMachine{{VMID: "vm-001", WorkspaceId: "workspace-001", PowerState: "POWERED_ON"}}, nil)
+		mockRepo.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, vm *modals.VirtualMachine) *dto.ApiResponseError {
+				assert.Equal(t, "POWERED_ON", vm.PowerState)
+				assert.Equal(t, "connection refused", vm.SyncError)
+				assert.NotNil(t, vm.SyncedAt)
+				return nil
+			})
+
+		assert.Equal(t, 1, rec.RunOnce(ctx))
+	})
+
+	t.Run("Failed writes are not counted", func(t *testing.T) {
+		mockRepo := mock_repo.NewMockVMRepository(ctrl)
+		source := &fakeSource{metrics: map[string]*vmmonitor.VmMetrics{
+			"vm-001": {Powerstate: "POWERED_ON"},
+			"vm-002": {Powerstate: "POWERED_ON"},
+		}}
+		rec := inventory.NewReconciler(mockRepo, source, cfg, logger)
+
+		mockRepo.EXPECT().ListVirtualMachinesToSync(gomock.Any(), gomock.Any(), 10).
+			Return([]*modals.VirtualMachine{{VMID: "vm-001"}, {VMID: "vm-002"}}, nil)
+		mockRepo.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, vm *modals.VirtualMachine) *dto.ApiResponseError {
+				if vm.VMID == "vm-002" {
+					return &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "db error"}
+				}
+				return nil
+			}).Times(2)
+
+		assert.Equal(t, 1, rec.RunOnce(ctx))
+	})
+
+	t.Run("List failure", func(t *testing.T) {
+		mockRepo := mock_repo.NewMockVMRepository(ctrl)
+		source := &fakeSource{}
+		rec := inventory.NewReconciler(mockRepo, source, cfg, logger)
+
+		mockRepo.EXPECT().ListVirtualMachinesToSync(gomock.Any(), gomock.Any(), 10).
+			Return(nil, &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "db error"})
+
+		assert.Equal(t, 0, rec.RunOnce(ctx))
+		assert.Equal(t, 0, source.calls)
+	})
+}
+
+func TestReconciler_StartStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repo.NewMockVMRepository(ctrl)
+	source := &fakeSource{metrics: map[string]*vmmonitor.VmMetrics{"vm-001": {Powerstate: "POWERED_ON"}}}
+	rec := inventory.NewReconciler(mockRepo, source, configmanager.Inventory{SyncInterval: 3600, BatchSize: 10, Workers: 1}, &mock_logger.StubLogger{})
+
+	synced := make(chan struct{})
+	mockRepo.EXPECT().ListVirtualMachinesToSync(gomock.Any(), gomock.Any(), 10).
+		Return([]*modals.VirtualMachine{{VMID: "vm-001", WorkspaceId: "workspace-001"}}, nil)
+	mockRepo.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, *modals.VirtualMachine) *dto.ApiResponseError {
+			close(synced)
+			return nil
+		})
+
+	rec.Start(context.Background())
+	select {
+	case <-synced:
+	case <-time.After(5 * time.Second):
+		t.Fatal("inventory was not synced")
+	}
+	rec.Stop()
+}
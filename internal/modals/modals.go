@@ -19,24 +19,70 @@ func SetUUID(model UUIDModel) {
  
 // VMRequest model
 type VMRequest struct {
-    RequestID       string     `gorm:"column:request_id;primaryKey;type:char(36)" json:"request_id"`
+    RequestID       string     `gorm:"column:request_id;primaryKey;type:varchar(36)" json:"request_id"`
     Operation       string     `gorm:"column:operation;not null;type:varchar(50)" json:"operation"`
     RequestStatus   string     `gorm:"column:request_status;not null;type:varchar(50)" json:"request_status"`
     WorkspaceId     string     `gorm:"column:workspace_id;type:varchar(50);default:''" json:"workspace_id"`
     DatacenterId    string     `gorm:"column:datacenter_id;type:varchar(50);default:''" json:"datacenter_id"`
-    CreatedAt       time.Time  `gorm:"column:created_at;autoCreateTime;type:timestamp" json:"created_at"`
-    CompletedAt     *time.Time `gorm:"column:completed_at;type:timestamp" json:"completed_at"`
+    CreatedAt       time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+    CompletedAt     *time.Time `gorm:"column:completed_at" json:"completed_at"`
     RequestMetadata string     `gorm:"column:request_metadata;type:text" json:"request_metadata"`
+    CancelRequested bool       `gorm:"column:cancel_requested;not null;default:false" json:"cancel_requested"`
+    ParentRequestID *string    `gorm:"column:parent_request_id;type:varchar(36);uniqueIndex" json:"parent_request_id"`
+    // LockedVMID is the per-VM operation lock. It names the target VM while a request that
+    // changes the VM is New or Inprogress, and its unique index admits one such request per VM.
+    LockedVMID      *string    `gorm:"column:locked_vm_id;type:varchar(50);uniqueIndex" json:"locked_vm_id"`
+    // VMID is the VM the request targets, copied from its metadata when it is created so that
+    // the requests of a VM are found by index. It is empty for deploys.
+    VMID            string     `gorm:"column:vm_id;type:varchar(50);default:'';index:idx_vm_requests_vm_id" json:"vm_id"`
+    // ClaimedBy names the executor that claimed the request and ClaimedAt is when it last
+    // renewed its claim. A request whose claim is not renewed in time is failed.
+    ClaimedBy       string     `gorm:"column:claimed_by;type:varchar(100);default:''" json:"claimed_by"`
+    ClaimedAt       *time.Time `gorm:"column:claimed_at" json:"claimed_at"`
 }
  
 // VMDeployInstance model
 type VMDeployInstance struct {
-    RequestID      string     `gorm:"column:request_id;primaryKey;type:char(36)" json:"request_id"`
+    RequestID      string     `gorm:"column:request_id;primaryKey;type:varchar(36)" json:"request_id"`
     VMName         string     `gorm:"column:vm_name;primaryKey;type:varchar(255)" json:"vm_name"`
     VMID           string     `gorm:"column:vm_id;type:varchar(50)" json:"vm_id"`
     VMStatus       string     `gorm:"column:vm_status;not null;type:varchar(50)" json:"vm_status"`
     VMStateMessage string     `gorm:"column:vm_state_message;type:text" json:"vm_state_message"`
-    CompletedAt    *time.Time `gorm:"column:completed_at;type:timestamp" json:"completed_at"`
+    CompletedAt    *time.Time `gorm:"column:completed_at" json:"completed_at"`
+}
+ 
+// IdempotencyKey records the VMRequest created for a client supplied Idempotency-Key.
+// The key is reserved and linked to its request in one transaction, so RequestID is only
+// empty in a reservation left by a call that did not finish.
+type IdempotencyKey struct {
+    WorkspaceId    string    `gorm:"column:workspace_id;primaryKey;type:varchar(50)" json:"workspace_id"`
+    IdempotencyKey string    `gorm:"column:idempotency_key;primaryKey;type:varchar(255)" json:"idempotency_key"`
+    Operation      string    `gorm:"column:operation;not null;type:varchar(50)" json:"operation"`
+    Fingerprint    string    `gorm:"column:fingerprint;not null;type:varchar(64)" json:"fingerprint"`
+    RequestID      string    `gorm:"column:request_id;type:varchar(36);default:''" json:"request_id"`
+    CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+ 
+// VirtualMachine is the local inventory record of a VM. The executor writes it when a
+// request deploys, powers or deletes the VM and the inventory reconciler refreshes its
+// power state and metrics from vm-monitor.
+type VirtualMachine struct {
+    VMID           string     `gorm:"column:vm_id;primaryKey;type:varchar(50)" json:"vm_id"`
+    WorkspaceId    string     `gorm:"column:workspace_id;not null;type:varchar(50);index" json:"workspace_id"`
+    VMName         string     `gorm:"column:vm_name;type:varchar(255);default:''" json:"vm_name"`
+    RequestID      string     `gorm:"column:request_id;type:varchar(36);default:''" json:"request_id"`
+    State          string     `gorm:"column:state;not null;type:varchar(20)" json:"state"`
+    PowerState     string     `gorm:"column:power_state;type:varchar(50);default:''" json:"power_state"`
+    CPUCores       int        `gorm:"column:cpu_cores;not null;default:0" json:"cpu_cores"`
+    CPUUsage       float32    `gorm:"column:cpu_usage;not null;default:0" json:"cpu_usage"`
+    MemSize        float32    `gorm:"column:mem_size;not null;default:0" json:"mem_size"`
+    MemUsage       float32    `gorm:"column:mem_usage;not null;default:0" json:"mem_usage"`
+    Guest          string     `gorm:"column:guest;type:varchar(255);default:''" json:"guest"`
+    NetworkAddress string     `gorm:"column:network_address;type:varchar(255);default:''" json:"network_address"`
+    SyncError      string     `gorm:"column:sync_error;type:text" json:"sync_error"`
+    SyncedAt       *time.Time `gorm:"column:synced_at" json:"synced_at"`
+    CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+    UpdatedAt      time.Time  `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
 }
  
 // Implement UUIDModel for VMRequest
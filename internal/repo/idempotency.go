@@ -0,0 +1,113 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/pkg/constants"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// IdempotencyKeyRetention is how long a key replays the request created for it.
+	IdempotencyKeyRetention = 24 * time.Hour
+	// IdempotencyReservationTTL is how long a key may stay reserved without a request before
+	// the call that reserved it is taken to have died.
+	IdempotencyReservationTTL = time.Minute
+)
+
+// ReserveIdempotencyKey inserts key for the caller's workspace. A key the workspace
+// already holds is reported as a conflict, so only one caller wins a race on it. It first
+// deletes the workspace's keys that are past IdempotencyKeyRetention or were abandoned
+// while reserved, so those keys can be used again.
+func (r *vmRepository) ReserveIdempotencyKey(ctx context.Context, key *modals.IdempotencyKey) *dto.ApiResponseError {
+	r.logger.Info(constants.MySql, constants.Insert, "ReserveIdempotencyKey repository function invoked", map[constants.ExtraKey]interface{}{
+		"operation": key.Operation,
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	key.WorkspaceId = workspaceID
+	db := r.db.GetWriter()
+
+	now := time.Now().UTC()
+	expired := db.WithContext(ctx).
+		Where("workspace_id = ? AND (created_at < ? OR (request_id = '' AND created_at < ?))",
+			workspaceID, now.Add(-IdempotencyKeyRetention), now.Add(-IdempotencyReservationTTL)).
+		Delete(&modals.IdempotencyKey{})
+	if expired.Error != nil {
+		r.logger.Error(constants.MySql, constants.Delete, "Failed to delete expired IdempotencyKeys", map[constants.ExtraKey]interface{}{
+			"error": expired.Error.Error(),
+		})
+		return databaseError(expired.Error)
+	}
+
+	result := db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(key)
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Insert, "Failed to reserve IdempotencyKey", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return databaseError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return &dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: "Idempotency-Key is already in use"}
+	}
+
+	return nil
+}
+
+// GetIdempotencyKey retrieves the caller's record for key.
+func (r *vmRepository) GetIdempotencyKey(ctx context.Context, key string) (*modals.IdempotencyKey, *dto.ApiResponseError) {
+	r.logger.Info(constants.MySql, constants.Select, "GetIdempotencyKey repository function invoked", nil)
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	db := r.db.GetWriter()
+
+	var record modals.IdempotencyKey
+	result := db.WithContext(ctx).Where("workspace_id = ? AND idempotency_key = ?", workspaceID, key).First(&record)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "IdempotencyKey not found"}
+		}
+		r.logger.Error(constants.MySql, constants.Select, "Failed to get IdempotencyKey", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return nil, databaseError(result.Error)
+	}
+
+	return &record, nil
+}
+
+// CompleteIdempotencyKey links a reserved key to the VMRequest created for it.
+func (r *vmRepository) CompleteIdempotencyKey(ctx context.Context, key, requestID string) *dto.ApiResponseError {
+	r.logger.Info(constants.MySql, constants.Update, "CompleteIdempotencyKey repository function invoked", map[constants.ExtraKey]interface{}{
+		"requestID": requestID,
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	db := r.db.GetWriter()
+
+	result := db.WithContext(ctx).Model(&modals.IdempotencyKey{}).
+		Where("workspace_id = ? AND idempotency_key = ?", workspaceID, key).
+		Update("request_id", requestID)
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Update, "Failed to complete IdempotencyKey", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return databaseError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "IdempotencyKey not found"}
+	}
+
+	return nil
+}
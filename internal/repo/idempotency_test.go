@@ -0,0 +1,139 @@
+package repo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"vm/internal/modals"
+	"vm/internal/repo"
+	"vm/pkg/constants"
+	mock_db "vm/pkg/db/mock"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mock_db.NewMockDatabase(ctrl)
+	mockLogger := &mock_logger.StubLogger{}
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	newKey := func() *modals.IdempotencyKey {
+		return &modals.IdempotencyKey{IdempotencyKey: "key-1", Operation: "vmPowerOn", Fingerprint: "fp-1"}
+	}
+
+	t.Run("Reserve stamps the workspace", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM `idempotency_keys` WHERE workspace_id = \\? AND \\(created_at < \\? OR \\(request_id = '' AND created_at < \\?\\)\\)").
+			WithArgs("workspace-001", sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `idempotency_keys` .* ON DUPLICATE KEY UPDATE").
+			WithArgs("workspace-001", "key-1", "vmPowerOn", "fp-1", "", sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		key := newKey()
+		err := repo.NewVMRepository(mockDB, mockLogger).ReserveIdempotencyKey(ctx, key)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "workspace-001", key.WorkspaceId)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Reserve of a used key is a conflict", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM `idempotency_keys`").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `idempotency_keys`").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := repo.NewVMRepository(mockDB, mockLogger).ReserveIdempotencyKey(ctx, newKey())
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Reserve without a workspace", func(t *testing.T) {
+		err := repo.NewVMRepository(mockDB, mockLogger).ReserveIdempotencyKey(context.Background(), newKey())
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.UnauthorizedErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Get returns the workspace's key", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectQuery("SELECT \\* FROM `idempotency_keys` WHERE workspace_id = \\? AND idempotency_key = \\?").
+			WithArgs("workspace-001", "key-1", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"workspace_id", "idempotency_key", "operation", "fingerprint", "request_id", "created_at"}).
+				AddRow("workspace-001", "key-1", "vmPowerOn", "fp-1", "req-001", time.Now()))
+
+		record, err := repo.NewVMRepository(mockDB, mockLogger).GetIdempotencyKey(ctx, "key-1")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "req-001", record.RequestID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Get of an unknown key is not found", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectQuery("SELECT \\* FROM `idempotency_keys`").WillReturnError(gorm.ErrRecordNotFound)
+
+		record, err := repo.NewVMRepository(mockDB, mockLogger).GetIdempotencyKey(ctx, "key-1")
+
+		assert.Nil(t, record)
+		assert.Equal(t, constants.SQLRecordNotFoundErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Complete links the request", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `idempotency_keys` SET `request_id`=\\? WHERE workspace_id = \\? AND idempotency_key = \\?").
+			WithArgs("req-001", "workspace-001", "key-1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.NewVMRepository(mockDB, mockLogger).CompleteIdempotencyKey(ctx, "key-1", "req-001")
+
+		assert.Nil(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Reserve fails when expired keys cannot be deleted", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM `idempotency_keys`").WillReturnError(errors.New("db down"))
+		mock.ExpectRollback()
+
+		err := repo.NewVMRepository(mockDB, mockLogger).ReserveIdempotencyKey(ctx, newKey())
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,193 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/pkg/constants"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UpsertVirtualMachine records vm in the caller's workspace. A VM that is already in the
+// inventory keeps its synced metrics and only has its deploy details and state replaced.
+func (r *vmRepository) UpsertVirtualMachine(ctx context.Context, vm *modals.VirtualMachine) *dto.ApiResponseError {
+	r.logger.Info(constants.MySql, constants.Insert, "UpsertVirtualMachine repository function invoked", map[constants.ExtraKey]interface{}{
+		"vmID": vm.VMID,
+	})
+	if vm.VMID == "" {
+		return &dto.ApiResponseError{ErrorCode: constants.InvalidRequestErrorCode, Message: "A virtual machine must have an id"}
+	}
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	vm.WorkspaceId = workspaceID
+	if vm.State == "" {
+		vm.State = string(constants.VMStateActive)
+	}
+	db := r.db.GetWriter()
+
+	result := db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "vm_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"vm_name", "request_id", "state", "power_state", "updated_at"}),
+	}).Create(vm)
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Insert, "Failed to upsert virtual machine", map[constants.ExtraKey]interface{}{
+			"vmID":  vm.VMID,
+			"error": result.Error.Error(),
+		})
+		return databaseError(result.Error)
+	}
+
+	return nil
+}
+
+// GetVirtualMachine retrieves the inventory record of vmID in the caller's workspace.
+func (r *vmRepository) GetVirtualMachine(ctx context.Context, vmID string) (*modals.VirtualMachine, *dto.ApiResponseError) {
+	r.logger.Info(constants.MySql, constants.Select, "GetVirtualMachine repository function invoked", map[constants.ExtraKey]interface{}{
+		"vmID": vmID,
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	db := r.reader(ctx)
+
+	var vm modals.VirtualMachine
+	result := db.WithContext(ctx).Where("vm_id = ? AND workspace_id = ?", vmID, workspaceID).First(&vm)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "VM not found in inventory"}
+		}
+		r.logger.Error(constants.MySql, constants.Select, "Failed to get virtual machine", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return nil, databaseError(result.Error)
+	}
+
+	return &vm, nil
+}
+
+// MarkVirtualMachineDeleted records that vmID was deleted at deletedAt. A VM that was never
+// added to the inventory is not an error.
+func (r *vmRepository) MarkVirtualMachineDeleted(ctx context.Context, vmID string, deletedAt time.Time) *dto.ApiResponseError {
+	r.logger.Info(constants.MySql, constants.Update, "MarkVirtualMachineDeleted repository function invoked", map[constants.ExtraKey]interface{}{
+		"vmID": vmID,
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	db := r.db.GetWriter()
+
+	result := db.WithContext(ctx).Model(&modals.VirtualMachine{}).
+		Where("vm_id = ? AND workspace_id = ?", vmID, workspaceID).
+		Updates(map[string]interface{}{
+			"state":       string(constants.VMStateDeleted),
+			"power_state": "",
+			"sync_error":  "",
+			"synced_at":   deletedAt,
+		})
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Update, "Failed to mark virtual machine deleted", map[constants.ExtraKey]interface{}{
+			"vmID":  vmID,
+			"error": result.Error.Error(),
+		})
+		return databaseError(result.Error)
+	}
+
+	return nil
+}
+
+// UpdateVirtualMachinePowerState records the power state an operation left vmID in, until the
+// reconciler next reads it from vm-monitor. Deleted VMs are left alone.
+func (r *vmRepository) UpdateVirtualMachinePowerState(ctx context.Context, vmID, powerState string) *dto.ApiResponseError {
+	r.logger.Info(constants.MySql, constants.Update, "UpdateVirtualMachinePowerState repository function invoked", map[constants.ExtraKey]interface{}{
+		"vmID":       vmID,
+		"powerState": powerState,
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	db := r.db.GetWriter()
+
+	result := db.WithContext(ctx).Model(&modals.VirtualMachine{}).
+		Where("vm_id = ? AND workspace_id = ? AND state = ?", vmID, workspaceID, constants.VMStateActive).
+		Update("power_state", powerState)
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Update, "Failed to update virtual machine power state", map[constants.ExtraKey]interface{}{
+			"vmID":  vmID,
+			"error": result.Error.Error(),
+		})
+		return databaseError(result.Error)
+	}
+
+	return nil
+}
+
+// ListVirtualMachinesToSync returns up to limit Active VMs that were not synced since
+// syncedBefore, least recently synced first. Like ClaimNewVMRequests it spans every
+// workspace, because the reconciler serves them all.
+func (r *vmRepository) ListVirtualMachinesToSync(ctx context.Context, syncedBefore time.Time, limit int) ([]*modals.VirtualMachine, *dto.ApiResponseError) {
+	r.logger.Info(constants.MySql, constants.Select, "ListVirtualMachinesToSync repository function invoked", map[constants.ExtraKey]interface{}{
+		"limit": limit,
+	})
+	db := r.db.GetWriter()
+
+	vms := []*modals.VirtualMachine{}
+	// VMs that were never synced go ahead of the rest. PostgreSQL sorts NULL last, so the
+	// order does not rely on where NULL synced_at falls.
+	err := db.WithContext(ctx).
+		Where("state = ? AND (synced_at IS NULL OR synced_at < ?)", constants.VMStateActive, syncedBefore).
+		Order("synced_at IS NOT NULL, synced_at, vm_id").Limit(limit).Find(&vms).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		r.logger.Error(constants.MySql, constants.Select, "Failed to list virtual machines to sync", map[constants.ExtraKey]interface{}{
+			"error": err.Error(),
+		})
+		return nil, databaseError(err)
+	}
+
+	return vms, nil
+}
+
+// SyncVirtualMachine stores the power state, metrics and sync error the reconciler read for
+// vm. A VM deleted while it was being read stays deleted.
+func (r *vmRepository) SyncVirtualMachine(ctx context.Context, vm *modals.VirtualMachine) *dto.ApiResponseError {
+	r.logger.Info(constants.MySql, constants.Update, "SyncVirtualMachine repository function invoked", map[constants.ExtraKey]interface{}{
+		"vmID": vm.VMID,
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	db := r.db.GetWriter()
+
+	result := db.WithContext(ctx).Model(&modals.VirtualMachine{}).
+		Where("vm_id = ? AND workspace_id = ? AND state = ?", vm.VMID, workspaceID, constants.VMStateActive).
+		Updates(map[string]interface{}{
+			"vm_name":         vm.VMName,
+			"power_state":     vm.PowerState,
+			"cpu_cores":       vm.CPUCores,
+			"cpu_usage":       vm.CPUUsage,
+			"mem_size":        vm.MemSize,
+			"mem_usage":       vm.MemUsage,
+			"guest":           vm.Guest,
+			"network_address": vm.NetworkAddress,
+			"sync_error":      vm.SyncError,
+			"synced_at":       vm.SyncedAt,
+		})
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Update, "Failed to sync virtual machine", map[constants.ExtraKey]interface{}{
+			"vmID":  vm.VMID,
+			"error": result.Error.Error(),
+		})
+		return databaseError(result.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package repo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"vm/internal/modals"
+	"vm/internal/repo"
+	"vm/pkg/constants"
+	mock_db "vm/pkg/db/mock"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+func TestVirtualMachineInventory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mock_db.NewMockDatabase(ctrl)
+	mockLogger := &mock_logger.StubLogger{}
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	t.Run("Upsert records the VM in the caller's workspace", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `virtual_machines` .* ON DUPLICATE KEY UPDATE `vm_name`=VALUES\\(`vm_name`\\),`request_id`=VALUES\\(`request_id`\\),`state`=VALUES\\(`state`\\),`power_state`=VALUES\\(`power_state`\\)").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		vm := &modals.VirtualMachine{VMID: "vm-001", VMName: "web_1", RequestID: "req-001", PowerState: "POWERED_ON"}
+		err := repo.NewVMRepository(mockDB, mockLogger).UpsertVirtualMachine(ctx, vm)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "workspace-001", vm.WorkspaceId)
+		assert.Equal(t, string(constants.VMStateActive), vm.State)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Upsert without a VM id is rejected", func(t *testing.T) {
+		err := repo.NewVMRepository(mockDB, mockLogger).UpsertVirtualMachine(ctx, &modals.VirtualMachine{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InvalidRequestErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Get is scoped to the workspace", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetReader().Return(gormDB)
+
+		rows := sqlmock.NewRows([]string{"vm_id", "workspace_id", "state", "power_state"}).
+			AddRow("vm-001", "workspace-001", "Active", "POWERED_OFF")
+		mock.ExpectQuery("SELECT \\* FROM `virtual_machines` WHERE vm_id = \\? AND workspace_id = \\?").
+			WithArgs("vm-001", "workspace-001", 1).
+			WillReturnRows(rows)
+
+		vm, err := repo.NewVMRepository(mockDB, mockLogger).GetVirtualMachine(ctx, "vm-001")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "POWERED_OFF", vm.PowerState)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Get of an unknown VM is not found", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetReader().Return(gormDB)
+
+		mock.ExpectQuery("SELECT \\* FROM `virtual_machines`").WillReturnError(gorm.ErrRecordNotFound)
+
+		vm, err := repo.NewVMRepository(mockDB, mockLogger).GetVirtualMachine(ctx, "vm-404")
+
+		assert.Nil(t, vm)
+		assert.Equal(t, constants.SQLRecordNotFoundErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Mark deleted clears the power state", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		deletedAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `virtual_machines` SET `power_state`=\\?,`state`=\\?,`sync_error`=\\?,`synced_at`=\\?,`updated_at`=\\? WHERE vm_id = \\? AND workspace_id = \\?").
+			WithArgs("", "Deleted", "", deletedAt, sqlmock.AnyArg(), "vm-001", "workspace-001").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.NewVMRepository(mockDB, mockLogger).MarkVirtualMachineDeleted(ctx, "vm-001", deletedAt)
+
+		assert.Nil(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Power state updates only Active VMs", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `virtual_machines` SET `power_state`=\\?,`updated_at`=\\? WHERE vm_id = \\? AND workspace_id = \\? AND state = \\?").
+			WithArgs("POWERED_OFF", sqlmock.AnyArg(), "vm-001", "workspace-001", "Active").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.NewVMRepository(mockDB, mockLogger).UpdateVirtualMachinePowerState(ctx, "vm-001", "POWERED_OFF")
+
+		assert.Nil(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("List to sync spans every workspace", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		syncedBefore := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+		rows := sqlmock.NewRows([]string{"vm_id", "workspace_id", "state"}).
+			AddRow("vm-001", "workspace-001", "Active").
+			AddRow("vm-002", "workspace-002", "Active")
+		mock.ExpectQuery("SELECT \\* FROM `virtual_machines` WHERE state = \\? AND \\(synced_at IS NULL OR synced_at < \\?\\) ORDER BY synced_at IS NOT NULL, synced_at, vm_id LIMIT \\?").
+			WithArgs("Active", syncedBefore, 10).
+			WillReturnRows(rows)
+
+		vms, err := repo.NewVMRepository(mockDB, mockLogger).ListVirtualMachinesToSync(context.Background(), syncedBefore, 10)
+
+		assert.Nil(t, err)
+		assert.Len(t, vms, 2)
+		assert.Equal(t, "workspace-002", vms[1].WorkspaceId)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Sync failure is an internal error", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `virtual_machines` SET .* WHERE vm_id = \\? AND workspace_id = \\? AND state = \\?").
+			WillReturnError(errors.New("db down"))
+		mock.ExpectRollback()
+
+		syncedAt := time.Now().UTC()
+		err := repo.NewVMRepository(mockDB, mockLogger).SyncVirtualMachine(ctx, &modals.VirtualMachine{VMID: "vm-001", PowerState: "POWERED_ON", SyncedAt: &syncedAt})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
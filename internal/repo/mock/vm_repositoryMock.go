@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: vm_repository.go
+// Source: internal/repo/vm_repository.go
 
 // Package mock_repo is a generated GoMock package.
 package mock_repo
@@ -7,8 +7,11 @@ package mock_repo
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 	dto "vm/internal/dtos"
 	modals "vm/internal/modals"
+	repo "vm/internal/repo"
+	constants "vm/pkg/constants"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -36,6 +39,78 @@ func (m *MockVMRepository) EXPECT() *MockVMRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CancelPendingVMDeployInstances mocks base method.
+func (m *MockVMRepository) CancelPendingVMDeployInstances(ctx context.Context, requestID string, completedAt time.Time) *dto.ApiResponseError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPendingVMDeployInstances", ctx, requestID, completedAt)
+	ret0, _ := ret[0].(*dto.ApiResponseError)
+	return ret0
+}
+
+// CancelPendingVMDeployInstances indicates an expected call of CancelPendingVMDeployInstances.
+func (mr *MockVMRepositoryMockRecorder) CancelPendingVMDeployInstances(ctx, requestID, completedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPendingVMDeployInstances", reflect.TypeOf((*MockVMRepository)(nil).CancelPendingVMDeployInstances), ctx, requestID, completedAt)
+}
+
+// CancelVMRequest mocks base method.
+func (m *MockVMRepository) CancelVMRequest(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelVMRequest", ctx, requestID)
+	ret0, _ := ret[0].(*modals.VMRequest)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// CancelVMRequest indicates an expected call of CancelVMRequest.
+func (mr *MockVMRepositoryMockRecorder) CancelVMRequest(ctx, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVMRequest", reflect.TypeOf((*MockVMRepository)(nil).CancelVMRequest), ctx, requestID)
+}
+
+// ClaimNewVMRequests mocks base method.
+func (m *MockVMRepository) ClaimNewVMRequests(ctx context.Context, owner string, limit int) ([]*modals.VMRequest, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNewVMRequests", ctx, owner, limit)
+	ret0, _ := ret[0].([]*modals.VMRequest)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// ClaimNewVMRequests indicates an expected call of ClaimNewVMRequests.
+func (mr *MockVMRepositoryMockRecorder) ClaimNewVMRequests(ctx, owner, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNewVMRequests", reflect.TypeOf((*MockVMRepository)(nil).ClaimNewVMRequests), ctx, owner, limit)
+}
+
+// CompleteIdempotencyKey mocks base method.
+func (m *MockVMRepository) CompleteIdempotencyKey(ctx context.Context, key, requestID string) *dto.ApiResponseError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteIdempotencyKey", ctx, key, requestID)
+	ret0, _ := ret[0].(*dto.ApiResponseError)
+	return ret0
+}
+
+// CompleteIdempotencyKey indicates an expected call of CompleteIdempotencyKey.
+func (mr *MockVMRepositoryMockRecorder) CompleteIdempotencyKey(ctx, key, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteIdempotencyKey", reflect.TypeOf((*MockVMRepository)(nil).CompleteIdempotencyKey), ctx, key, requestID)
+}
+
+// CreateRetryVMRequest mocks base method.
+func (m *MockVMRepository) CreateRetryVMRequest(ctx context.Context, req *modals.VMRequest) *dto.ApiResponseError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRetryVMRequest", ctx, req)
+	ret0, _ := ret[0].(*dto.ApiResponseError)
+	return ret0
+}
+
+// CreateRetryVMRequest indicates an expected call of CreateRetryVMRequest.
+func (mr *MockVMRepositoryMockRecorder) CreateRetryVMRequest(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRetryVMRequest", reflect.TypeOf((*MockVMRepository)(nil).CreateRetryVMRequest), ctx, req)
+}
+
 // CreateVMDeployInstances mocks base method.
 func (m *MockVMRepository) CreateVMDeployInstances(ctx context.Context, instances []modals.VMDeployInstance) *dto.ApiResponseError {
 	m.ctrl.T.Helper()
@@ -64,20 +139,83 @@ func (mr *MockVMRepositoryMockRecorder) CreateVMRequest(ctx, req interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVMRequest", reflect.TypeOf((*MockVMRepository)(nil).CreateVMRequest), ctx, req)
 }
 
+// FailExpiredVMRequestClaims mocks base method.
+func (m *MockVMRepository) FailExpiredVMRequestClaims(ctx context.Context, claimedBefore time.Time, limit int) ([]*modals.VMRequest, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailExpiredVMRequestClaims", ctx, claimedBefore, limit)
+	ret0, _ := ret[0].([]*modals.VMRequest)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// FailExpiredVMRequestClaims indicates an expected call of FailExpiredVMRequestClaims.
+func (mr *MockVMRepositoryMockRecorder) FailExpiredVMRequestClaims(ctx, claimedBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailExpiredVMRequestClaims", reflect.TypeOf((*MockVMRepository)(nil).FailExpiredVMRequestClaims), ctx, claimedBefore, limit)
+}
+
 // GetAllVMRequestsWithInstances mocks base method.
-func (m *MockVMRepository) GetAllVMRequestsWithInstances(ctx context.Context) ([]*modals.VMRequest, []*modals.VMDeployInstance, *dto.ApiResponseError) {
+func (m *MockVMRepository) GetAllVMRequestsWithInstances(ctx context.Context, filter dto.VMRequestFilter) ([]*modals.VMRequest, []*modals.VMDeployInstance, int64, int64, *dto.ApiResponseError) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllVMRequestsWithInstances", ctx)
+	ret := m.ctrl.Call(m, "GetAllVMRequestsWithInstances", ctx, filter)
 	ret0, _ := ret[0].([]*modals.VMRequest)
 	ret1, _ := ret[1].([]*modals.VMDeployInstance)
+	ret2, _ := ret[2].(int64)
+	ret3, _ := ret[3].(int64)
+	ret4, _ := ret[4].(*dto.ApiResponseError)
+	return ret0, ret1, ret2, ret3, ret4
+}
+
+// GetAllVMRequestsWithInstances indicates an expected call of GetAllVMRequestsWithInstances.
+func (mr *MockVMRepositoryMockRecorder) GetAllVMRequestsWithInstances(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllVMRequestsWithInstances", reflect.TypeOf((*MockVMRepository)(nil).GetAllVMRequestsWithInstances), ctx, filter)
+}
+
+// GetDeployedVM mocks base method.
+func (m *MockVMRepository) GetDeployedVM(ctx context.Context, vmID string) (*modals.VMDeployInstance, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeployedVM", ctx, vmID)
+	ret0, _ := ret[0].(*modals.VMDeployInstance)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// GetDeployedVM indicates an expected call of GetDeployedVM.
+func (mr *MockVMRepositoryMockRecorder) GetDeployedVM(ctx, vmID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployedVM", reflect.TypeOf((*MockVMRepository)(nil).GetDeployedVM), ctx, vmID)
+}
+
+// GetDeployedVMs mocks base method.
+func (m *MockVMRepository) GetDeployedVMs(ctx context.Context, limit, offset int) ([]*modals.VMDeployInstance, int64, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeployedVMs", ctx, limit, offset)
+	ret0, _ := ret[0].([]*modals.VMDeployInstance)
+	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(*dto.ApiResponseError)
 	return ret0, ret1, ret2
 }
 
-// GetAllVMRequestsWithInstances indicates an expected call of GetAllVMRequestsWithInstances.
-func (mr *MockVMRepositoryMockRecorder) GetAllVMRequestsWithInstances(ctx interface{}) *gomock.Call {
+// GetDeployedVMs indicates an expected call of GetDeployedVMs.
+func (mr *MockVMRepositoryMockRecorder) GetDeployedVMs(ctx, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllVMRequestsWithInstances", reflect.TypeOf((*MockVMRepository)(nil).GetAllVMRequestsWithInstances), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployedVMs", reflect.TypeOf((*MockVMRepository)(nil).GetDeployedVMs), ctx, limit, offset)
+}
+
+// GetIdempotencyKey mocks base method.
+func (m *MockVMRepository) GetIdempotencyKey(ctx context.Context, key string) (*modals.IdempotencyKey, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyKey", ctx, key)
+	ret0, _ := ret[0].(*modals.IdempotencyKey)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// GetIdempotencyKey indicates an expected call of GetIdempotencyKey.
+func (mr *MockVMRepositoryMockRecorder) GetIdempotencyKey(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyKey", reflect.TypeOf((*MockVMRepository)(nil).GetIdempotencyKey), ctx, key)
 }
 
 // GetVMDeployInstances mocks base method.
@@ -109,3 +247,204 @@ func (mr *MockVMRepositoryMockRecorder) GetVMRequest(ctx, requestID interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMRequest", reflect.TypeOf((*MockVMRepository)(nil).GetVMRequest), ctx, requestID)
 }
+
+// GetVMRequestRetry mocks base method.
+func (m *MockVMRepository) GetVMRequestRetry(ctx context.Context, parentRequestID string) (*modals.VMRequest, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVMRequestRetry", ctx, parentRequestID)
+	ret0, _ := ret[0].(*modals.VMRequest)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// GetVMRequestRetry indicates an expected call of GetVMRequestRetry.
+func (mr *MockVMRepositoryMockRecorder) GetVMRequestRetry(ctx, parentRequestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMRequestRetry", reflect.TypeOf((*MockVMRepository)(nil).GetVMRequestRetry), ctx, parentRequestID)
+}
+
+// GetVMRequestsForVMs mocks base method.
+func (m *MockVMRepository) GetVMRequestsForVMs(ctx context.Context, vmIDs []string) ([]*modals.VMRequest, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVMRequestsForVMs", ctx, vmIDs)
+	ret0, _ := ret[0].([]*modals.VMRequest)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// GetVMRequestsForVMs indicates an expected call of GetVMRequestsForVMs.
+func (mr *MockVMRepositoryMockRecorder) GetVMRequestsForVMs(ctx, vmIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMRequestsForVMs", reflect.TypeOf((*MockVMRepository)(nil).GetVMRequestsForVMs), ctx, vmIDs)
+}
+
+// GetVirtualMachine mocks base method.
+func (m *MockVMRepository) GetVirtualMachine(ctx context.Context, vmID string) (*modals.VirtualMachine, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachine", ctx, vmID)
+	ret0, _ := ret[0].(*modals.VirtualMachine)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// GetVirtualMachine indicates an expected call of GetVirtualMachine.
+func (mr *MockVMRepositoryMockRecorder) GetVirtualMachine(ctx, vmID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachine", reflect.TypeOf((*MockVMRepository)(nil).GetVirtualMachine), ctx, vmID)
+}
+
+// IsVMRequestCancelRequested mocks base method.
+func (m *MockVMRepository) IsVMRequestCancelRequested(ctx context.Context, requestID string) (bool, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsVMRequestCancelRequested", ctx, requestID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// IsVMRequestCancelRequested indicates an expected call of IsVMRequestCancelRequested.
+func (mr *MockVMRepositoryMockRecorder) IsVMRequestCancelRequested(ctx, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVMRequestCancelRequested", reflect.TypeOf((*MockVMRepository)(nil).IsVMRequestCancelRequested), ctx, requestID)
+}
+
+// ListVirtualMachinesToSync mocks base method.
+func (m *MockVMRepository) ListVirtualMachinesToSync(ctx context.Context, syncedBefore time.Time, limit int) ([]*modals.VirtualMachine, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualMachinesToSync", ctx, syncedBefore, limit)
+	ret0, _ := ret[0].([]*modals.VirtualMachine)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// ListVirtualMachinesToSync indicates an expected call of ListVirtualMachinesToSync.
+func (mr *MockVMRepositoryMockRecorder) ListVirtualMachinesToSync(ctx, syncedBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesToSync", reflect.TypeOf((*MockVMRepository)(nil).ListVirtualMachinesToSync), ctx, syncedBefore, limit)
+}
+
+// MarkVirtualMachineDeleted mocks base method.
+func (m *MockVMRepository) MarkVirtualMachineDeleted(ctx context.Context, vmID string, deletedAt time.Time) *dto.ApiResponseError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkVirtualMachineDeleted", ctx, vmID, deletedAt)
+	ret0, _ := ret[0].(*dto.ApiResponseError)
+	return ret0
+}
+
+// MarkVirtualMachineDeleted indicates an expected call of MarkVirtualMachineDeleted.
+func (mr *MockVMRepositoryMockRecorder) MarkVirtualMachineDeleted(ctx, vmID, deletedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkVirtualMachineDeleted", reflect.TypeOf((*MockVMRepository)(nil).MarkVirtualMachineDeleted), ctx, vmID, deletedAt)
+}
+
+// RenewVMRequestClaims mocks base method.
+func (m *MockVMRepository) RenewVMRequestClaims(ctx context.Context, owner string, requestIDs []string) *dto.ApiResponseError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewVMRequestClaims", ctx, owner, requestIDs)
+	ret0, _ := ret[0].(*dto.ApiResponseError)
+	return ret0
+}
+
+// RenewVMRequestClaims indicates an expected call of RenewVMRequestClaims.
+func (mr *MockVMRepositoryMockRecorder) RenewVMRequestClaims(ctx, owner, requestIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewVMRequestClaims", reflect.TypeOf((*MockVMRepository)(nil).RenewVMRequestClaims), ctx, owner, requestIDs)
+}
+
+// ReserveIdempotencyKey mocks base method.
+func (m *MockVMRepository) ReserveIdempotencyKey(ctx context.Context, key *modals.IdempotencyKey) *dto.ApiResponseError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveIdempotencyKey", ctx, key)
+	ret0, _ := ret[0].(*dto.ApiResponseError)
+	return ret0
+}
+
+// ReserveIdempotencyKey indicates an expected call of ReserveIdempotencyKey.
+func (mr *MockVMRepositoryMockRecorder) ReserveIdempotencyKey(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveIdempotencyKey", reflect.TypeOf((*MockVMRepository)(nil).ReserveIdempotencyKey), ctx, key)
+}
+
+// SyncVirtualMachine mocks base method.
+func (m *MockVMRepository) SyncVirtualMachine(ctx context.Context, vm *modals.VirtualMachine) *dto.ApiResponseError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncVirtualMachine", ctx, vm)
+	ret0, _ := ret[0].(*dto.ApiResponseError)
+	return ret0
+}
+
+// SyncVirtualMachine indicates an expected call of SyncVirtualMachine.
+func (mr *MockVMRepositoryMockRecorder) SyncVirtualMachine(ctx, vm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncVirtualMachine", reflect.TypeOf((*MockVMRepository)(nil).SyncVirtualMachine), ctx, vm)
+}
+
+// UpdateVMDeployInstance mocks base method.
+func (m *MockVMRepository) UpdateVMDeployInstance(ctx context.Context, instance *modals.VMDeployInstance) *dto.ApiResponseError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVMDeployInstance", ctx, instance)
+	ret0, _ := ret[0].(*dto.ApiResponseError)
+	return ret0
+}
+
+// UpdateVMDeployInstance indicates an expected call of UpdateVMDeployInstance.
+func (mr *MockVMRepositoryMockRecorder) UpdateVMDeployInstance(ctx, instance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVMDeployInstance", reflect.TypeOf((*MockVMRepository)(nil).UpdateVMDeployInstance), ctx, instance)
+}
+
+// UpdateVMRequestStatus mocks base method.
+func (m *MockVMRepository) UpdateVMRequestStatus(ctx context.Context, requestID string, status constants.RequestStatus, completedAt *time.Time) *dto.ApiResponseError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVMRequestStatus", ctx, requestID, status, completedAt)
+	ret0, _ := ret[0].(*dto.ApiResponseError)
+	return ret0
+}
+
+// UpdateVMRequestStatus indicates an expected call of UpdateVMRequestStatus.
+func (mr *MockVMRepositoryMockRecorder) UpdateVMRequestStatus(ctx, requestID, status, completedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVMRequestStatus", reflect.TypeOf((*MockVMRepository)(nil).UpdateVMRequestStatus), ctx, requestID, status, completedAt)
+}
+
+// UpdateVirtualMachinePowerState mocks base method.
+func (m *MockVMRepository) UpdateVirtualMachinePowerState(ctx context.Context, vmID, powerState string) *dto.ApiResponseError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualMachinePowerState", ctx, vmID, powerState)
+	ret0, _ := ret[0].(*dto.ApiResponseError)
+	return ret0
+}
+
+// UpdateVirtualMachinePowerState indicates an expected call of UpdateVirtualMachinePowerState.
+func (mr *MockVMRepositoryMockRecorder) UpdateVirtualMachinePowerState(ctx, vmID, powerState interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachinePowerState", reflect.TypeOf((*MockVMRepository)(nil).UpdateVirtualMachinePowerState), ctx, vmID, powerState)
+}
+
+// UpsertVirtualMachine mocks base method.
+func (m *MockVMRepository) UpsertVirtualMachine(ctx context.Context, vm *modals.VirtualMachine) *dto.ApiResponseError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertVirtualMachine", ctx, vm)
+	ret0, _ := ret[0].(*dto.ApiResponseError)
+	return ret0
+}
+
+// UpsertVirtualMachine indicates an expected call of UpsertVirtualMachine.
+func (mr *MockVMRepositoryMockRecorder) UpsertVirtualMachine(ctx, vm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertVirtualMachine", reflect.TypeOf((*MockVMRepository)(nil).UpsertVirtualMachine), ctx, vm)
+}
+
+// WithTransaction mocks base method.
+func (m *MockVMRepository) WithTransaction(ctx context.Context, fn func(repo.VMRepository) *dto.ApiResponseError) *dto.ApiResponseError {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", ctx, fn)
+	ret0, _ := ret[0].(*dto.ApiResponseError)
+	return ret0
+}
+
+// WithTransaction indicates an expected call of WithTransaction.
+func (mr *MockVMRepositoryMockRecorder) WithTransaction(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockVMRepository)(nil).WithTransaction), ctx, fn)
+}
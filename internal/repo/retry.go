@@ -0,0 +1,81 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/pkg/constants"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateRetryVMRequest creates req as the retry of req.ParentRequestID. parent_request_id is
+// unique, so a request that was already retried is reported as a conflict and only one of
+// two racing retries wins.
+func (r *vmRepository) CreateRetryVMRequest(ctx context.Context, req *modals.VMRequest) *dto.ApiResponseError {
+	r.logger.Info(constants.MySql, constants.Insert, "CreateRetryVMRequest repository function invoked", nil)
+	if req.ParentRequestID == nil || *req.ParentRequestID == "" {
+		return &dto.ApiResponseError{ErrorCode: constants.InvalidRequestErrorCode, Message: "A retry must name the request it retries"}
+	}
+	if constants.RequestStatus(req.RequestStatus) != constants.StatusNew {
+		return &dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: fmt.Sprintf("VMRequest must be created with status %q", constants.StatusNew)}
+	}
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	req.WorkspaceId = workspaceID
+	db := r.db.GetWriter().WithContext(ctx)
+
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(req)
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Insert, "Failed to create retry VMRequest", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return databaseError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		// Either unique index can reject the retry; the VM lock is only to blame when the
+		// parent has not been retried.
+		if req.LockedVMID != nil {
+			var retried int64
+			if err := db.Model(&modals.VMRequest{}).Where("parent_request_id = ?", *req.ParentRequestID).Count(&retried).Error; err == nil && retried == 0 {
+				return vmLockConflict(db, *req.LockedVMID, workspaceID)
+			}
+		}
+		return &dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: fmt.Sprintf("VMRequest %s was already retried", *req.ParentRequestID)}
+	}
+
+	r.logger.Info(constants.MySql, constants.Insert, "Retry VMRequest created successfully", map[constants.ExtraKey]interface{}{
+		"requestID":       req.RequestID,
+		"parentRequestID": *req.ParentRequestID,
+	})
+	return nil
+}
+
+// GetVMRequestRetry retrieves the request created by retrying parentRequestID.
+func (r *vmRepository) GetVMRequestRetry(ctx context.Context, parentRequestID string) (*modals.VMRequest, *dto.ApiResponseError) {
+	r.logger.Info(constants.MySql, constants.Select, "GetVMRequestRetry repository function invoked", nil)
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	db := r.reader(ctx)
+
+	var req modals.VMRequest
+	result := db.WithContext(ctx).Where("parent_request_id = ? AND workspace_id = ?", parentRequestID, workspaceID).First(&req)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "VMRequest was not retried"}
+		}
+		r.logger.Error(constants.MySql, constants.Select, "Failed to get retry VMRequest", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return nil, databaseError(result.Error)
+	}
+
+	return &req, nil
+}
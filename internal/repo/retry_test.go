@@ -0,0 +1,106 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"vm/internal/modals"
+	"vm/internal/repo"
+	"vm/pkg/constants"
+	mock_db "vm/pkg/db/mock"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+func TestRetryVMRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mock_db.NewMockDatabase(ctrl)
+	mockLogger := &mock_logger.StubLogger{}
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	newRetry := func() *modals.VMRequest {
+		parentID := "req-100"
+		return &modals.VMRequest{
+			Operation:       string(constants.VMDeploy),
+			RequestStatus:   string(constants.StatusNew),
+			RequestMetadata: `{"key":"value"}`,
+			ParentRequestID: &parentID,
+		}
+	}
+
+	t.Run("Create links the retry to its parent", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `vm_requests` .* ON DUPLICATE KEY UPDATE").
+			WithArgs(sqlmock.AnyArg(), "vmDeploy", "New", "workspace-001", "", sqlmock.AnyArg(), nil, `{"key":"value"}`, false, "req-100", nil, "", "", nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		req := newRetry()
+		err := repo.NewVMRepository(mockDB, mockLogger).CreateRetryVMRequest(ctx, req)
+
+		assert.Nil(t, err)
+		assert.NotEmpty(t, req.RequestID)
+		assert.Equal(t, "workspace-001", req.WorkspaceId)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Create for an already retried parent is a conflict", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `vm_requests`").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := repo.NewVMRepository(mockDB, mockLogger).CreateRetryVMRequest(ctx, newRetry())
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Create without a parent is rejected", func(t *testing.T) {
+		req := newRetry()
+		req.ParentRequestID = nil
+		err := repo.NewVMRepository(mockDB, mockLogger).CreateRetryVMRequest(ctx, req)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InvalidRequestErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Get returns the retry of a request", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetReader().Return(gormDB)
+
+		mock.ExpectQuery("SELECT \\* FROM `vm_requests` WHERE parent_request_id = \\? AND workspace_id = \\?").
+			WithArgs("req-100", "workspace-001", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"request_id", "parent_request_id"}).AddRow("req-200", "req-100"))
+
+		req, err := repo.NewVMRepository(mockDB, mockLogger).GetVMRequestRetry(ctx, "req-100")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "req-200", req.RequestID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Get of a request that was not retried", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetReader().Return(gormDB)
+
+		mock.ExpectQuery("SELECT \\* FROM `vm_requests`").
+			WillReturnRows(sqlmock.NewRows([]string{"request_id"}))
+
+		_, err := repo.NewVMRepository(mockDB, mockLogger).GetVMRequestRetry(ctx, "req-100")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.SQLRecordNotFoundErrorCode, err.ErrorCode)
+	})
+}
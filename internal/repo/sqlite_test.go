@@ -0,0 +1,166 @@
+package repo_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/internal/repo"
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/constants"
+	"vm/pkg/db"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+// newSQLiteRepository returns a repository over a migrated SQLite database of its own.
+func newSQLiteRepository(t *testing.T) repo.VMRepository {
+	logger := &mock_logger.StubLogger{}
+	database := db.NewDatabase(logger)
+	_, err := database.InitDB(configmanager.ApplicationConfig{Database: configmanager.Database{
+		Driver: db.DriverSQLite,
+		DBName: filepath.Join(t.TempDir(), "vm.db"),
+	}})
+	require.NoError(t, err)
+	require.NoError(t, db.NewMigrateAllTables(database, logger).MigrateAllTables())
+	return repo.NewVMRepository(database, logger)
+}
+
+func TestVMRepository_SQLite(t *testing.T) {
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	t.Run("Deploy request and instances are stored together", func(t *testing.T) {
+		vmRepo := newSQLiteRepository(t)
+
+		req := &modals.VMRequest{Operation: string(constants.VMDeploy), RequestStatus: string(constants.StatusNew), RequestMetadata: `{}`}
+		err := vmRepo.WithTransaction(ctx, func(tx repo.VMRepository) *dto.ApiResponseError {
+			if err := tx.CreateVMRequest(ctx, req); err != nil {
+				return err
+			}
+			return tx.CreateVMDeployInstances(ctx, []modals.VMDeployInstance{
+				{RequestID: req.RequestID, VMName: "web_1", VMStatus: string(constants.StatusNew)},
+				{RequestID: req.RequestID, VMName: "web_2", VMStatus: string(constants.StatusNew)},
+			})
+		})
+		require.Nil(t, err)
+
+		stored, err := vmRepo.GetVMRequest(ctx, req.RequestID)
+		require.Nil(t, err)
+		assert.Equal(t, "workspace-001", stored.WorkspaceId)
+		instances, err := vmRepo.GetVMDeployInstances(ctx, req.RequestID)
+		require.Nil(t, err)
+		assert.Len(t, instances, 2)
+	})
+
+	t.Run("Failed instance insert leaves no request behind", func(t *testing.T) {
+		vmRepo := newSQLiteRepository(t)
+
+		req := &modals.VMRequest{Operation: string(constants.VMDeploy), RequestStatus: string(constants.StatusNew)}
+		err := vmRepo.WithTransaction(ctx, func(tx repo.VMRepository) *dto.ApiResponseError {
+			if err := tx.CreateVMRequest(ctx, req); err != nil {
+				return err
+			}
+			// The same name twice breaks the primary key.
+			return tx.CreateVMDeployInstances(ctx, []modals.VMDeployInstance{
+				{RequestID: req.RequestID, VMName: "web_1", VMStatus: string(constants.StatusNew)},
+				{RequestID: req.RequestID, VMName: "web_1", VMStatus: string(constants.StatusNew)},
+			})
+		})
+		require.NotNil(t, err)
+
+		_, err = vmRepo.GetVMRequest(ctx, req.RequestID)
+		require.NotNil(t, err)
+		assert.Equal(t, constants.SQLRecordNotFoundErrorCode, err.ErrorCode)
+	})
+
+	t.Run("VM lock is held until the request finishes", func(t *testing.T) {
+		vmRepo := newSQLiteRepository(t)
+		powerOff := func() *modals.VMRequest {
+			vmID := "vm-001"
+			return &modals.VMRequest{Operation: string(constants.VMPowerOff), RequestStatus: string(constants.StatusNew), LockedVMID: &vmID}
+		}
+
+		first := powerOff()
+		require.Nil(t, vmRepo.CreateVMRequest(ctx, first))
+
+		err := vmRepo.CreateVMRequest(ctx, powerOff())
+		require.NotNil(t, err)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+		assert.Equal(t, "VM vm-001 is locked by request "+first.RequestID+" (vmPowerOff), which is New", err.Message)
+
+		completedAt := time.Now().UTC()
+		require.Nil(t, vmRepo.UpdateVMRequestStatus(ctx, first.RequestID, constants.StatusInProgress, nil))
+		require.Nil(t, vmRepo.UpdateVMRequestStatus(ctx, first.RequestID, constants.StatusSuccess, &completedAt))
+
+		assert.Nil(t, vmRepo.CreateVMRequest(ctx, powerOff()))
+	})
+
+	t.Run("Request whose claim expired is failed and releases its VM", func(t *testing.T) {
+		vmRepo := newSQLiteRepository(t)
+		vmID := "vm-001"
+		req := &modals.VMRequest{Operation: string(constants.VMPowerOff), RequestStatus: string(constants.StatusNew), LockedVMID: &vmID}
+		require.Nil(t, vmRepo.CreateVMRequest(ctx, req))
+
+		claimed, err := vmRepo.ClaimNewVMRequests(ctx, "executor-1", 10)
+		require.Nil(t, err)
+		require.Len(t, claimed, 1)
+
+		// A claim renewed after the cutoff is left alone.
+		cutoff := time.Now().UTC()
+		require.Nil(t, vmRepo.RenewVMRequestClaims(ctx, "executor-1", []string{req.RequestID}))
+		failed, err := vmRepo.FailExpiredVMRequestClaims(ctx, cutoff, 10)
+		require.Nil(t, err)
+		assert.Empty(t, failed)
+
+		failed, err = vmRepo.FailExpiredVMRequestClaims(ctx, time.Now().UTC().Add(time.Second), 10)
+		require.Nil(t, err)
+		require.Len(t, failed, 1)
+		assert.Equal(t, "executor-1", failed[0].ClaimedBy)
+
+		stored, err := vmRepo.GetVMRequest(ctx, req.RequestID)
+		require.Nil(t, err)
+		assert.Equal(t, string(constants.StatusFailure), stored.RequestStatus)
+		assert.NotNil(t, stored.CompletedAt)
+		assert.Nil(t, vmRepo.CreateVMRequest(ctx, &modals.VMRequest{Operation: string(constants.VMPowerOn), RequestStatus: string(constants.StatusNew), LockedVMID: &vmID}))
+	})
+
+	t.Run("VMs never synced are listed first", func(t *testing.T) {
+		vmRepo := newSQLiteRepository(t)
+
+		for _, vmID := range []string{"vm-001", "vm-002"} {
+			require.Nil(t, vmRepo.UpsertVirtualMachine(ctx, &modals.VirtualMachine{VMID: vmID}))
+		}
+		syncedAt := time.Now().UTC().Add(-time.Hour)
+		require.Nil(t, vmRepo.SyncVirtualMachine(ctx, &modals.VirtualMachine{VMID: "vm-001", SyncedAt: &syncedAt}))
+
+		vms, err := vmRepo.ListVirtualMachinesToSync(ctx, time.Now().UTC(), 10)
+		require.Nil(t, err)
+		require.Len(t, vms, 2)
+		assert.Equal(t, "vm-002", vms[0].VMID)
+		assert.Equal(t, "vm-001", vms[1].VMID)
+	})
+
+	t.Run("Abandoned and expired idempotency keys can be reserved again", func(t *testing.T) {
+		vmRepo := newSQLiteRepository(t)
+		key := func(name string, createdAt time.Time) *modals.IdempotencyKey {
+			return &modals.IdempotencyKey{IdempotencyKey: name, Operation: string(constants.VMPowerOn), Fingerprint: "fp-1", CreatedAt: createdAt}
+		}
+		now := time.Now().UTC()
+		require.Nil(t, vmRepo.ReserveIdempotencyKey(ctx, key("abandoned", now.Add(-2*repo.IdempotencyReservationTTL))))
+		require.Nil(t, vmRepo.ReserveIdempotencyKey(ctx, key("expired", now.Add(-2*repo.IdempotencyKeyRetention))))
+		require.Nil(t, vmRepo.CompleteIdempotencyKey(ctx, "expired", "req-001"))
+		require.Nil(t, vmRepo.ReserveIdempotencyKey(ctx, key("pending", now)))
+
+		assert.Nil(t, vmRepo.ReserveIdempotencyKey(ctx, key("abandoned", now)))
+		assert.Nil(t, vmRepo.ReserveIdempotencyKey(ctx, key("expired", now)))
+		err := vmRepo.ReserveIdempotencyKey(ctx, key("pending", now))
+		require.NotNil(t, err)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+	})
+}
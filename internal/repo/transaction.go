@@ -0,0 +1,60 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	dto "vm/internal/dtos"
+	"vm/pkg/constants"
+	"vm/pkg/db"
+
+	"gorm.io/gorm"
+)
+
+// errRollback makes gorm roll back a transaction whose work returned an ApiResponseError.
+var errRollback = errors.New("transaction rolled back")
+
+// txDatabase serves one transaction in place of the connection pool.
+type txDatabase struct {
+	db.Database
+	tx *gorm.DB
+}
+
+func (d txDatabase) GetWriter() *gorm.DB {
+	return d.tx
+}
+
+// GetReader also returns the transaction: a replica would not see its uncommitted writes.
+func (d txDatabase) GetReader() *gorm.DB {
+	return d.tx
+}
+
+// WithTransaction runs fn against a VMRepository bound to a single transaction. The
+// transaction commits when fn returns nil and rolls back when it returns an error or panics,
+// so the writes fn makes land together or not at all. Calling WithTransaction on the
+// repository passed to fn nests a savepoint.
+func (r *vmRepository) WithTransaction(ctx context.Context, fn func(tx VMRepository) *dto.ApiResponseError) *dto.ApiResponseError {
+	writer := r.db.GetWriter()
+	if writer.Error != nil {
+		// gorm begins the transaction before looking at the error, so do not let it.
+		return databaseError(writer.Error)
+	}
+
+	var apiErr *dto.ApiResponseError
+	err := writer.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		apiErr = fn(&vmRepository{db: txDatabase{Database: r.db, tx: tx}, logger: r.logger})
+		if apiErr != nil {
+			return errRollback
+		}
+		return nil
+	})
+	if apiErr != nil {
+		return apiErr
+	}
+	if err != nil {
+		r.logger.Error(constants.MySql, constants.Update, "Failed to commit transaction", map[constants.ExtraKey]interface{}{
+			"error": err.Error(),
+		})
+		return databaseError(err)
+	}
+	return nil
+}
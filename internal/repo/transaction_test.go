@@ -0,0 +1,134 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/internal/repo"
+	"vm/pkg/constants"
+	"vm/pkg/db"
+	mock_db "vm/pkg/db/mock"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+func TestWithTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := &mock_logger.StubLogger{}
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	setup := func(t *testing.T) (*mock_db.MockDatabase, sqlmock.Sqlmock) {
+		sqlDB, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		t.Cleanup(func() { sqlDB.Close() })
+
+		gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+		mockDB := mock_db.NewMockDatabase(ctrl)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+		return mockDB, mock
+	}
+	createDeploy := func(tx repo.VMRepository) *dto.ApiResponseError {
+		req := &modals.VMRequest{RequestID: "req-001", Operation: string(constants.VMDeploy), RequestStatus: string(constants.StatusNew)}
+		if err := tx.CreateVMRequest(ctx, req); err != nil {
+			return err
+		}
+		return tx.CreateVMDeployInstances(ctx, []modals.VMDeployInstance{
+			{RequestID: req.RequestID, VMName: "web_1", VMStatus: string(constants.StatusNew)},
+		})
+	}
+
+	t.Run("Request and instances commit together", func(t *testing.T) {
+		mockDB, mock := setup(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `vm_requests`").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_requests`").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectExec("INSERT INTO `vm_deploy_instances`").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		err := repo.NewVMRepository(mockDB, mockLogger).WithTransaction(ctx, createDeploy)
+
+		assert.Nil(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failed instance insert rolls back the request", func(t *testing.T) {
+		mockDB, mock := setup(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `vm_requests`").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_requests`").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectExec("INSERT INTO `vm_deploy_instances`").WillReturnError(assert.AnError)
+		mock.ExpectRollback()
+
+		err := repo.NewVMRepository(mockDB, mockLogger).WithTransaction(ctx, createDeploy)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Error from the work rolls back with it as is", func(t *testing.T) {
+		mockDB, mock := setup(t)
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		err := repo.NewVMRepository(mockDB, mockLogger).WithTransaction(ctx, func(repo.VMRepository) *dto.ApiResponseError {
+			return &dto.ApiResponseError{ErrorCode: constants.InvalidRequestErrorCode, Message: "bad payload"}
+		})
+
+		assert.Equal(t, &dto.ApiResponseError{ErrorCode: constants.InvalidRequestErrorCode, Message: "bad payload"}, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Commit failure is an internal error", func(t *testing.T) {
+		mockDB, mock := setup(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit().WillReturnError(assert.AnError)
+
+		err := repo.NewVMRepository(mockDB, mockLogger).WithTransaction(ctx, func(repo.VMRepository) *dto.ApiResponseError {
+			return nil
+		})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Database down fails at once with 503", func(t *testing.T) {
+		sqlDB, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer sqlDB.Close()
+
+		gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+		down := gormDB.Session(&gorm.Session{NewDB: true})
+		_ = down.AddError(db.ErrUnavailable)
+		mockDB := mock_db.NewMockDatabase(ctrl)
+		mockDB.EXPECT().GetWriter().Return(down).Times(2)
+		vmRepo := repo.NewVMRepository(mockDB, mockLogger)
+
+		txErr := vmRepo.WithTransaction(ctx, createDeploy)
+		_, getErr := vmRepo.GetVMRequest(db.WithPrimary(ctx), "req-001")
+
+		assert.Equal(t, constants.ServiceUnavailableErrorCode, txErr.ErrorCode)
+		assert.Equal(t, constants.ServiceUnavailableErrorCode, getErr.ErrorCode)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
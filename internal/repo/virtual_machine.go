@@ -0,0 +1,97 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/pkg/constants"
+
+	"gorm.io/gorm"
+)
+
+// deployedVMs selects the deploy instances of workspaceID that produced a VM.
+func deployedVMs(db *gorm.DB, workspaceID string) *gorm.DB {
+	return db.Model(&modals.VMDeployInstance{}).
+		Where("vm_status = ? AND vm_id <> '' AND request_id IN (?)", constants.StatusSuccess, requestsInWorkspace(db, workspaceID))
+}
+
+// GetDeployedVMs returns one page of the VMs deployed in the caller's workspace, newest first,
+// together with the total number of deployed VMs.
+func (r *vmRepository) GetDeployedVMs(ctx context.Context, limit, offset int) ([]*modals.VMDeployInstance, int64, *dto.ApiResponseError) {
+	r.logger.Info(constants.MySql, constants.Select, "GetDeployedVMs repository function invoked", map[constants.ExtraKey]interface{}{
+		"limit":  limit,
+		"offset": offset,
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return nil, 0, apiErr
+	}
+	db := r.reader(ctx).WithContext(ctx)
+
+	var total int64
+	if err := deployedVMs(db, workspaceID).Count(&total).Error; err != nil {
+		return nil, 0, databaseError(err)
+	}
+
+	instances := []*modals.VMDeployInstance{}
+	if err := deployedVMs(db, workspaceID).Order("completed_at DESC, vm_id").Limit(limit).Offset(offset).Find(&instances).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, 0, databaseError(err)
+		}
+	}
+
+	return instances, total, nil
+}
+
+// GetDeployedVM returns the deploy instance that produced vmID in the caller's workspace.
+func (r *vmRepository) GetDeployedVM(ctx context.Context, vmID string) (*modals.VMDeployInstance, *dto.ApiResponseError) {
+	r.logger.Info(constants.MySql, constants.Select, "GetDeployedVM repository function invoked", map[constants.ExtraKey]interface{}{
+		"vmID": vmID,
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	db := r.reader(ctx).WithContext(ctx)
+
+	var instance modals.VMDeployInstance
+	result := deployedVMs(db, workspaceID).Where("vm_id = ?", vmID).First(&instance)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "VM not found"}
+		}
+		r.logger.Error(constants.MySql, constants.Select, "Failed to get deployed VM", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return nil, databaseError(result.Error)
+	}
+
+	return &instance, nil
+}
+
+// GetVMRequestsForVMs returns the caller's requests that target one of vmIDs, newest first.
+func (r *vmRepository) GetVMRequestsForVMs(ctx context.Context, vmIDs []string) ([]*modals.VMRequest, *dto.ApiResponseError) {
+	r.logger.Info(constants.MySql, constants.Select, "GetVMRequestsForVMs repository function invoked", map[constants.ExtraKey]interface{}{
+		"count": len(vmIDs),
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	requests := []*modals.VMRequest{}
+	if len(vmIDs) == 0 {
+		return requests, nil
+	}
+	db := r.reader(ctx).WithContext(ctx)
+
+	err := db.Where("workspace_id = ? AND vm_id IN ?", workspaceID, vmIDs).Order("created_at DESC, request_id DESC").Find(&requests).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		r.logger.Error(constants.MySql, constants.Select, "Failed to get VM requests for VMs", map[constants.ExtraKey]interface{}{
+			"error": err.Error(),
+		})
+		return nil, databaseError(err)
+	}
+
+	return requests, nil
+}
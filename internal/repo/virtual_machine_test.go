@@ -0,0 +1,86 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"vm/internal/repo"
+	"vm/pkg/constants"
+	mock_db "vm/pkg/db/mock"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+func TestDeployedVMs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mock_db.NewMockDatabase(ctrl)
+	mockLogger := &mock_logger.StubLogger{}
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	instanceColumns := []string{"request_id", "vm_name", "vm_id", "vm_status", "completed_at"}
+
+	t.Run("List pages through the workspace's deployed VMs", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetReader().Return(gormDB)
+
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_deploy_instances` WHERE vm_status = \\? AND vm_id <> '' AND request_id IN \\(SELECT `request_id` FROM `vm_requests` WHERE workspace_id = \\?\\)").
+			WithArgs("Success", "workspace-001").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		mock.ExpectQuery("SELECT \\* FROM `vm_deploy_instances` WHERE .* ORDER BY completed_at DESC, vm_id LIMIT \\? OFFSET \\?").
+			WithArgs("Success", "workspace-001", 2, 1).
+			WillReturnRows(sqlmock.NewRows(instanceColumns).
+				AddRow("req-1", "web_2", "vm-2", "Success", time.Now()).
+				AddRow("req-1", "web_1", "vm-1", "Success", time.Now()))
+
+		instances, total, err := repo.NewVMRepository(mockDB, mockLogger).GetDeployedVMs(ctx, 2, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), total)
+		assert.Len(t, instances, 2)
+		assert.Equal(t, "vm-2", instances[0].VMID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Get of a VM deployed in another workspace is not found", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetReader().Return(gormDB)
+
+		mock.ExpectQuery("SELECT \\* FROM `vm_deploy_instances` WHERE .* AND vm_id = \\?").
+			WithArgs("Success", "workspace-001", "vm-1", 1).
+			WillReturnRows(sqlmock.NewRows(instanceColumns))
+
+		_, err := repo.NewVMRepository(mockDB, mockLogger).GetDeployedVM(ctx, "vm-1")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.SQLRecordNotFoundErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Requests are looked up by the VM they target", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetReader().Return(gormDB)
+
+		mock.ExpectQuery("SELECT \\* FROM `vm_requests` WHERE workspace_id = \\? AND vm_id IN \\(\\?,\\?\\) ORDER BY created_at DESC, request_id DESC").
+			WithArgs("workspace-001", "vm-1", "vm-2").
+			WillReturnRows(sqlmock.NewRows([]string{"request_id", "vm_id"}).AddRow("req-2", "vm-1"))
+
+		requests, err := repo.NewVMRepository(mockDB, mockLogger).GetVMRequestsForVMs(ctx, []string{"vm-1", "vm-2"})
+
+		assert.Nil(t, err)
+		assert.Len(t, requests, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("No VMs means no query", func(t *testing.T) {
+		requests, err := repo.NewVMRepository(mockDB, mockLogger).GetVMRequestsForVMs(ctx, nil)
+
+		assert.Nil(t, err)
+		assert.Empty(t, requests)
+	})
+}
@@ -0,0 +1,29 @@
+package repo
+
+import (
+	"fmt"
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/pkg/constants"
+
+	"gorm.io/gorm"
+)
+
+// vmLockConflict builds the error returned when another request of workspaceID holds the
+// operation lock of vmID.
+func vmLockConflict(db *gorm.DB, vmID, workspaceID string) *dto.ApiResponseError {
+	var holder modals.VMRequest
+	err := db.Select("request_id", "operation", "request_status").
+		Where("locked_vm_id = ? AND workspace_id = ?", vmID, workspaceID).
+		First(&holder).Error
+	if err != nil {
+		return &dto.ApiResponseError{
+			ErrorCode: constants.LoadStatusConflictErrorCode,
+			Message:   fmt.Sprintf("VM %s has another operation in progress", vmID),
+		}
+	}
+	return &dto.ApiResponseError{
+		ErrorCode: constants.LoadStatusConflictErrorCode,
+		Message:   fmt.Sprintf("VM %s is locked by request %s (%s), which is %s", vmID, holder.RequestID, holder.Operation, holder.RequestStatus),
+	}
+}
@@ -0,0 +1,113 @@
+package repo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"vm/internal/modals"
+	"vm/internal/repo"
+	"vm/pkg/constants"
+	mock_db "vm/pkg/db/mock"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+func TestVMOperationLock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mock_db.NewMockDatabase(ctrl)
+	mockLogger := &mock_logger.StubLogger{}
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	newPowerOff := func() *modals.VMRequest {
+		vmID := "vm-001"
+		return &modals.VMRequest{
+			Operation:       string(constants.VMPowerOff),
+			RequestStatus:   string(constants.StatusNew),
+			RequestMetadata: `{"VMID":"vm-001"}`,
+			VMID:            vmID,
+			LockedVMID:      &vmID,
+		}
+	}
+
+	t.Run("Create takes the lock of an idle VM", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `vm_requests` .* ON DUPLICATE KEY UPDATE").
+			WithArgs(sqlmock.AnyArg(), "vmPowerOff", "New", "workspace-001", "", sqlmock.AnyArg(), nil, `{"VMID":"vm-001"}`, false, nil, "vm-001", "vm-001", "", nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.NewVMRepository(mockDB, mockLogger).CreateVMRequest(ctx, newPowerOff())
+
+		assert.Nil(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Create for a locked VM names the holder", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `vm_requests`").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		mock.ExpectQuery("SELECT `request_id`,`operation`,`request_status` FROM `vm_requests` WHERE locked_vm_id = \\? AND workspace_id = \\?").
+			WithArgs("vm-001", "workspace-001", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"request_id", "operation", "request_status"}).AddRow("req-001", "vmReconfigure", "Inprogress"))
+
+		err := repo.NewVMRepository(mockDB, mockLogger).CreateVMRequest(ctx, newPowerOff())
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+		assert.Equal(t, "VM vm-001 is locked by request req-001 (vmReconfigure), which is Inprogress", err.Message)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Create for a VM locked in another workspace", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `vm_requests`").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		mock.ExpectQuery("SELECT .* FROM `vm_requests` WHERE locked_vm_id = \\?").WillReturnError(gorm.ErrRecordNotFound)
+
+		err := repo.NewVMRepository(mockDB, mockLogger).CreateVMRequest(ctx, newPowerOff())
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+		assert.Equal(t, "VM vm-001 has another operation in progress", err.Message)
+	})
+
+	t.Run("Retry of a request whose VM is locked", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		req := newPowerOff()
+		parentID := "req-100"
+		req.ParentRequestID = &parentID
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `vm_requests`").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_requests` WHERE parent_request_id = \\?").
+			WithArgs("req-100").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery("SELECT .* FROM `vm_requests` WHERE locked_vm_id = \\? AND workspace_id = \\?").
+			WillReturnRows(sqlmock.NewRows([]string{"request_id", "operation", "request_status"}).AddRow("req-001", "vmPowerOn", "New"))
+
+		err := repo.NewVMRepository(mockDB, mockLogger).CreateRetryVMRequest(ctx, req)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "VM vm-001 is locked by request req-001 (vmPowerOn), which is New", err.Message)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
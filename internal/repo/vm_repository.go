@@ -3,13 +3,17 @@ package repo
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 	dto "vm/internal/dtos"
 	"vm/internal/modals"
 	"vm/pkg/cinterface"
 	"vm/pkg/constants"
 	"vm/pkg/db"
+	"vm/pkg/utils"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 //go:generate mockgen -source=vm_repository.go -destination=mock/vm_repositoryMock.go
@@ -18,7 +22,30 @@ type VMRepository interface {
 	GetVMRequest(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError)
 	GetVMDeployInstances(ctx context.Context, requestID string) ([]*modals.VMDeployInstance, *dto.ApiResponseError)
 	CreateVMDeployInstances(ctx context.Context, instances []modals.VMDeployInstance) *dto.ApiResponseError
-	GetAllVMRequestsWithInstances(ctx context.Context) ([]*modals.VMRequest, []*modals.VMDeployInstance, *dto.ApiResponseError)
+	GetAllVMRequestsWithInstances(ctx context.Context, filter dto.VMRequestFilter) ([]*modals.VMRequest, []*modals.VMDeployInstance, int64, int64, *dto.ApiResponseError)
+	ClaimNewVMRequests(ctx context.Context, owner string, limit int) ([]*modals.VMRequest, *dto.ApiResponseError)
+	RenewVMRequestClaims(ctx context.Context, owner string, requestIDs []string) *dto.ApiResponseError
+	FailExpiredVMRequestClaims(ctx context.Context, claimedBefore time.Time, limit int) ([]*modals.VMRequest, *dto.ApiResponseError)
+	UpdateVMRequestStatus(ctx context.Context, requestID string, status constants.RequestStatus, completedAt *time.Time) *dto.ApiResponseError
+	UpdateVMDeployInstance(ctx context.Context, instance *modals.VMDeployInstance) *dto.ApiResponseError
+	CancelVMRequest(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError)
+	IsVMRequestCancelRequested(ctx context.Context, requestID string) (bool, *dto.ApiResponseError)
+	CancelPendingVMDeployInstances(ctx context.Context, requestID string, completedAt time.Time) *dto.ApiResponseError
+	CreateRetryVMRequest(ctx context.Context, req *modals.VMRequest) *dto.ApiResponseError
+	GetVMRequestRetry(ctx context.Context, parentRequestID string) (*modals.VMRequest, *dto.ApiResponseError)
+	GetDeployedVMs(ctx context.Context, limit, offset int) ([]*modals.VMDeployInstance, int64, *dto.ApiResponseError)
+	GetDeployedVM(ctx context.Context, vmID string) (*modals.VMDeployInstance, *dto.ApiResponseError)
+	GetVMRequestsForVMs(ctx context.Context, vmIDs []string) ([]*modals.VMRequest, *dto.ApiResponseError)
+	UpsertVirtualMachine(ctx context.Context, vm *modals.VirtualMachine) *dto.ApiResponseError
+	GetVirtualMachine(ctx context.Context, vmID string) (*modals.VirtualMachine, *dto.ApiResponseError)
+	MarkVirtualMachineDeleted(ctx context.Context, vmID string, deletedAt time.Time) *dto.ApiResponseError
+	UpdateVirtualMachinePowerState(ctx context.Context, vmID, powerState string) *dto.ApiResponseError
+	ListVirtualMachinesToSync(ctx context.Context, syncedBefore time.Time, limit int) ([]*modals.VirtualMachine, *dto.ApiResponseError)
+	SyncVirtualMachine(ctx context.Context, vm *modals.VirtualMachine) *dto.ApiResponseError
+	ReserveIdempotencyKey(ctx context.Context, key *modals.IdempotencyKey) *dto.ApiResponseError
+	GetIdempotencyKey(ctx context.Context, key string) (*modals.IdempotencyKey, *dto.ApiResponseError)
+	CompleteIdempotencyKey(ctx context.Context, key, requestID string) *dto.ApiResponseError
+	WithTransaction(ctx context.Context, fn func(tx VMRepository) *dto.ApiResponseError) *dto.ApiResponseError
 }
 
 // vmRepository implements the VMRepository interface.
@@ -35,17 +62,42 @@ func NewVMRepository(db db.Database, logger cinterface.Logger) VMRepository {
 	}
 }
 
+// reader returns the database list and detail queries run against: a read replica, or the
+// primary when ctx needs to see its own writes.
+func (r *vmRepository) reader(ctx context.Context) *gorm.DB {
+	if db.UsesPrimary(ctx) {
+		return r.db.GetWriter()
+	}
+	return r.db.GetReader()
+}
+
 // CreateVMRequest creates a new VMRequest record in the database.
 func (r *vmRepository) CreateVMRequest(ctx context.Context, req *modals.VMRequest) *dto.ApiResponseError {
 	r.logger.Info(constants.MySql, constants.Insert, "CreateVMRequest repository function invoked", nil)
-	db := r.db.GetReader()
+	if constants.RequestStatus(req.RequestStatus) != constants.StatusNew {
+		return &dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: fmt.Sprintf("VMRequest must be created with status %q", constants.StatusNew)}
+	}
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	req.WorkspaceId = workspaceID
+	db := r.db.GetWriter().WithContext(ctx)
 
-	result := db.WithContext(ctx).Create(req)
+	query := db
+	if req.LockedVMID != nil {
+		// locked_vm_id is unique, so a request for a VM that is already locked inserts nothing.
+		query = db.Clauses(clause.OnConflict{DoNothing: true})
+	}
+	result := query.Create(req)
 	if result.Error != nil {
 		r.logger.Error(constants.MySql, constants.Insert, "Failed to create VMRequest", map[constants.ExtraKey]interface{}{
 			"error": result.Error.Error(),
 		})
-		return &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: result.Error.Error()}
+		return databaseError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return vmLockConflict(db, *req.LockedVMID, workspaceID)
 	}
 
 	r.logger.Info(constants.MySql, constants.Insert, "VMRequest created successfully", map[constants.ExtraKey]interface{}{
@@ -58,10 +110,15 @@ func (r *vmRepository) CreateVMRequest(ctx context.Context, req *modals.VMReques
 // GetVMRequest retrieves a VMRequest record from the database by its ID.
 func (r *vmRepository) GetVMRequest(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError) {
 	r.logger.Info(constants.MySql, constants.Select, "GetVMRequest repository function invoked", nil)
-	db := r.db.GetReader()
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	db := r.reader(ctx)
 
+	// A request owned by another workspace is reported as not found.
 	var req modals.VMRequest
-	result := db.WithContext(ctx).Where("request_id = ?", requestID).First(&req)
+	result := db.WithContext(ctx).Where("request_id = ? AND workspace_id = ?", requestID, workspaceID).First(&req)
 	if result.Error != nil {
 		r.logger.Error(constants.MySql, constants.Select, "Failed to get VMRequest", map[constants.ExtraKey]interface{}{
 			"error": result.Error.Error(),
@@ -69,7 +126,7 @@ func (r *vmRepository) GetVMRequest(ctx context.Context, requestID string) (*mod
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "VMRequest not found"}
 		}
-		return nil, &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: result.Error.Error()}
+		return nil, databaseError(result.Error)
 	}
 
 	r.logger.Info(constants.MySql, constants.Select, "VMRequest retrieved successfully", map[constants.ExtraKey]interface{}{
@@ -82,10 +139,14 @@ func (r *vmRepository) GetVMRequest(ctx context.Context, requestID string) (*mod
 // GetVMDeployInstances retrieves all VMDeployInstance records from the database by request ID.
 func (r *vmRepository) GetVMDeployInstances(ctx context.Context, requestID string) ([]*modals.VMDeployInstance, *dto.ApiResponseError) {
 	r.logger.Info(constants.MySql, constants.Select, "GetVMDeployInstances repository function invoked", nil)
-	db := r.db.GetReader()
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	db := r.reader(ctx).WithContext(ctx)
 
 	var instances []*modals.VMDeployInstance
-	result := db.WithContext(ctx).Where("request_id = ?", requestID).Find(&instances)
+	result := db.Where("request_id = ? AND request_id IN (?)", requestID, requestsInWorkspace(db, workspaceID)).Find(&instances)
 	if result.Error != nil {
 		r.logger.Error(constants.MySql, constants.Select, "Failed to get VMDeployInstances", map[constants.ExtraKey]interface{}{
 			"error": result.Error.Error(),
@@ -93,7 +154,7 @@ func (r *vmRepository) GetVMDeployInstances(ctx context.Context, requestID strin
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "VMDeployInstances not found"}
 		}
-		return nil, &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: result.Error.Error()}
+		return nil, databaseError(result.Error)
 	}
 
 	r.logger.Info(constants.MySql, constants.Select, "VMDeployInstances retrieved successfully", map[constants.ExtraKey]interface{}{
@@ -104,19 +165,54 @@ func (r *vmRepository) GetVMDeployInstances(ctx context.Context, requestID strin
 	return instances, nil
 }
 
+// CreateVMDeployInstances creates deploy instances for requests owned by the caller's workspace.
 func (r *vmRepository) CreateVMDeployInstances(ctx context.Context, instances []modals.VMDeployInstance) *dto.ApiResponseError {
 	r.logger.Info(constants.MySql, constants.Insert, "CreateVMDeployInstances repository function invoked", map[constants.ExtraKey]interface{}{
-		"requestID": instances[0].RequestID,
-		"count":     len(instances),
+		"count": len(instances),
 	})
+	if len(instances) == 0 {
+		return nil
+	}
+	for _, inst := range instances {
+		if constants.RequestStatus(inst.VMStatus) != constants.StatusNew {
+			return &dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: fmt.Sprintf("VMDeployInstance must be created with status %q", constants.StatusNew)}
+		}
+	}
+
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	db := r.db.GetWriter()
 
-	db := r.db.GetReader()
-	result := db.WithContext(ctx).Create(&instances)
+	requestIDs := make([]string, 0, 1)
+	seen := map[string]bool{}
+	for _, inst := range instances {
+		if !seen[inst.RequestID] {
+			seen[inst.RequestID] = true
+			requestIDs = append(requestIDs, inst.RequestID)
+		}
+	}
+	var owned int64
+	result := db.WithContext(ctx).Model(&modals.VMRequest{}).
+		Where("request_id IN ? AND workspace_id = ?", requestIDs, workspaceID).
+		Count(&owned)
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Select, "Failed to check VMRequest ownership", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return databaseError(result.Error)
+	}
+	if owned != int64(len(requestIDs)) {
+		return &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "VMRequest not found"}
+	}
+
+	result = db.WithContext(ctx).Create(&instances)
 	if result.Error != nil {
 		r.logger.Error(constants.MySql, constants.Insert, "Failed to create VMDeployInstances", map[constants.ExtraKey]interface{}{
 			"error": result.Error.Error(),
 		})
-		return &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: result.Error.Error()}
+		return databaseError(result.Error)
 	}
 
 	r.logger.Info(constants.MySql, constants.Insert, "VMDeployInstances created successfully", map[constants.ExtraKey]interface{}{
@@ -126,28 +222,495 @@ func (r *vmRepository) CreateVMDeployInstances(ctx context.Context, instances []
 	return nil
 }
 
-func (r *vmRepository) GetAllVMRequestsWithInstances(ctx context.Context) ([]*modals.VMRequest, []*modals.VMDeployInstance, *dto.ApiResponseError) {
-	var requests []*modals.VMRequest
-	db := r.db.GetReader()
+// GetAllVMRequestsWithInstances returns one page of the caller's VM requests matching filter, the deploy
+// instances of that page, and the total number of matching requests and instances.
+func (r *vmRepository) GetAllVMRequestsWithInstances(ctx context.Context, filter dto.VMRequestFilter) ([]*modals.VMRequest, []*modals.VMDeployInstance, int64, int64, *dto.ApiResponseError) {
+	r.logger.Info(constants.MySql, constants.Select, "GetAllVMRequestsWithInstances repository function invoked", map[constants.ExtraKey]interface{}{
+		"limit":  filter.Limit,
+		"offset": filter.Offset,
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return nil, nil, 0, 0, apiErr
+	}
+	if filter.WorkspaceID != "" && filter.WorkspaceID != workspaceID {
+		// Nothing in another workspace is visible to the caller.
+		return []*modals.VMRequest{}, []*modals.VMDeployInstance{}, 0, 0, nil
+	}
+	filter.WorkspaceID = workspaceID
+	db := r.reader(ctx).WithContext(ctx)
 
-	if err := db.WithContext(ctx).Find(&requests).Error; err != nil {
+	var requestCount int64
+	if err := filterVMRequests(db, filter).Count(&requestCount).Error; err != nil {
+		return nil, nil, 0, 0, databaseError(err)
+	}
+
+	order := "created_at DESC, request_id DESC"
+	if filter.Ascending {
+		order = "created_at ASC, request_id ASC"
+	}
+	var requests []*modals.VMRequest
+	if err := filterVMRequests(db, filter).Order(order).Limit(filter.Limit).Offset(filter.Offset).Find(&requests).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			requests = []*modals.VMRequest{} // return empty slice
 		} else {
-			return nil, nil, &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: err.Error()}
+			return nil, nil, 0, 0, databaseError(err)
 		}
+	}
 
+	var instanceCount int64
+	matching := filterVMRequests(db, filter).Select("request_id")
+	if err := db.Model(&modals.VMDeployInstance{}).Where("request_id IN (?)", matching).Count(&instanceCount).Error; err != nil {
+		return nil, nil, 0, 0, databaseError(err)
 	}
 
-	var instances []*modals.VMDeployInstance
-	if err := db.WithContext(ctx).Find(&instances).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			instances = []*modals.VMDeployInstance{} // return empty slice
-		} else {
-			return nil, nil, &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: err.Error()}
+	// Only the instances of the requests on this page are returned.
+	instances := []*modals.VMDeployInstance{}
+	if len(requests) > 0 {
+		requestIDs := make([]string, len(requests))
+		for i, req := range requests {
+			requestIDs[i] = req.RequestID
+		}
+		if err := db.Where("request_id IN ?", requestIDs).Order("request_id, vm_name").Find(&instances).Error; err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil, 0, 0, databaseError(err)
+			}
+		}
+	}
+
+	return requests, instances, requestCount, instanceCount, nil
+}
+
+// filterVMRequests returns a fresh vm_requests query with the filter's WHERE clauses applied.
+func filterVMRequests(db *gorm.DB, filter dto.VMRequestFilter) *gorm.DB {
+	query := db.Model(&modals.VMRequest{})
+	if filter.Operation != "" {
+		query = query.Where("operation = ?", filter.Operation)
+	}
+	if filter.Status != "" {
+		query = query.Where("request_status = ?", filter.Status)
+	}
+	if filter.WorkspaceID != "" {
+		query = query.Where("workspace_id = ?", filter.WorkspaceID)
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where("created_at < ?", *filter.CreatedBefore)
+	}
+	return query
+}
+
+// ClaimNewVMRequests moves up to limit New requests to Inprogress on behalf of the executor
+// owner and returns the ones this caller won. The status check in the UPDATE makes the claim
+// safe when several executors poll the same table. It is the only query not scoped to a
+// workspace, with FailExpiredVMRequestClaims; requests without one are never claimed.
+func (r *vmRepository) ClaimNewVMRequests(ctx context.Context, owner string, limit int) ([]*modals.VMRequest, *dto.ApiResponseError) {
+	r.logger.Info(constants.MySql, constants.Update, "ClaimNewVMRequests repository function invoked", map[constants.ExtraKey]interface{}{
+		"limit": limit,
+	})
+	db := r.db.GetWriter()
+
+	var candidates []*modals.VMRequest
+	result := db.WithContext(ctx).Where("request_status = ? AND workspace_id <> ''", constants.StatusNew).Order("created_at").Limit(limit).Find(&candidates)
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Select, "Failed to get new VMRequests", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return nil, databaseError(result.Error)
+	}
+
+	claimed := make([]*modals.VMRequest, 0, len(candidates))
+	for _, req := range candidates {
+		claimedAt := time.Now().UTC()
+		result := db.WithContext(ctx).Model(&modals.VMRequest{}).
+			Where("request_id = ? AND request_status = ?", req.RequestID, constants.StatusNew).
+			Updates(map[string]interface{}{
+				"request_status": string(constants.StatusInProgress),
+				"claimed_by":     owner,
+				"claimed_at":     claimedAt,
+			})
+		if result.Error != nil {
+			r.logger.Error(constants.MySql, constants.Update, "Failed to claim VMRequest", map[constants.ExtraKey]interface{}{
+				"requestID": req.RequestID,
+				"error":     result.Error.Error(),
+			})
+			return claimed, databaseError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			// Another executor claimed it first.
+			continue
+		}
+		req.RequestStatus = string(constants.StatusInProgress)
+		req.ClaimedBy = owner
+		req.ClaimedAt = &claimedAt
+		claimed = append(claimed, req)
+	}
+
+	r.logger.Info(constants.MySql, constants.Update, "VMRequests claimed successfully", map[constants.ExtraKey]interface{}{
+		"count": len(claimed),
+	})
+
+	return claimed, nil
+}
+
+// RenewVMRequestClaims moves the claims owner holds on requestIDs to now, so that the requests
+// it is still running are not taken for abandoned. Requests that have finished are left alone.
+func (r *vmRepository) RenewVMRequestClaims(ctx context.Context, owner string, requestIDs []string) *dto.ApiResponseError {
+	r.logger.Info(constants.MySql, constants.Update, "RenewVMRequestClaims repository function invoked", map[constants.ExtraKey]interface{}{
+		"count": len(requestIDs),
+	})
+	if len(requestIDs) == 0 {
+		return nil
+	}
+	db := r.db.GetWriter()
+
+	result := db.WithContext(ctx).Model(&modals.VMRequest{}).
+		Where("request_id IN ? AND claimed_by = ? AND request_status = ?", requestIDs, owner, constants.StatusInProgress).
+		Update("claimed_at", time.Now().UTC())
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Update, "Failed to renew VMRequest claims", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return databaseError(result.Error)
+	}
+
+	return nil
+}
+
+// FailExpiredVMRequestClaims fails up to limit Inprogress requests whose claim was last renewed
+// before claimedBefore, because the executor running them stopped, and returns them. Their VM
+// lock is released and their unfinished deploy instances fail with them; RetryVMRequest can
+// replay them. Like ClaimNewVMRequests it spans every workspace.
+func (r *vmRepository) FailExpiredVMRequestClaims(ctx context.Context, claimedBefore time.Time, limit int) ([]*modals.VMRequest, *dto.ApiResponseError) {
+	r.logger.Info(constants.MySql, constants.Update, "FailExpiredVMRequestClaims repository function invoked", map[constants.ExtraKey]interface{}{
+		"limit": limit,
+	})
+	db := r.db.GetWriter()
+
+	var candidates []*modals.VMRequest
+	result := db.WithContext(ctx).Where("request_status = ? AND claimed_at < ?", constants.StatusInProgress, claimedBefore).Order("claimed_at").Limit(limit).Find(&candidates)
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Select, "Failed to get expired VMRequest claims", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return nil, databaseError(result.Error)
+	}
+
+	failed := make([]*modals.VMRequest, 0, len(candidates))
+	for _, req := range candidates {
+		completedAt := time.Now().UTC()
+		expired := false
+		err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// Matching on the claim time leaves a request alone whose claim was renewed or
+			// which finished since it was read.
+			result := tx.Model(&modals.VMRequest{}).
+				Where("request_id = ? AND request_status = ? AND claimed_at < ?", req.RequestID, constants.StatusInProgress, claimedBefore).
+				Updates(map[string]interface{}{
+					"request_status": string(constants.StatusFailure),
+					"completed_at":   completedAt,
+					"locked_vm_id":   nil,
+				})
+			if result.Error != nil || result.RowsAffected == 0 {
+				return result.Error
+			}
+			expired = true
+			return tx.Model(&modals.VMDeployInstance{}).
+				Where("request_id = ? AND vm_status IN ?", req.RequestID, []string{string(constants.StatusNew), string(constants.StatusInProgress)}).
+				Updates(map[string]interface{}{
+					"vm_status":        string(constants.StatusFailure),
+					"vm_state_message": "The executor running the request stopped",
+					"completed_at":     completedAt,
+				}).Error
+		})
+		if err != nil {
+			r.logger.Error(constants.MySql, constants.Update, "Failed to fail expired VMRequest claim", map[constants.ExtraKey]interface{}{
+				"requestID": req.RequestID,
+				"error":     err.Error(),
+			})
+			return failed, databaseError(err)
+		}
+		if !expired {
+			continue
+		}
+		req.RequestStatus = string(constants.StatusFailure)
+		req.CompletedAt = &completedAt
+		req.LockedVMID = nil
+		failed = append(failed, req)
+	}
+
+	return failed, nil
+}
+
+// UpdateVMRequestStatus moves a VMRequest to status and sets its completion time.
+// Moves the status state machine does not allow are rejected with a conflict.
+func (r *vmRepository) UpdateVMRequestStatus(ctx context.Context, requestID string, status constants.RequestStatus, completedAt *time.Time) *dto.ApiResponseError {
+	r.logger.Info(constants.MySql, constants.Update, "UpdateVMRequestStatus repository function invoked", map[constants.ExtraKey]interface{}{
+		"requestID": requestID,
+		"status":    status,
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	db := r.db.GetWriter()
+
+	var current modals.VMRequest
+	result := db.WithContext(ctx).Select("request_status").Where("request_id = ? AND workspace_id = ?", requestID, workspaceID).First(&current)
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Select, "Failed to get VMRequest status", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "VMRequest not found"}
+		}
+		return databaseError(result.Error)
+	}
+
+	from := constants.RequestStatus(current.RequestStatus)
+	if !from.CanTransitionTo(status) {
+		return statusConflict("VMRequest", requestID, from, status)
+	}
+
+	updates := map[string]interface{}{
+		"request_status": string(status),
+		"completed_at":   completedAt,
+	}
+	if status.IsTerminal() {
+		// A finished request releases the operation lock of its VM.
+		updates["locked_vm_id"] = nil
+	}
+	// Matching on the status just read turns a concurrent move into a conflict instead of a lost update.
+	result = db.WithContext(ctx).Model(&modals.VMRequest{}).
+		Where("request_id = ? AND workspace_id = ? AND request_status = ?", requestID, workspaceID, from).
+		Updates(updates)
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Update, "Failed to update VMRequest status", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return databaseError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return &dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: "VMRequest status was changed concurrently"}
+	}
+
+	return nil
+}
+
+// UpdateVMDeployInstance persists the VM id, status, message and completion time of a deploy instance.
+// The status change must be allowed by the status state machine.
+func (r *vmRepository) UpdateVMDeployInstance(ctx context.Context, instance *modals.VMDeployInstance) *dto.ApiResponseError {
+	r.logger.Info(constants.MySql, constants.Update, "UpdateVMDeployInstance repository function invoked", map[constants.ExtraKey]interface{}{
+		"requestID": instance.RequestID,
+		"vmName":    instance.VMName,
+		"status":    instance.VMStatus,
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	db := r.db.GetWriter().WithContext(ctx)
+
+	var current modals.VMDeployInstance
+	result := db.Select("vm_status").
+		Where("request_id = ? AND vm_name = ? AND request_id IN (?)", instance.RequestID, instance.VMName, requestsInWorkspace(db, workspaceID)).
+		First(&current)
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Select, "Failed to get VMDeployInstance status", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "VMDeployInstance not found"}
+		}
+		return databaseError(result.Error)
+	}
+
+	from := constants.RequestStatus(current.VMStatus)
+	status := constants.RequestStatus(instance.VMStatus)
+	if !from.CanTransitionTo(status) {
+		return statusConflict("VMDeployInstance", instance.VMName, from, status)
+	}
+
+	result = db.Model(&modals.VMDeployInstance{}).
+		Where("request_id = ? AND vm_name = ? AND vm_status = ?", instance.RequestID, instance.VMName, from).
+		Updates(map[string]interface{}{
+			"vm_id":            instance.VMID,
+			"vm_status":        instance.VMStatus,
+			"vm_state_message": instance.VMStateMessage,
+			"completed_at":     instance.CompletedAt,
+		})
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Update, "Failed to update VMDeployInstance", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return databaseError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return &dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: "VMDeployInstance status was changed concurrently"}
+	}
+
+	return nil
+}
+
+// CancelVMRequest cancels a New request and its deploy instances outright. An Inprogress request
+// is only flagged with cancel_requested; the executor stops it at its next step. Finished requests
+// cannot be cancelled.
+func (r *vmRepository) CancelVMRequest(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError) {
+	r.logger.Info(constants.MySql, constants.Update, "CancelVMRequest repository function invoked", map[constants.ExtraKey]interface{}{
+		"requestID": requestID,
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	// The status decides which update runs, so it is read from the primary.
+	ctx = db.WithPrimary(ctx)
+	db := r.db.GetWriter().WithContext(ctx)
+
+	// A New request can be claimed between the read and the update, so the status is re-read once.
+	for attempt := 0; attempt < 2; attempt++ {
+		req, apiErr := r.GetVMRequest(ctx, requestID)
+		if apiErr != nil {
+			return nil, apiErr
+		}
+
+		status := constants.RequestStatus(req.RequestStatus)
+		switch status {
+		case constants.StatusNew:
+			completedAt := time.Now().UTC()
+			result := db.Model(&modals.VMRequest{}).
+				Where("request_id = ? AND workspace_id = ? AND request_status = ?", requestID, workspaceID, constants.StatusNew).
+				Updates(map[string]interface{}{
+					"request_status": string(constants.StatusCancelled),
+					"completed_at":   completedAt,
+					"locked_vm_id":   nil,
+				})
+			if result.Error != nil {
+				r.logger.Error(constants.MySql, constants.Update, "Failed to cancel VMRequest", map[constants.ExtraKey]interface{}{
+					"error": result.Error.Error(),
+				})
+				return nil, databaseError(result.Error)
+			}
+			if result.RowsAffected == 0 {
+				continue
+			}
+			if apiErr := r.CancelPendingVMDeployInstances(ctx, requestID, completedAt); apiErr != nil {
+				return nil, apiErr
+			}
+			req.RequestStatus = string(constants.StatusCancelled)
+			req.CompletedAt = &completedAt
+
+		case constants.StatusInProgress:
+			if req.CancelRequested {
+				return req, nil
+			}
+			result := db.Model(&modals.VMRequest{}).
+				Where("request_id = ? AND workspace_id = ? AND request_status = ?", requestID, workspaceID, constants.StatusInProgress).
+				Update("cancel_requested", true)
+			if result.Error != nil {
+				r.logger.Error(constants.MySql, constants.Update, "Failed to request VMRequest cancellation", map[constants.ExtraKey]interface{}{
+					"error": result.Error.Error(),
+				})
+				return nil, databaseError(result.Error)
+			}
+			if result.RowsAffected == 0 {
+				continue
+			}
+			req.CancelRequested = true
+
+		default:
+			return nil, statusConflict("VMRequest", requestID, status, constants.StatusCancelled)
 		}
 
+		r.logger.Info(constants.MySql, constants.Update, "VMRequest cancellation recorded", map[constants.ExtraKey]interface{}{
+			"requestID": requestID,
+			"status":    req.RequestStatus,
+		})
+		return req, nil
 	}
 
-	return requests, instances, nil
+	return nil, &dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: "VMRequest status was changed concurrently"}
+}
+
+// IsVMRequestCancelRequested reports whether cancellation was requested for an Inprogress request.
+func (r *vmRepository) IsVMRequestCancelRequested(ctx context.Context, requestID string) (bool, *dto.ApiResponseError) {
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return false, apiErr
+	}
+	db := r.db.GetWriter()
+
+	var req modals.VMRequest
+	result := db.WithContext(ctx).Select("cancel_requested").Where("request_id = ? AND workspace_id = ?", requestID, workspaceID).First(&req)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return false, &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "VMRequest not found"}
+		}
+		return false, databaseError(result.Error)
+	}
+
+	return req.CancelRequested, nil
+}
+
+// CancelPendingVMDeployInstances cancels the deploy instances of a request that have not started.
+func (r *vmRepository) CancelPendingVMDeployInstances(ctx context.Context, requestID string, completedAt time.Time) *dto.ApiResponseError {
+	r.logger.Info(constants.MySql, constants.Update, "CancelPendingVMDeployInstances repository function invoked", map[constants.ExtraKey]interface{}{
+		"requestID": requestID,
+	})
+	workspaceID, apiErr := workspaceFromContext(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	db := r.db.GetWriter().WithContext(ctx)
+
+	result := db.Model(&modals.VMDeployInstance{}).
+		Where("request_id = ? AND vm_status = ? AND request_id IN (?)", requestID, constants.StatusNew, requestsInWorkspace(db, workspaceID)).
+		Updates(map[string]interface{}{
+			"vm_status":    string(constants.StatusCancelled),
+			"completed_at": completedAt,
+		})
+	if result.Error != nil {
+		r.logger.Error(constants.MySql, constants.Update, "Failed to cancel VMDeployInstances", map[constants.ExtraKey]interface{}{
+			"error": result.Error.Error(),
+		})
+		return databaseError(result.Error)
+	}
+
+	r.logger.Info(constants.MySql, constants.Update, "VMDeployInstances cancelled", map[constants.ExtraKey]interface{}{
+		"requestID": requestID,
+		"count":     result.RowsAffected,
+	})
+	return nil
+}
+
+// statusConflict builds the error returned for a status move the state machine rejects.
+func statusConflict(entity, id string, from, to constants.RequestStatus) *dto.ApiResponseError {
+	return &dto.ApiResponseError{
+		ErrorCode: constants.LoadStatusConflictErrorCode,
+		Message:   fmt.Sprintf("%s %s cannot move from %q to %q", entity, id, from, to),
+	}
+}
+
+// databaseError builds the error returned for a failed statement: 503 while the database is
+// down, 500 otherwise.
+func databaseError(err error) *dto.ApiResponseError {
+	if errors.Is(err, db.ErrUnavailable) {
+		return &dto.ApiResponseError{ErrorCode: constants.ServiceUnavailableErrorCode, Message: err.Error()}
+	}
+	return &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: err.Error()}
+}
+
+// workspaceFromContext returns the caller's workspace, which scopes every tenant query.
+func workspaceFromContext(ctx context.Context) (string, *dto.ApiResponseError) {
+	workspaceID, err := utils.GetWorkspaceIDFromContext(ctx)
+	if err != nil {
+		return "", &dto.ApiResponseError{ErrorCode: constants.UnauthorizedErrorCode, Message: err.Error()}
+	}
+	return workspaceID, nil
+}
+
+// requestsInWorkspace selects the ids of the requests owned by workspaceID.
+func requestsInWorkspace(db *gorm.DB, workspaceID string) *gorm.DB {
+	return db.Model(&modals.VMRequest{}).Select("request_id").Where("workspace_id = ?", workspaceID)
 }
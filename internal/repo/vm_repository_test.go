@@ -2,7 +2,9 @@ package repo_test
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -11,16 +13,32 @@ import (
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 
+	dto "vm/internal/dtos"
 	"vm/internal/modals"
 	"vm/internal/repo"
 	"vm/pkg/constants"
+	"vm/pkg/db"
 	mock_db "vm/pkg/db/mock"
+	"vm/pkg/utils"
 
 	mock_logger "vm/pkg/logger/mock"
 
 	"github.com/golang/mock/gomock"
 )
 
+// newGormDB returns a gorm MySQL connection over a sqlmock, closed when t ends.
+func newGormDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	return gormDB, mock
+}
+
 func TestCreateVMRequest(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -28,7 +46,7 @@ func TestCreateVMRequest(t *testing.T) {
 	mockDB := mock_db.NewMockDatabase(ctrl)
 	mockLogger := &mock_logger.StubLogger{}
 
-	ctx := context.Background()
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
 
 	t.Run("Successful insert", func(t *testing.T) {
 		sqlDB, mock, _ := sqlmock.New()
@@ -39,11 +57,11 @@ func TestCreateVMRequest(t *testing.T) {
 			SkipInitializeWithVersion: true,
 		}), &gorm.Config{})
 
-		mockDB.EXPECT().GetReader().Return(gormDB.Session(&gorm.Session{SkipHooks: true}))
+		mockDB.EXPECT().GetWriter().Return(gormDB.Session(&gorm.Session{SkipHooks: true}))
 
 		mock.ExpectBegin()
 		mock.ExpectExec("INSERT INTO `vm_requests`").
-			WithArgs("req-123", "vmDeploy", "New", "workspace-001", "dc-001", sqlmock.AnyArg(), nil, `{"key":"value"}`).
+			WithArgs("req-123", "vmDeploy", "New", "workspace-001", "dc-001", sqlmock.AnyArg(), nil, `{"key":"value"}`, false, nil, nil, "", "", nil).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
 
@@ -73,11 +91,11 @@ func TestCreateVMRequest(t *testing.T) {
 			SkipInitializeWithVersion: true,
 		}), &gorm.Config{})
 
-		mockDB.EXPECT().GetReader().Return(gormDB.Session(&gorm.Session{SkipHooks: true}))
+		mockDB.EXPECT().GetWriter().Return(gormDB.Session(&gorm.Session{SkipHooks: true}))
 
 		mock.ExpectBegin()
 		mock.ExpectExec("INSERT INTO `vm_requests`").
-			WithArgs("req-123", "vmDeploy", "New", "workspace-001", "dc-001", sqlmock.AnyArg(), nil, `{"key":"value"}`).
+			WithArgs("req-123", "vmDeploy", "New", "workspace-001", "dc-001", sqlmock.AnyArg(), nil, `{"key":"value"}`, false, nil, nil, "", "", nil).
 			WillReturnError(errors.New("insert error"))
 		mock.ExpectRollback()
 
@@ -98,6 +116,28 @@ func TestCreateVMRequest(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Equal(t, "insert error", err.Message)
 	})
+
+	t.Run("Rejects requests not in New", func(t *testing.T) {
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		err := repo.CreateVMRequest(ctx, &modals.VMRequest{
+			Operation:     "vmDeploy",
+			RequestStatus: string(constants.StatusSuccess),
+		})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Rejects requests without a workspace", func(t *testing.T) {
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		err := repo.CreateVMRequest(context.Background(), &modals.VMRequest{
+			Operation:     "vmDeploy",
+			RequestStatus: string(constants.StatusNew),
+		})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.UnauthorizedErrorCode, err.ErrorCode)
+	})
 }
 
 func TestGetVMRequest(t *testing.T) {
@@ -106,7 +146,7 @@ func TestGetVMRequest(t *testing.T) {
 
 	mockDB := mock_db.NewMockDatabase(ctrl)
 	mockLogger := &mock_logger.StubLogger{}
-	ctx := context.Background()
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
 	requestID := "req-123"
 
 	t.Run("Successful retrieval", func(t *testing.T) {
@@ -120,8 +160,8 @@ func TestGetVMRequest(t *testing.T) {
 
 		mockDB.EXPECT().GetReader().Return(gormDB)
 
-		mock.ExpectQuery("SELECT .* FROM `vm_requests` WHERE request_id = ?").
-			WithArgs(requestID, 1).
+		mock.ExpectQuery("SELECT .* FROM `vm_requests` WHERE request_id = \\? AND workspace_id = \\?").
+			WithArgs(requestID, "workspace-001", 1).
 			WillReturnRows(sqlmock.NewRows([]string{
 				"request_id", "operation", "request_status", "workspace_id", "datacenter_id", "created_at", "completed_at", "request_metadata",
 			}).AddRow(
@@ -136,6 +176,28 @@ func TestGetVMRequest(t *testing.T) {
 		assert.Equal(t, requestID, result.RequestID)
 	})
 
+	t.Run("Context pinned to the primary reads from the writer", func(t *testing.T) {
+		sqlDB, mock, _ := sqlmock.New()
+		defer sqlDB.Close()
+
+		gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectQuery("SELECT .* FROM `vm_requests` WHERE request_id = \\? AND workspace_id = \\?").
+			WithArgs(requestID, "workspace-001", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"request_id", "request_status"}).AddRow(requestID, "New"))
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		result, err := repo.GetVMRequest(db.WithPrimary(ctx), requestID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, requestID, result.RequestID)
+	})
+
 	t.Run("Query error", func(t *testing.T) {
 		sqlDB, mock, _ := sqlmock.New()
 		defer sqlDB.Close()
@@ -147,8 +209,8 @@ func TestGetVMRequest(t *testing.T) {
 
 		mockDB.EXPECT().GetReader().Return(gormDB)
 
-		mock.ExpectQuery("SELECT .* FROM `vm_requests` WHERE request_id = ?").
-			WithArgs(requestID, 1).
+		mock.ExpectQuery("SELECT .* FROM `vm_requests` WHERE request_id = \\? AND workspace_id = \\?").
+			WithArgs(requestID, "workspace-001", 1).
 			WillReturnError(errors.New("query failed"))
 
 		repo := repo.NewVMRepository(mockDB, mockLogger)
@@ -171,8 +233,8 @@ func TestGetVMRequest(t *testing.T) {
 
 		mockDB.EXPECT().GetReader().Return(gormDB)
 
-		mock.ExpectQuery("SELECT .* FROM `vm_requests` WHERE request_id = ?").
-			WithArgs(requestID, 1).
+		mock.ExpectQuery("SELECT .* FROM `vm_requests` WHERE request_id = \\? AND workspace_id = \\?").
+			WithArgs(requestID, "workspace-001", 1).
 			WillReturnError(gorm.ErrRecordNotFound)
 
 		repo := repo.NewVMRepository(mockDB, mockLogger)
@@ -184,6 +246,38 @@ func TestGetVMRequest(t *testing.T) {
 		assert.Equal(t, constants.SQLRecordNotFoundErrorCode, err.ErrorCode)
 	})
 
+	t.Run("Request owned by another workspace is not found", func(t *testing.T) {
+		sqlDB, mock, _ := sqlmock.New()
+		defer sqlDB.Close()
+
+		gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+
+		mockDB.EXPECT().GetReader().Return(gormDB)
+
+		otherCtx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-002")
+		mock.ExpectQuery("SELECT .* FROM `vm_requests` WHERE request_id = \\? AND workspace_id = \\?").
+			WithArgs(requestID, "workspace-002", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"request_id"}))
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		result, err := repo.GetVMRequest(otherCtx, requestID)
+
+		assert.Nil(t, result)
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.SQLRecordNotFoundErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Rejects lookups without a workspace", func(t *testing.T) {
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		result, err := repo.GetVMRequest(context.Background(), requestID)
+
+		assert.Nil(t, result)
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.UnauthorizedErrorCode, err.ErrorCode)
+	})
 }
 
 func TestGetVMDeployInstances(t *testing.T) {
@@ -192,7 +286,7 @@ func TestGetVMDeployInstances(t *testing.T) {
 
 	mockDB := mock_db.NewMockDatabase(ctrl)
 	mockLogger := &mock_logger.StubLogger{}
-	ctx := context.Background()
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
 	requestID := "req-123"
 
 	t.Run("Successful retrieval", func(t *testing.T) {
@@ -206,8 +300,8 @@ func TestGetVMDeployInstances(t *testing.T) {
 
 		mockDB.EXPECT().GetReader().Return(gormDB)
 
-		mock.ExpectQuery("SELECT .* FROM `vm_deploy_instances` WHERE request_id = ?").
-			WithArgs(requestID).
+		mock.ExpectQuery("SELECT .* FROM `vm_deploy_instances` WHERE request_id = \\? AND request_id IN \\(SELECT `request_id` FROM `vm_requests` WHERE workspace_id = \\?\\)").
+			WithArgs(requestID, "workspace-001").
 			WillReturnRows(sqlmock.NewRows([]string{
 				"request_id", "vm_name", "vm_id", "vm_status", "vm_state_message", "completed_at",
 			}).AddRow(
@@ -236,8 +330,8 @@ func TestGetVMDeployInstances(t *testing.T) {
 
 		mockDB.EXPECT().GetReader().Return(gormDB)
 
-		mock.ExpectQuery("SELECT .* FROM `vm_deploy_instances` WHERE request_id = ?").
-			WithArgs(requestID).
+		mock.ExpectQuery("SELECT .* FROM `vm_deploy_instances` WHERE request_id = \\? AND request_id IN \\(SELECT `request_id` FROM `vm_requests` WHERE workspace_id = \\?\\)").
+			WithArgs(requestID, "workspace-001").
 			WillReturnError(gorm.ErrRecordNotFound)
 
 		repo := repo.NewVMRepository(mockDB, mockLogger)
@@ -259,8 +353,8 @@ func TestGetVMDeployInstances(t *testing.T) {
 
 		mockDB.EXPECT().GetReader().Return(gormDB)
 
-		mock.ExpectQuery("SELECT .* FROM `vm_deploy_instances` WHERE request_id = ?").
-			WithArgs(requestID).
+		mock.ExpectQuery("SELECT .* FROM `vm_deploy_instances` WHERE request_id = \\? AND request_id IN \\(SELECT `request_id` FROM `vm_requests` WHERE workspace_id = \\?\\)").
+			WithArgs(requestID, "workspace-001").
 			WillReturnError(errors.New("query failed"))
 
 		repo := repo.NewVMRepository(mockDB, mockLogger)
@@ -280,7 +374,14 @@ func TestCreateVMDeployInstances(t *testing.T) {
 
 	mockDB := mock_db.NewMockDatabase(ctrl)
 	mockLogger := &mock_logger.StubLogger{}
-	ctx := context.Background()
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	t.Run("No instances", func(t *testing.T) {
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+
+		assert.Nil(t, repo.CreateVMDeployInstances(ctx, nil))
+		assert.Nil(t, repo.CreateVMDeployInstances(ctx, []modals.VMDeployInstance{}))
+	})
 
 	t.Run("Successful insert", func(t *testing.T) {
 		sqlDB, mock, _ := sqlmock.New()
@@ -291,14 +392,14 @@ func TestCreateVMDeployInstances(t *testing.T) {
 			SkipInitializeWithVersion: true,
 		}), &gorm.Config{})
 
-		mockDB.EXPECT().GetReader().Return(gormDB)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
 
 		instances := []modals.VMDeployInstance{
 			{
 				RequestID:      "req-123",
 				VMName:         "vm-1",
 				VMID:           "vmid-001",
-				VMStatus:       "INIT",
+				VMStatus:       "New",
 				VMStateMessage: "Starting",
 				CompletedAt:    nil,
 			},
@@ -306,12 +407,15 @@ func TestCreateVMDeployInstances(t *testing.T) {
 				RequestID:      "req-123",
 				VMName:         "vm-2",
 				VMID:           "vmid-002",
-				VMStatus:       "RUNNING",
+				VMStatus:       "New",
 				VMStateMessage: "Running",
 				CompletedAt:    nil,
 			},
 		}
 
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_requests` WHERE request_id IN \\(\\?\\) AND workspace_id = \\?").
+			WithArgs("req-123", "workspace-001").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 		mock.ExpectBegin()
 		mock.ExpectExec("INSERT INTO `vm_deploy_instances`").
 			WithArgs(
@@ -336,19 +440,22 @@ func TestCreateVMDeployInstances(t *testing.T) {
 			SkipInitializeWithVersion: true,
 		}), &gorm.Config{})
 
-		mockDB.EXPECT().GetReader().Return(gormDB)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
 
 		instances := []modals.VMDeployInstance{
 			{
 				RequestID:      "req-456",
 				VMName:         "vm-3",
 				VMID:           "vmid-003",
-				VMStatus:       "FAILED",
+				VMStatus:       "New",
 				VMStateMessage: "Error",
 				CompletedAt:    nil,
 			},
 		}
 
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_requests` WHERE request_id IN \\(\\?\\) AND workspace_id = \\?").
+			WithArgs("req-456", "workspace-001").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 		mock.ExpectBegin()
 		mock.ExpectExec("INSERT INTO `vm_deploy_instances`").
 			WithArgs(
@@ -363,6 +470,41 @@ func TestCreateVMDeployInstances(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Equal(t, "insert error", err.Message)
 	})
+
+	t.Run("Rejects instances of another workspace's request", func(t *testing.T) {
+		sqlDB, mock, _ := sqlmock.New()
+		defer sqlDB.Close()
+
+		gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_requests`").
+			WithArgs("req-other", "workspace-001").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		err := repo.CreateVMDeployInstances(ctx, []modals.VMDeployInstance{
+			{RequestID: "req-other", VMName: "vm-5", VMStatus: string(constants.StatusNew)},
+		})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.SQLRecordNotFoundErrorCode, err.ErrorCode)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Rejects instances not in New", func(t *testing.T) {
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		err := repo.CreateVMDeployInstances(ctx, []modals.VMDeployInstance{
+			{RequestID: "req-789", VMName: "vm-4", VMStatus: string(constants.StatusSuccess)},
+		})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+	})
 }
 
 func TestGetAllVMRequestsWithInstances(t *testing.T) {
@@ -371,44 +513,206 @@ func TestGetAllVMRequestsWithInstances(t *testing.T) {
 
 	mockDB := mock_db.NewMockDatabase(ctrl)
 	mockLogger := &mock_logger.StubLogger{}
-	ctx := context.Background()
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
 
-	t.Run("Successful retrieval", func(t *testing.T) {
-		sqlDB, mock, _ := sqlmock.New()
-		defer sqlDB.Close()
-
-		gormDB, _ := gorm.Open(mysql.New(mysql.Config{
-			Conn:                      sqlDB,
-			SkipInitializeWithVersion: true,
-		}), &gorm.Config{})
+	requestColumns := []string{
+		"request_id", "operation", "request_status", "workspace_id", "datacenter_id", "created_at", "completed_at", "request_metadata",
+	}
+	instanceColumns := []string{
+		"request_id", "vm_name", "vm_id", "vm_status", "vm_state_message", "completed_at",
+	}
 
+	t.Run("Successful retrieval", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
 		mockDB.EXPECT().GetReader().Return(gormDB).Times(1)
 
-		mock.ExpectQuery("SELECT .* FROM `vm_requests`").
-			WillReturnRows(sqlmock.NewRows([]string{
-				"request_id", "operation", "request_status", "workspace_id", "datacenter_id", "created_at", "completed_at", "request_metadata",
-			}).AddRow(
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_requests` WHERE workspace_id = \\?").
+			WithArgs("workspace-001").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(42))
+		mock.ExpectQuery("SELECT \\* FROM `vm_requests` WHERE workspace_id = \\? ORDER BY created_at DESC, request_id DESC LIMIT \\?").
+			WithArgs("workspace-001", 1).
+			WillReturnRows(sqlmock.NewRows(requestColumns).AddRow(
 				"req-001", "vmDeploy", "New", "workspace-001", "dc-001", time.Now(), nil, `{"key":"value"}`,
 			))
-
-		mock.ExpectQuery("SELECT .* FROM `vm_deploy_instances`").
-			WillReturnRows(sqlmock.NewRows([]string{
-				"request_id", "vm_name", "vm_id", "vm_status", "vm_state_message", "completed_at",
-			}).AddRow(
-				"req-001", "vm-1", "vmid-001", "INIT", "Starting", nil,
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_deploy_instances` WHERE request_id IN \\(SELECT `request_id` FROM `vm_requests` WHERE workspace_id = \\?\\)").
+			WithArgs("workspace-001").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(97))
+		mock.ExpectQuery("SELECT \\* FROM `vm_deploy_instances` WHERE request_id IN \\(\\?\\)").
+			WithArgs("req-001").
+			WillReturnRows(sqlmock.NewRows(instanceColumns).AddRow(
+				"req-001", "vm-1", "vmid-001", "New", "", nil,
 			))
 
 		repo := repo.NewVMRepository(mockDB, mockLogger)
-		requests, instances, err := repo.GetAllVMRequestsWithInstances(ctx)
+		requests, instances, requestCount, instanceCount, err := repo.GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{Limit: 1})
 
 		assert.Nil(t, err)
 		assert.Len(t, requests, 1)
 		assert.Len(t, instances, 1)
+		assert.Equal(t, int64(42), requestCount)
+		assert.Equal(t, int64(97), instanceCount)
 		assert.Equal(t, "req-001", requests[0].RequestID)
 		assert.Equal(t, "vm-1", instances[0].VMName)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Applies filters, sort order and offset", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetReader().Return(gormDB).Times(1)
+
+		after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		before := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+		filter := dto.VMRequestFilter{
+			Operation:     "vmPowerOn",
+			Status:        "Failure",
+			WorkspaceID:   "workspace-001",
+			CreatedAfter:  &after,
+			CreatedBefore: &before,
+			Ascending:     true,
+			Limit:         10,
+			Offset:        20,
+		}
+		where := "WHERE operation = \\? AND request_status = \\? AND workspace_id = \\? AND created_at >= \\? AND created_at < \\?"
+		args := []driver.Value{"vmPowerOn", "Failure", "workspace-001", after, before}
+
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_requests` " + where).
+			WithArgs(args...).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(20))
+		mock.ExpectQuery("SELECT \\* FROM `vm_requests` " + where + " ORDER BY created_at ASC, request_id ASC LIMIT \\? OFFSET \\?").
+			WithArgs(append(args, 10, 20)...).
+			WillReturnRows(sqlmock.NewRows(requestColumns))
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_deploy_instances` WHERE request_id IN \\(SELECT `request_id` FROM `vm_requests` " + where + "\\)").
+			WithArgs(args...).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		requests, instances, requestCount, instanceCount, err := repo.GetAllVMRequestsWithInstances(ctx, filter)
+
+		assert.Nil(t, err)
+		assert.Empty(t, requests)
+		assert.Empty(t, instances)
+		assert.Equal(t, int64(20), requestCount)
+		assert.Equal(t, int64(0), instanceCount)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Another workspace's filter matches nothing", func(t *testing.T) {
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		requests, instances, requestCount, instanceCount, err := repo.GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{WorkspaceID: "workspace-002", Limit: 10})
+
+		assert.Nil(t, err)
+		assert.Empty(t, requests)
+		assert.Empty(t, instances)
+		assert.Zero(t, requestCount)
+		assert.Zero(t, instanceCount)
+	})
+
+	t.Run("Rejects listing without a workspace", func(t *testing.T) {
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		_, _, _, _, err := repo.GetAllVMRequestsWithInstances(context.Background(), dto.VMRequestFilter{Limit: 10})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.UnauthorizedErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Error counting requests", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetReader().Return(gormDB)
+
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_requests`").
+			WillReturnError(errors.New("count error"))
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		requests, instances, _, _, err := repo.GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{Limit: 10})
+
+		assert.Nil(t, requests)
+		assert.Nil(t, instances)
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+		assert.Equal(t, "count error", err.Message)
 	})
 
 	t.Run("Error fetching requests", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetReader().Return(gormDB)
+
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_requests`").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery("SELECT \\* FROM `vm_requests`").
+			WillReturnError(errors.New("query error"))
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		requests, instances, _, _, err := repo.GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{Limit: 10})
+
+		assert.Nil(t, requests)
+		assert.Nil(t, instances)
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+		assert.Equal(t, "query error", err.Message)
+	})
+
+	t.Run("Error fetching instances", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetReader().Return(gormDB).Times(1)
+
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_requests`").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery("SELECT \\* FROM `vm_requests`").
+			WillReturnRows(sqlmock.NewRows(requestColumns).AddRow(
+				"req-001", "vmDeploy", "New", "workspace-001", "dc-001", time.Now(), nil, `{"key":"value"}`,
+			))
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_deploy_instances`").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery("SELECT \\* FROM `vm_deploy_instances`").
+			WillReturnError(errors.New("instance query error"))
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		requests, instances, _, _, err := repo.GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{Limit: 10})
+
+		assert.Nil(t, requests)
+		assert.Nil(t, instances)
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+		assert.Equal(t, "instance query error", err.Message)
+	})
+
+	t.Run("RecordNotFound for instances", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB.EXPECT().GetReader().Return(gormDB).Times(1)
+
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_requests`").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery("SELECT \\* FROM `vm_requests`").
+			WillReturnRows(sqlmock.NewRows(requestColumns).AddRow(
+				"req-001", "vmDeploy", "New", "workspace-001", "dc-001", time.Now(), nil, `{"key":"value"}`,
+			))
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `vm_deploy_instances`").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery("SELECT \\* FROM `vm_deploy_instances`").
+			WillReturnError(gorm.ErrRecordNotFound)
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		requests, instances, _, _, err := repo.GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{Limit: 10})
+
+		assert.Nil(t, err)
+		assert.Len(t, requests, 1)
+		assert.Empty(t, instances)
+	})
+}
+
+func TestClaimNewVMRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mock_db.NewMockDatabase(ctrl)
+	mockLogger := &mock_logger.StubLogger{}
+	ctx := context.Background()
+
+	requestColumns := []string{
+		"request_id", "operation", "request_status", "workspace_id", "datacenter_id", "created_at", "completed_at", "request_metadata",
+	}
+
+	t.Run("Claims only the requests it wins", func(t *testing.T) {
 		sqlDB, mock, _ := sqlmock.New()
 		defer sqlDB.Close()
 
@@ -417,22 +721,62 @@ func TestGetAllVMRequestsWithInstances(t *testing.T) {
 			SkipInitializeWithVersion: true,
 		}), &gorm.Config{})
 
-		mockDB.EXPECT().GetReader().Return(gormDB)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectQuery("SELECT .* FROM `vm_requests` WHERE request_status = \\? AND workspace_id <> '' ORDER BY created_at LIMIT \\?").
+			WithArgs("New", 2).
+			WillReturnRows(sqlmock.NewRows(requestColumns).
+				AddRow("req-001", "vmDeploy", "New", "workspace-001", "dc-001", time.Now(), nil, `{}`).
+				AddRow("req-002", "vmPowerOn", "New", "workspace-001", "dc-001", time.Now(), nil, `{}`))
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests` SET `claimed_at`=\\?,`claimed_by`=\\?,`request_status`=\\? WHERE request_id = \\? AND request_status = \\?").
+			WithArgs(sqlmock.AnyArg(), "executor-1", "Inprogress", "req-001", "New").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests` SET `claimed_at`=\\?,`claimed_by`=\\?,`request_status`=\\? WHERE request_id = \\? AND request_status = \\?").
+			WithArgs(sqlmock.AnyArg(), "executor-1", "Inprogress", "req-002", "New").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		claimed, err := repo.ClaimNewVMRequests(ctx, "executor-1", 2)
+
+		assert.Nil(t, err)
+		assert.Len(t, claimed, 1)
+		assert.Equal(t, "req-001", claimed[0].RequestID)
+		assert.Equal(t, string(constants.StatusInProgress), claimed[0].RequestStatus)
+		assert.Equal(t, "executor-1", claimed[0].ClaimedBy)
+		assert.NotNil(t, claimed[0].ClaimedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Query error", func(t *testing.T) {
+		sqlDB, mock, _ := sqlmock.New()
+		defer sqlDB.Close()
+
+		gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+
+		mockDB.EXPECT().GetWriter().Return(gormDB)
 
 		mock.ExpectQuery("SELECT .* FROM `vm_requests`").
-			WillReturnError(errors.New("query error"))
+			WillReturnError(errors.New("query failed"))
 
 		repo := repo.NewVMRepository(mockDB, mockLogger)
-		requests, instances, err := repo.GetAllVMRequestsWithInstances(ctx)
+		claimed, err := repo.ClaimNewVMRequests(ctx, "executor-1", 2)
 
-		assert.Nil(t, requests)
-		assert.Nil(t, instances)
+		assert.Nil(t, claimed)
 		assert.NotNil(t, err)
 		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
-		assert.Equal(t, "query error", err.Message)
+		assert.Equal(t, "query failed", err.Message)
 	})
 
-	t.Run("Error fetching instances", func(t *testing.T) {
+	t.Run("Update error", func(t *testing.T) {
 		sqlDB, mock, _ := sqlmock.New()
 		defer sqlDB.Close()
 
@@ -441,29 +785,224 @@ func TestGetAllVMRequestsWithInstances(t *testing.T) {
 			SkipInitializeWithVersion: true,
 		}), &gorm.Config{})
 
-		mockDB.EXPECT().GetReader().Return(gormDB).Times(1)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
 
 		mock.ExpectQuery("SELECT .* FROM `vm_requests`").
-			WillReturnRows(sqlmock.NewRows([]string{
-				"request_id", "operation", "request_status", "workspace_id", "datacenter_id", "created_at", "completed_at", "request_metadata",
-			}).AddRow(
-				"req-001", "vmDeploy", "New", "workspace-001", "dc-001", time.Now(), nil, `{"key":"value"}`,
-			))
+			WillReturnRows(sqlmock.NewRows(requestColumns).
+				AddRow("req-001", "vmDeploy", "New", "workspace-001", "dc-001", time.Now(), nil, `{}`))
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests`").
+			WillReturnError(errors.New("update failed"))
+		mock.ExpectRollback()
 
-		mock.ExpectQuery("SELECT .* FROM `vm_deploy_instances`").
-			WillReturnError(errors.New("instance query error"))
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		claimed, err := repo.ClaimNewVMRequests(ctx, "executor-1", 2)
+
+		assert.Empty(t, claimed)
+		assert.NotNil(t, err)
+		assert.Equal(t, "update failed", err.Message)
+	})
+}
+
+func TestRenewVMRequestClaims(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := &mock_logger.StubLogger{}
+	ctx := context.Background()
+
+	t.Run("Renews the claims the owner still holds", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB := mock_db.NewMockDatabase(ctrl)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests` SET `claimed_at`=\\? WHERE request_id IN \\(\\?,\\?\\) AND claimed_by = \\? AND request_status = \\?").
+			WithArgs(sqlmock.AnyArg(), "req-001", "req-002", "executor-1", "Inprogress").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
 
 		repo := repo.NewVMRepository(mockDB, mockLogger)
-		requests, instances, err := repo.GetAllVMRequestsWithInstances(ctx)
+		err := repo.RenewVMRequestClaims(ctx, "executor-1", []string{"req-001", "req-002"})
+
+		assert.Nil(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("No requests", func(t *testing.T) {
+		repo := repo.NewVMRepository(mock_db.NewMockDatabase(ctrl), mockLogger)
+
+		assert.Nil(t, repo.RenewVMRequestClaims(ctx, "executor-1", nil))
+	})
+
+	t.Run("Update error", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB := mock_db.NewMockDatabase(ctrl)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests`").
+			WillReturnError(errors.New("update failed"))
+		mock.ExpectRollback()
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		err := repo.RenewVMRequestClaims(ctx, "executor-1", []string{"req-001"})
 
-		assert.Nil(t, requests)
-		assert.Nil(t, instances)
 		assert.NotNil(t, err)
-		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
-		assert.Equal(t, "instance query error", err.Message)
+		assert.Equal(t, "update failed", err.Message)
 	})
+}
+
+func TestFailExpiredVMRequestClaims(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := &mock_logger.StubLogger{}
+	ctx := context.Background()
+	claimedBefore := time.Now().UTC().Add(-5 * time.Minute)
+
+	requestColumns := []string{
+		"request_id", "operation", "request_status", "workspace_id", "locked_vm_id", "claimed_by", "claimed_at",
+	}
+
+	t.Run("Fails only the requests whose claim is still expired", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB := mock_db.NewMockDatabase(ctrl)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
 
-	t.Run("RecordNotFound for requests", func(t *testing.T) {
+		mock.ExpectQuery("SELECT .* FROM `vm_requests` WHERE request_status = \\? AND claimed_at < \\? ORDER BY claimed_at LIMIT \\?").
+			WithArgs("Inprogress", claimedBefore, 10).
+			WillReturnRows(sqlmock.NewRows(requestColumns).
+				AddRow("req-001", "vmDeploy", "Inprogress", "workspace-001", nil, "executor-1", claimedBefore.Add(-time.Minute)).
+				AddRow("req-002", "vmPowerOn", "Inprogress", "workspace-001", "vm-001", "executor-1", claimedBefore.Add(-time.Minute)))
+
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests` SET `completed_at`=\\?,`locked_vm_id`=\\?,`request_status`=\\? WHERE request_id = \\? AND request_status = \\? AND claimed_at < \\?").
+			WithArgs(sqlmock.AnyArg(), nil, "Failure", "req-001", "Inprogress", claimedBefore).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE `vm_deploy_instances` SET `completed_at`=\\?,`vm_state_message`=\\?,`vm_status`=\\? WHERE request_id = \\? AND vm_status IN \\(\\?,\\?\\)").
+			WithArgs(sqlmock.AnyArg(), "The executor running the request stopped", "Failure", "req-001", "New", "Inprogress").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		// req-002 had its claim renewed since it was read.
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests`").
+			WithArgs(sqlmock.AnyArg(), nil, "Failure", "req-002", "Inprogress", claimedBefore).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		failed, err := repo.FailExpiredVMRequestClaims(ctx, claimedBefore, 10)
+
+		assert.Nil(t, err)
+		assert.Len(t, failed, 1)
+		assert.Equal(t, "req-001", failed[0].RequestID)
+		assert.Equal(t, string(constants.StatusFailure), failed[0].RequestStatus)
+		assert.Equal(t, "executor-1", failed[0].ClaimedBy)
+		assert.NotNil(t, failed[0].CompletedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Query error", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB := mock_db.NewMockDatabase(ctrl)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectQuery("SELECT .* FROM `vm_requests`").
+			WillReturnError(errors.New("query failed"))
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		failed, err := repo.FailExpiredVMRequestClaims(ctx, claimedBefore, 10)
+
+		assert.Nil(t, failed)
+		assert.NotNil(t, err)
+		assert.Equal(t, "query failed", err.Message)
+	})
+
+	t.Run("Update error rolls the request back", func(t *testing.T) {
+		gormDB, mock := newGormDB(t)
+		mockDB := mock_db.NewMockDatabase(ctrl)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectQuery("SELECT .* FROM `vm_requests`").
+			WillReturnRows(sqlmock.NewRows(requestColumns).
+				AddRow("req-001", "vmDeploy", "Inprogress", "workspace-001", nil, "executor-1", claimedBefore.Add(-time.Minute)))
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests`").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE `vm_deploy_instances`").
+			WillReturnError(errors.New("update failed"))
+		mock.ExpectRollback()
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		failed, err := repo.FailExpiredVMRequestClaims(ctx, claimedBefore, 10)
+
+		assert.Empty(t, failed)
+		assert.NotNil(t, err)
+		assert.Equal(t, "update failed", err.Message)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestUpdateVMRequestStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mock_db.NewMockDatabase(ctrl)
+	mockLogger := &mock_logger.StubLogger{}
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+	completedAt := time.Now().UTC()
+
+	for _, from := range constants.Statuses() {
+		for _, to := range constants.Statuses() {
+			from, to := from, to
+			t.Run(fmt.Sprintf("%s to %s", from, to), func(t *testing.T) {
+				sqlDB, mock, _ := sqlmock.New()
+				defer sqlDB.Close()
+
+				gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+					Conn:                      sqlDB,
+					SkipInitializeWithVersion: true,
+				}), &gorm.Config{})
+
+				mockDB.EXPECT().GetWriter().Return(gormDB)
+
+				mock.ExpectQuery("SELECT `request_status` FROM `vm_requests` WHERE request_id = \\? AND workspace_id = \\?").
+					WithArgs("req-123", "workspace-001", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"request_status"}).AddRow(string(from)))
+
+				allowed := from.CanTransitionTo(to)
+				if allowed && to.IsTerminal() {
+					// Finishing a request releases its VM lock.
+					mock.ExpectBegin()
+					mock.ExpectExec("UPDATE `vm_requests` SET `completed_at`=\\?,`locked_vm_id`=\\?,`request_status`=\\? WHERE request_id = \\? AND workspace_id = \\? AND request_status = \\?").
+						WithArgs(completedAt, nil, string(to), "req-123", "workspace-001", string(from)).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				} else if allowed {
+					mock.ExpectBegin()
+					mock.ExpectExec("UPDATE `vm_requests` SET `completed_at`=\\?,`request_status`=\\? WHERE request_id = \\? AND workspace_id = \\? AND request_status = \\?").
+						WithArgs(completedAt, string(to), "req-123", "workspace-001", string(from)).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				}
+
+				repo := repo.NewVMRepository(mockDB, mockLogger)
+				err := repo.UpdateVMRequestStatus(ctx, "req-123", to, &completedAt)
+
+				if allowed {
+					assert.Nil(t, err)
+				} else {
+					assert.NotNil(t, err)
+					assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+				}
+				assert.NoError(t, mock.ExpectationsWereMet())
+			})
+		}
+	}
+
+	t.Run("Record not found", func(t *testing.T) {
 		sqlDB, mock, _ := sqlmock.New()
 		defer sqlDB.Close()
 
@@ -472,27 +1011,44 @@ func TestGetAllVMRequestsWithInstances(t *testing.T) {
 			SkipInitializeWithVersion: true,
 		}), &gorm.Config{})
 
-		mockDB.EXPECT().GetReader().Return(gormDB)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
 
-		mock.ExpectQuery("SELECT .* FROM `vm_requests`").
+		mock.ExpectQuery("SELECT `request_status` FROM `vm_requests`").
 			WillReturnError(gorm.ErrRecordNotFound)
 
-		mock.ExpectQuery("SELECT .* FROM `vm_deploy_instances`").
-			WillReturnRows(sqlmock.NewRows([]string{
-				"request_id", "vm_name", "vm_id", "vm_status", "vm_state_message", "completed_at",
-			}).AddRow(
-				"req-001", "vm-1", "vmid-001", "INIT", "Starting", nil,
-			))
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		err := repo.UpdateVMRequestStatus(ctx, "req-123", constants.StatusSuccess, &completedAt)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.SQLRecordNotFoundErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Concurrent change", func(t *testing.T) {
+		sqlDB, mock, _ := sqlmock.New()
+		defer sqlDB.Close()
+
+		gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectQuery("SELECT `request_status` FROM `vm_requests`").
+			WillReturnRows(sqlmock.NewRows([]string{"request_status"}).AddRow("Inprogress"))
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests`").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
 
 		repo := repo.NewVMRepository(mockDB, mockLogger)
-		requests, instances, err := repo.GetAllVMRequestsWithInstances(ctx)
+		err := repo.UpdateVMRequestStatus(ctx, "req-123", constants.StatusSuccess, &completedAt)
 
-		assert.Nil(t, err)
-		assert.Empty(t, requests)
-		assert.Len(t, instances, 1)
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
 	})
 
-	t.Run("RecordNotFound for instances", func(t *testing.T) {
+	t.Run("Update error", func(t *testing.T) {
 		sqlDB, mock, _ := sqlmock.New()
 		defer sqlDB.Close()
 
@@ -501,23 +1057,293 @@ func TestGetAllVMRequestsWithInstances(t *testing.T) {
 			SkipInitializeWithVersion: true,
 		}), &gorm.Config{})
 
-		mockDB.EXPECT().GetReader().Return(gormDB).Times(1)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
 
-		mock.ExpectQuery("SELECT .* FROM `vm_requests`").
-			WillReturnRows(sqlmock.NewRows([]string{
-				"request_id", "operation", "request_status", "workspace_id", "datacenter_id", "created_at", "completed_at", "request_metadata",
-			}).AddRow(
-				"req-001", "vmDeploy", "New", "workspace-001", "dc-001", time.Now(), nil, `{"key":"value"}`,
-			))
+		mock.ExpectQuery("SELECT `request_status` FROM `vm_requests`").
+			WillReturnRows(sqlmock.NewRows([]string{"request_status"}).AddRow("Inprogress"))
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests`").
+			WillReturnError(errors.New("update failed"))
+		mock.ExpectRollback()
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		err := repo.UpdateVMRequestStatus(ctx, "req-123", constants.StatusSuccess, &completedAt)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+		assert.Equal(t, "update failed", err.Message)
+	})
+}
+
+func TestUpdateVMDeployInstance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mock_db.NewMockDatabase(ctrl)
+	mockLogger := &mock_logger.StubLogger{}
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+	completedAt := time.Now().UTC()
+
+	for _, from := range constants.Statuses() {
+		for _, to := range constants.Statuses() {
+			from, to := from, to
+			t.Run(fmt.Sprintf("%s to %s", from, to), func(t *testing.T) {
+				sqlDB, mock, _ := sqlmock.New()
+				defer sqlDB.Close()
+
+				gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+					Conn:                      sqlDB,
+					SkipInitializeWithVersion: true,
+				}), &gorm.Config{})
+
+				mockDB.EXPECT().GetWriter().Return(gormDB)
+
+				instance := &modals.VMDeployInstance{
+					RequestID:   "req-123",
+					VMName:      "vm-1",
+					VMID:        "vmid-001",
+					VMStatus:    string(to),
+					CompletedAt: &completedAt,
+				}
+
+				mock.ExpectQuery("SELECT `vm_status` FROM `vm_deploy_instances` WHERE request_id = \\? AND vm_name = \\? AND request_id IN \\(SELECT `request_id` FROM `vm_requests` WHERE workspace_id = \\?\\)").
+					WithArgs("req-123", "vm-1", "workspace-001", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"vm_status"}).AddRow(string(from)))
+
+				allowed := from.CanTransitionTo(to)
+				if allowed {
+					mock.ExpectBegin()
+					mock.ExpectExec("UPDATE `vm_deploy_instances` SET .* WHERE request_id = \\? AND vm_name = \\? AND vm_status = \\?").
+						WithArgs(completedAt, "vmid-001", "", string(to), "req-123", "vm-1", string(from)).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectCommit()
+				}
+
+				repo := repo.NewVMRepository(mockDB, mockLogger)
+				err := repo.UpdateVMDeployInstance(ctx, instance)
+
+				if allowed {
+					assert.Nil(t, err)
+				} else {
+					assert.NotNil(t, err)
+					assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+				}
+				assert.NoError(t, mock.ExpectationsWereMet())
+			})
+		}
+	}
+
+	t.Run("Record not found", func(t *testing.T) {
+		sqlDB, mock, _ := sqlmock.New()
+		defer sqlDB.Close()
 
-		mock.ExpectQuery("SELECT .* FROM `vm_deploy_instances`").
+		gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectQuery("SELECT `vm_status` FROM `vm_deploy_instances`").
 			WillReturnError(gorm.ErrRecordNotFound)
 
 		repo := repo.NewVMRepository(mockDB, mockLogger)
-		requests, instances, err := repo.GetAllVMRequestsWithInstances(ctx)
+		err := repo.UpdateVMDeployInstance(ctx, &modals.VMDeployInstance{RequestID: "req-123", VMName: "vm-1", VMStatus: "Inprogress"})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.SQLRecordNotFoundErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Update error", func(t *testing.T) {
+		sqlDB, mock, _ := sqlmock.New()
+		defer sqlDB.Close()
+
+		gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+
+		mock.ExpectQuery("SELECT `vm_status` FROM `vm_deploy_instances`").
+			WillReturnRows(sqlmock.NewRows([]string{"vm_status"}).AddRow("New"))
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_deploy_instances`").
+			WillReturnError(errors.New("update failed"))
+		mock.ExpectRollback()
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		err := repo.UpdateVMDeployInstance(ctx, &modals.VMDeployInstance{RequestID: "req-123", VMName: "vm-1", VMStatus: "Inprogress"})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "update failed", err.Message)
+	})
+}
+
+func TestCancelVMRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := &mock_logger.StubLogger{}
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+	requestColumns := []string{"request_id", "operation", "request_status", "workspace_id", "created_at", "cancel_requested"}
+
+	setup := func(t *testing.T) (*mock_db.MockDatabase, sqlmock.Sqlmock) {
+		sqlDB, mock, _ := sqlmock.New()
+		t.Cleanup(func() { sqlDB.Close() })
+
+		gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+
+		mockDB := mock_db.NewMockDatabase(ctrl)
+		mockDB.EXPECT().GetWriter().Return(gormDB).AnyTimes()
+		return mockDB, mock
+	}
+
+	t.Run("New request is cancelled with its instances", func(t *testing.T) {
+		mockDB, mock := setup(t)
+
+		mock.ExpectQuery("SELECT .* FROM `vm_requests` WHERE request_id = \\? AND workspace_id = \\?").
+			WithArgs("req-123", "workspace-001", 1).
+			WillReturnRows(sqlmock.NewRows(requestColumns).AddRow("req-123", "vmDeploy", "New", "workspace-001", time.Now(), false))
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests` SET `completed_at`=\\?,`locked_vm_id`=\\?,`request_status`=\\? WHERE request_id = \\? AND workspace_id = \\? AND request_status = \\?").
+			WithArgs(sqlmock.AnyArg(), nil, "Cancelled", "req-123", "workspace-001", "New").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_deploy_instances` SET `completed_at`=\\?,`vm_status`=\\? WHERE request_id = \\? AND vm_status = \\?").
+			WithArgs(sqlmock.AnyArg(), "Cancelled", "req-123", "New", "workspace-001").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		result, err := repo.CancelVMRequest(ctx, "req-123")
 
 		assert.Nil(t, err)
-		assert.Len(t, requests, 1)
-		assert.Empty(t, instances)
+		assert.Equal(t, "Cancelled", result.RequestStatus)
+		assert.NotNil(t, result.CompletedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
+
+	t.Run("Inprogress request is flagged for the executor", func(t *testing.T) {
+		mockDB, mock := setup(t)
+
+		mock.ExpectQuery("SELECT .* FROM `vm_requests`").
+			WillReturnRows(sqlmock.NewRows(requestColumns).AddRow("req-123", "vmDeploy", "Inprogress", "workspace-001", time.Now(), false))
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests` SET `cancel_requested`=\\? WHERE request_id = \\? AND workspace_id = \\? AND request_status = \\?").
+			WithArgs(true, "req-123", "workspace-001", "Inprogress").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		result, err := repo.CancelVMRequest(ctx, "req-123")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Inprogress", result.RequestStatus)
+		assert.True(t, result.CancelRequested)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Repeated cancel of an Inprogress request is a no-op", func(t *testing.T) {
+		mockDB, mock := setup(t)
+
+		mock.ExpectQuery("SELECT .* FROM `vm_requests`").
+			WillReturnRows(sqlmock.NewRows(requestColumns).AddRow("req-123", "vmDeploy", "Inprogress", "workspace-001", time.Now(), true))
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		result, err := repo.CancelVMRequest(ctx, "req-123")
+
+		assert.Nil(t, err)
+		assert.True(t, result.CancelRequested)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Request claimed while cancelling is flagged instead", func(t *testing.T) {
+		mockDB, mock := setup(t)
+
+		mock.ExpectQuery("SELECT .* FROM `vm_requests`").
+			WillReturnRows(sqlmock.NewRows(requestColumns).AddRow("req-123", "vmDeploy", "New", "workspace-001", time.Now(), false))
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests` SET `completed_at`").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		mock.ExpectQuery("SELECT .* FROM `vm_requests`").
+			WillReturnRows(sqlmock.NewRows(requestColumns).AddRow("req-123", "vmDeploy", "Inprogress", "workspace-001", time.Now(), false))
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `vm_requests` SET `cancel_requested`").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		result, err := repo.CancelVMRequest(ctx, "req-123")
+
+		assert.Nil(t, err)
+		assert.True(t, result.CancelRequested)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	for _, status := range []constants.RequestStatus{constants.StatusSuccess, constants.StatusFailure, constants.StatusCancelled} {
+		status := status
+		t.Run(fmt.Sprintf("%s request cannot be cancelled", status), func(t *testing.T) {
+			mockDB, mock := setup(t)
+
+			mock.ExpectQuery("SELECT .* FROM `vm_requests`").
+				WillReturnRows(sqlmock.NewRows(requestColumns).AddRow("req-123", "vmDeploy", string(status), "workspace-001", time.Now(), false))
+
+			repo := repo.NewVMRepository(mockDB, mockLogger)
+			result, err := repo.CancelVMRequest(ctx, "req-123")
+
+			assert.Nil(t, result)
+			assert.NotNil(t, err)
+			assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	t.Run("Record not found", func(t *testing.T) {
+		mockDB, mock := setup(t)
+
+		mock.ExpectQuery("SELECT .* FROM `vm_requests`").
+			WillReturnError(gorm.ErrRecordNotFound)
+
+		repo := repo.NewVMRepository(mockDB, mockLogger)
+		_, err := repo.CancelVMRequest(ctx, "req-123")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.SQLRecordNotFoundErrorCode, err.ErrorCode)
+	})
+}
+
+func TestIsVMRequestCancelRequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mock_db.NewMockDatabase(ctrl)
+	mockLogger := &mock_logger.StubLogger{}
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	sqlDB, mock, _ := sqlmock.New()
+	defer sqlDB.Close()
+
+	gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+
+	mockDB.EXPECT().GetWriter().Return(gormDB)
+
+	mock.ExpectQuery("SELECT `cancel_requested` FROM `vm_requests` WHERE request_id = \\? AND workspace_id = \\?").
+		WithArgs("req-123", "workspace-001", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"cancel_requested"}).AddRow(true))
+
+	repo := repo.NewVMRepository(mockDB, mockLogger)
+	cancelled, err := repo.IsVMRequestCancelRequested(ctx, "req-123")
+
+	assert.Nil(t, err)
+	assert.True(t, cancelled)
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"time"
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/internal/repo"
+	"vm/pkg/constants"
+	"vm/pkg/db"
+)
+
+// FindIdempotentVMRequest returns the request created by an earlier call with the same
+// Idempotency-Key, or nil when the key has not been used or has expired. Reusing a key for a
+// different operation or body, or while its first call is still running, is a conflict.
+func (s *vmService) FindIdempotentVMRequest(ctx context.Context, key string, operation constants.OperationType, fingerprint string) (*modals.VMRequest, *dto.ApiResponseError) {
+	s.logger.Info(constants.Internal, constants.Api, "FindIdempotentVMRequest service function invoked", map[constants.ExtraKey]interface{}{
+		"operation": operation,
+	})
+
+	record, err := s.vmRepo.GetIdempotencyKey(ctx, key)
+	if err != nil {
+		if err.ErrorCode == constants.SQLRecordNotFoundErrorCode {
+			return nil, nil
+		}
+		return nil, err
+	}
+	age := time.Since(record.CreatedAt)
+	if age > repo.IdempotencyKeyRetention || (record.RequestID == "" && age > repo.IdempotencyReservationTTL) {
+		// Reserving the key again deletes the old record.
+		return nil, nil
+	}
+	if record.Operation != string(operation) || record.Fingerprint != fingerprint {
+		s.logger.Warn(constants.Internal, constants.Api, "Idempotency-Key reused with a different request", map[constants.ExtraKey]interface{}{
+			"operation": operation,
+			"requestID": record.RequestID,
+		})
+		return nil, &dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: "Idempotency-Key was already used with a different request"}
+	}
+	if record.RequestID == "" {
+		return nil, &dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: "A request with this Idempotency-Key is still being created"}
+	}
+
+	// A replay usually follows the first call closely, before its request reaches the replicas.
+	vmRequest, err := s.vmRepo.GetVMRequest(db.WithPrimary(ctx), record.RequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(constants.Internal, constants.Api, "Replaying VM request for Idempotency-Key", map[constants.ExtraKey]interface{}{
+		"requestID": vmRequest.RequestID,
+	})
+	return vmRequest, nil
+}
+
+// CreateIdempotentVMRequest creates a VM request the way CreateVMRequest does and records
+// it against key. The key is reserved, the request created and the key linked to it in one
+// transaction, so when two calls race only one creates a request and the other gets the
+// winner's request, and a failed call leaves the key free for a retry.
+func (s *vmService) CreateIdempotentVMRequest(ctx context.Context, key, fingerprint string, operation constants.OperationType, status constants.RequestStatus, metadata string) (*modals.VMRequest, *dto.ApiResponseError) {
+	s.logger.Info(constants.Internal, constants.Api, "CreateIdempotentVMRequest service function invoked", map[constants.ExtraKey]interface{}{
+		"operation": operation,
+	})
+
+	reservation := &modals.IdempotencyKey{
+		IdempotencyKey: key,
+		Operation:      string(operation),
+		Fingerprint:    fingerprint,
+	}
+	var vmRequest *modals.VMRequest
+	reserved := false
+	err := s.vmRepo.WithTransaction(ctx, func(tx repo.VMRepository) *dto.ApiResponseError {
+		if err := tx.ReserveIdempotencyKey(ctx, reservation); err != nil {
+			return err
+		}
+		reserved = true
+
+		created, err := (&vmService{vmRepo: tx, logger: s.logger}).CreateVMRequest(ctx, operation, status, metadata)
+		if err != nil {
+			return err
+		}
+		if err := tx.CompleteIdempotencyKey(ctx, key, created.RequestID); err != nil {
+			s.logger.Error(constants.Internal, constants.Api, "Failed to record VM request for Idempotency-Key", map[constants.ExtraKey]interface{}{
+				"requestID": created.RequestID,
+				"error":     err.Message,
+			})
+			return err
+		}
+		vmRequest = created
+		return nil
+	})
+	if err == nil {
+		return vmRequest, nil
+	}
+	if reserved || err.ErrorCode != constants.LoadStatusConflictErrorCode {
+		return nil, err
+	}
+
+	// Another call with this key got there first.
+	vmRequest, findErr := s.FindIdempotentVMRequest(ctx, key, operation, fingerprint)
+	if findErr != nil {
+		return nil, findErr
+	}
+	if vmRequest == nil {
+		return nil, err
+	}
+	return vmRequest, nil
+}
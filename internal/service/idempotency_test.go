@@ -0,0 +1,178 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/internal/repo"
+	"vm/internal/service"
+
+	mock_repo "vm/internal/repo/mock"
+	"vm/pkg/constants"
+	"vm/pkg/db"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+func TestFindIdempotentVMRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repo.NewMockVMRepository(ctrl)
+	vmSvc := service.NewVMService(mockRepo, &mock_logger.StubLogger{})
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	record := func(requestID, fingerprint string) *modals.IdempotencyKey {
+		return &modals.IdempotencyKey{IdempotencyKey: "key-1", Operation: string(constants.VMPowerOn), Fingerprint: fingerprint, RequestID: requestID, CreatedAt: time.Now()}
+	}
+
+	t.Run("Unused key", func(t *testing.T) {
+		mockRepo.EXPECT().GetIdempotencyKey(ctx, "key-1").
+			Return(nil, &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode})
+
+		req, err := vmSvc.FindIdempotentVMRequest(ctx, "key-1", constants.VMPowerOn, "fp-1")
+		assert.Nil(t, err)
+		assert.Nil(t, req)
+	})
+
+	t.Run("Replay returns the original request", func(t *testing.T) {
+		mockRepo.EXPECT().GetIdempotencyKey(ctx, "key-1").Return(record("req-001", "fp-1"), nil)
+		mockRepo.EXPECT().GetVMRequest(db.WithPrimary(ctx), "req-001").Return(&modals.VMRequest{RequestID: "req-001"}, nil)
+
+		req, err := vmSvc.FindIdempotentVMRequest(ctx, "key-1", constants.VMPowerOn, "fp-1")
+		assert.Nil(t, err)
+		assert.Equal(t, "req-001", req.RequestID)
+	})
+
+	t.Run("Different body is a conflict", func(t *testing.T) {
+		mockRepo.EXPECT().GetIdempotencyKey(ctx, "key-1").Return(record("req-001", "fp-1"), nil)
+
+		req, err := vmSvc.FindIdempotentVMRequest(ctx, "key-1", constants.VMPowerOn, "fp-2")
+		assert.Nil(t, req)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Different operation is a conflict", func(t *testing.T) {
+		mockRepo.EXPECT().GetIdempotencyKey(ctx, "key-1").Return(record("req-001", "fp-1"), nil)
+
+		req, err := vmSvc.FindIdempotentVMRequest(ctx, "key-1", constants.VMDelete, "fp-1")
+		assert.Nil(t, req)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Key still being created is a conflict", func(t *testing.T) {
+		mockRepo.EXPECT().GetIdempotencyKey(ctx, "key-1").Return(record("", "fp-1"), nil)
+
+		req, err := vmSvc.FindIdempotentVMRequest(ctx, "key-1", constants.VMPowerOn, "fp-1")
+		assert.Nil(t, req)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Abandoned reservation is unused", func(t *testing.T) {
+		abandoned := record("", "fp-1")
+		abandoned.CreatedAt = time.Now().Add(-2 * repo.IdempotencyReservationTTL)
+		mockRepo.EXPECT().GetIdempotencyKey(ctx, "key-1").Return(abandoned, nil)
+
+		req, err := vmSvc.FindIdempotentVMRequest(ctx, "key-1", constants.VMPowerOn, "fp-1")
+		assert.Nil(t, err)
+		assert.Nil(t, req)
+	})
+
+	t.Run("Expired key is unused", func(t *testing.T) {
+		expired := record("req-001", "fp-2")
+		expired.CreatedAt = time.Now().Add(-repo.IdempotencyKeyRetention - time.Minute)
+		mockRepo.EXPECT().GetIdempotencyKey(ctx, "key-1").Return(expired, nil)
+
+		req, err := vmSvc.FindIdempotentVMRequest(ctx, "key-1", constants.VMPowerOn, "fp-1")
+		assert.Nil(t, err)
+		assert.Nil(t, req)
+	})
+}
+
+func TestCreateIdempotentVMRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repo.NewMockVMRepository(ctrl)
+	vmSvc := service.NewVMService(mockRepo, &mock_logger.StubLogger{})
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+	metadata := `{"VMID":"vm-1"}`
+
+	t.Run("Reserves the key, creates the request and links them in one transaction", func(t *testing.T) {
+		gomock.InOrder(
+			expectTransaction(mockRepo),
+			mockRepo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, key *modals.IdempotencyKey) *dto.ApiResponseError {
+				assert.Equal(t, "key-1", key.IdempotencyKey)
+				assert.Equal(t, string(constants.VMPowerOn), key.Operation)
+				assert.Equal(t, "fp-1", key.Fingerprint)
+				return nil
+			}),
+			expectTransaction(mockRepo),
+			mockRepo.EXPECT().CreateVMRequest(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, req *modals.VMRequest) *dto.ApiResponseError {
+				req.RequestID = "req-001"
+				return nil
+			}),
+			mockRepo.EXPECT().CompleteIdempotencyKey(ctx, "key-1", "req-001").Return(nil),
+		)
+
+		req, err := vmSvc.CreateIdempotentVMRequest(ctx, "key-1", "fp-1", constants.VMPowerOn, constants.StatusNew, metadata)
+		assert.Nil(t, err)
+		assert.Equal(t, "req-001", req.RequestID)
+	})
+
+	t.Run("Losing a race replays the winner's request", func(t *testing.T) {
+		expectTransaction(mockRepo)
+		mockRepo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).
+			Return(&dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode})
+		mockRepo.EXPECT().GetIdempotencyKey(ctx, "key-1").
+			Return(&modals.IdempotencyKey{Operation: string(constants.VMPowerOn), Fingerprint: "fp-1", RequestID: "req-001", CreatedAt: time.Now()}, nil)
+		mockRepo.EXPECT().GetVMRequest(db.WithPrimary(ctx), "req-001").Return(&modals.VMRequest{RequestID: "req-001"}, nil)
+
+		req, err := vmSvc.CreateIdempotentVMRequest(ctx, "key-1", "fp-1", constants.VMPowerOn, constants.StatusNew, metadata)
+		assert.Nil(t, err)
+		assert.Equal(t, "req-001", req.RequestID)
+	})
+
+	t.Run("Failed creation rolls the reservation back", func(t *testing.T) {
+		expectTransaction(mockRepo).Times(2)
+		mockRepo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).Return(nil)
+		mockRepo.EXPECT().CreateVMRequest(ctx, gomock.Any()).
+			Return(&dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "db down"})
+
+		req, err := vmSvc.CreateIdempotentVMRequest(ctx, "key-1", "fp-1", constants.VMPowerOn, constants.StatusNew, metadata)
+		assert.Nil(t, req)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Conflict creating the request is not a lost race", func(t *testing.T) {
+		expectTransaction(mockRepo).Times(2)
+		mockRepo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).Return(nil)
+		mockRepo.EXPECT().CreateVMRequest(ctx, gomock.Any()).
+			Return(&dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: "VM is locked"})
+
+		req, err := vmSvc.CreateIdempotentVMRequest(ctx, "key-1", "fp-1", constants.VMPowerOn, constants.StatusNew, metadata)
+		assert.Nil(t, req)
+		assert.Equal(t, "VM is locked", err.Message)
+	})
+
+	t.Run("Failing to link the key fails the call", func(t *testing.T) {
+		expectTransaction(mockRepo).Times(2)
+		mockRepo.EXPECT().ReserveIdempotencyKey(ctx, gomock.Any()).Return(nil)
+		mockRepo.EXPECT().CreateVMRequest(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, req *modals.VMRequest) *dto.ApiResponseError {
+			req.RequestID = "req-001"
+			return nil
+		})
+		mockRepo.EXPECT().CompleteIdempotencyKey(ctx, "key-1", "req-001").
+			Return(&dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "db down"})
+
+		req, err := vmSvc.CreateIdempotentVMRequest(ctx, "key-1", "fp-1", constants.VMPowerOn, constants.StatusNew, metadata)
+		assert.Nil(t, req)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+	})
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/pkg/constants"
+)
+
+// GetInventoryVM returns the inventory record of vmID, or nil when the VM is not in the
+// inventory, for example because it was created before the inventory existed.
+func (s *vmService) GetInventoryVM(ctx context.Context, vmID string) (*modals.VirtualMachine, *dto.ApiResponseError) {
+	vm, err := s.vmRepo.GetVirtualMachine(ctx, vmID)
+	if err != nil {
+		if err.ErrorCode == constants.SQLRecordNotFoundErrorCode {
+			return nil, nil
+		}
+		s.logger.Error(constants.Internal, constants.Api, "Failed to get inventory VM", map[constants.ExtraKey]interface{}{
+			"vmID":  vmID,
+			"error": err.Message,
+		})
+		return nil, err
+	}
+	return vm, nil
+}
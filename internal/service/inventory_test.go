@@ -0,0 +1,57 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/internal/service"
+
+	mock_repo "vm/internal/repo/mock"
+	"vm/pkg/constants"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+func TestGetInventoryVM(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repo.NewMockVMRepository(ctrl)
+	vmSvc := service.NewVMService(mockRepo, &mock_logger.StubLogger{})
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	t.Run("Returns the inventory record", func(t *testing.T) {
+		mockRepo.EXPECT().GetVirtualMachine(ctx, "vm-001").
+			Return(&modals.VirtualMachine{VMID: "vm-001", State: string(constants.VMStateActive)}, nil)
+
+		vm, err := vmSvc.GetInventoryVM(ctx, "vm-001")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "vm-001", vm.VMID)
+	})
+
+	t.Run("A VM missing from the inventory is not an error", func(t *testing.T) {
+		mockRepo.EXPECT().GetVirtualMachine(ctx, "vm-404").
+			Return(nil, &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "VM not found in inventory"})
+
+		vm, err := vmSvc.GetInventoryVM(ctx, "vm-404")
+
+		assert.Nil(t, err)
+		assert.Nil(t, vm)
+	})
+
+	t.Run("Repository failure", func(t *testing.T) {
+		mockRepo.EXPECT().GetVirtualMachine(ctx, "vm-001").
+			Return(nil, &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "db error"})
+
+		vm, err := vmSvc.GetInventoryVM(ctx, "vm-001")
+
+		assert.Nil(t, vm)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+	})
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: vm_service.go
+// Source: internal/service/vm_service.go
 
 // Package mock_service is a generated GoMock package.
 package mock_service
@@ -37,6 +37,36 @@ func (m *MockVMService) EXPECT() *MockVMServiceMockRecorder {
 	return m.recorder
 }
 
+// CancelVMRequest mocks base method.
+func (m *MockVMService) CancelVMRequest(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelVMRequest", ctx, requestID)
+	ret0, _ := ret[0].(*modals.VMRequest)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// CancelVMRequest indicates an expected call of CancelVMRequest.
+func (mr *MockVMServiceMockRecorder) CancelVMRequest(ctx, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVMRequest", reflect.TypeOf((*MockVMService)(nil).CancelVMRequest), ctx, requestID)
+}
+
+// CreateIdempotentVMRequest mocks base method.
+func (m *MockVMService) CreateIdempotentVMRequest(ctx context.Context, key, fingerprint string, operation constants.OperationType, status constants.RequestStatus, metadata string) (*modals.VMRequest, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIdempotentVMRequest", ctx, key, fingerprint, operation, status, metadata)
+	ret0, _ := ret[0].(*modals.VMRequest)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// CreateIdempotentVMRequest indicates an expected call of CreateIdempotentVMRequest.
+func (mr *MockVMServiceMockRecorder) CreateIdempotentVMRequest(ctx, key, fingerprint, operation, status, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIdempotentVMRequest", reflect.TypeOf((*MockVMService)(nil).CreateIdempotentVMRequest), ctx, key, fingerprint, operation, status, metadata)
+}
+
 // CreateVMRequest mocks base method.
 func (m *MockVMService) CreateVMRequest(ctx context.Context, operation constants.OperationType, status constants.RequestStatus, metadata string) (*modals.VMRequest, *dto.ApiResponseError) {
 	m.ctrl.T.Helper()
@@ -52,10 +82,25 @@ func (mr *MockVMServiceMockRecorder) CreateVMRequest(ctx, operation, status, met
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVMRequest", reflect.TypeOf((*MockVMService)(nil).CreateVMRequest), ctx, operation, status, metadata)
 }
 
+// FindIdempotentVMRequest mocks base method.
+func (m *MockVMService) FindIdempotentVMRequest(ctx context.Context, key string, operation constants.OperationType, fingerprint string) (*modals.VMRequest, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdempotentVMRequest", ctx, key, operation, fingerprint)
+	ret0, _ := ret[0].(*modals.VMRequest)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// FindIdempotentVMRequest indicates an expected call of FindIdempotentVMRequest.
+func (mr *MockVMServiceMockRecorder) FindIdempotentVMRequest(ctx, key, operation, fingerprint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdempotentVMRequest", reflect.TypeOf((*MockVMService)(nil).FindIdempotentVMRequest), ctx, key, operation, fingerprint)
+}
+
 // GetAllVMRequestsWithInstances mocks base method.
-func (m *MockVMService) GetAllVMRequestsWithInstances(ctx context.Context) ([]*modals.VMRequest, []*modals.VMDeployInstance, int, int, *dto.ApiResponseError) {
+func (m *MockVMService) GetAllVMRequestsWithInstances(ctx context.Context, filter dto.VMRequestFilter) ([]*modals.VMRequest, []*modals.VMDeployInstance, int, int, *dto.ApiResponseError) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllVMRequestsWithInstances", ctx)
+	ret := m.ctrl.Call(m, "GetAllVMRequestsWithInstances", ctx, filter)
 	ret0, _ := ret[0].([]*modals.VMRequest)
 	ret1, _ := ret[1].([]*modals.VMDeployInstance)
 	ret2, _ := ret[2].(int)
@@ -65,9 +110,39 @@ func (m *MockVMService) GetAllVMRequestsWithInstances(ctx context.Context) ([]*m
 }
 
 // GetAllVMRequestsWithInstances indicates an expected call of GetAllVMRequestsWithInstances.
-func (mr *MockVMServiceMockRecorder) GetAllVMRequestsWithInstances(ctx interface{}) *gomock.Call {
+func (mr *MockVMServiceMockRecorder) GetAllVMRequestsWithInstances(ctx, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllVMRequestsWithInstances", reflect.TypeOf((*MockVMService)(nil).GetAllVMRequestsWithInstances), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllVMRequestsWithInstances", reflect.TypeOf((*MockVMService)(nil).GetAllVMRequestsWithInstances), ctx, filter)
+}
+
+// GetInventoryVM mocks base method.
+func (m *MockVMService) GetInventoryVM(ctx context.Context, vmID string) (*modals.VirtualMachine, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventoryVM", ctx, vmID)
+	ret0, _ := ret[0].(*modals.VirtualMachine)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// GetInventoryVM indicates an expected call of GetInventoryVM.
+func (mr *MockVMServiceMockRecorder) GetInventoryVM(ctx, vmID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventoryVM", reflect.TypeOf((*MockVMService)(nil).GetInventoryVM), ctx, vmID)
+}
+
+// GetVM mocks base method.
+func (m *MockVMService) GetVM(ctx context.Context, vmID string) (*dto.VMRecord, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVM", ctx, vmID)
+	ret0, _ := ret[0].(*dto.VMRecord)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// GetVM indicates an expected call of GetVM.
+func (mr *MockVMServiceMockRecorder) GetVM(ctx, vmID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVM", reflect.TypeOf((*MockVMService)(nil).GetVM), ctx, vmID)
 }
 
 // GetVMDeployInstances mocks base method.
@@ -99,3 +174,49 @@ func (mr *MockVMServiceMockRecorder) GetVMRequest(ctx, requestID interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMRequest", reflect.TypeOf((*MockVMService)(nil).GetVMRequest), ctx, requestID)
 }
+
+// GetVMRequestRetry mocks base method.
+func (m *MockVMService) GetVMRequestRetry(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVMRequestRetry", ctx, requestID)
+	ret0, _ := ret[0].(*modals.VMRequest)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// GetVMRequestRetry indicates an expected call of GetVMRequestRetry.
+func (mr *MockVMServiceMockRecorder) GetVMRequestRetry(ctx, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMRequestRetry", reflect.TypeOf((*MockVMService)(nil).GetVMRequestRetry), ctx, requestID)
+}
+
+// ListVMs mocks base method.
+func (m *MockVMService) ListVMs(ctx context.Context, limit, offset int) ([]*dto.VMRecord, int, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVMs", ctx, limit, offset)
+	ret0, _ := ret[0].([]*dto.VMRecord)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(*dto.ApiResponseError)
+	return ret0, ret1, ret2
+}
+
+// ListVMs indicates an expected call of ListVMs.
+func (mr *MockVMServiceMockRecorder) ListVMs(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMs", reflect.TypeOf((*MockVMService)(nil).ListVMs), ctx, limit, offset)
+}
+
+// RetryVMRequest mocks base method.
+func (m *MockVMService) RetryVMRequest(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryVMRequest", ctx, requestID)
+	ret0, _ := ret[0].(*modals.VMRequest)
+	ret1, _ := ret[1].(*dto.ApiResponseError)
+	return ret0, ret1
+}
+
+// RetryVMRequest indicates an expected call of RetryVMRequest.
+func (mr *MockVMServiceMockRecorder) RetryVMRequest(ctx, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryVMRequest", reflect.TypeOf((*MockVMService)(nil).RetryVMRequest), ctx, requestID)
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/internal/repo"
+	"vm/pkg/constants"
+	"vm/pkg/db"
+)
+
+// RetryVMRequest creates a New request that replays the failed request requestID from its
+// stored metadata. A deploy retry only replays the instances that did not deploy, under
+// their original names.
+func (s *vmService) RetryVMRequest(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError) {
+	s.logger.Info(constants.Internal, constants.Api, "RetryVMRequest service function invoked", map[constants.ExtraKey]interface{}{
+		"requestID": requestID,
+	})
+
+	// The failure being retried may have been recorded a moment ago.
+	ctx = db.WithPrimary(ctx)
+	parent, err := s.vmRepo.GetVMRequest(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if constants.RequestStatus(parent.RequestStatus) != constants.StatusFailure {
+		return nil, &dto.ApiResponseError{
+			ErrorCode: constants.LoadStatusConflictErrorCode,
+			Message:   fmt.Sprintf("VMRequest %s is %s; only %s requests can be retried", requestID, parent.RequestStatus, constants.StatusFailure),
+		}
+	}
+
+	var replay []modals.VMDeployInstance
+	if constants.OperationType(parent.Operation) == constants.VMDeploy {
+		instances, err := s.vmRepo.GetVMDeployInstances(ctx, requestID)
+		if err != nil && err.ErrorCode != constants.SQLRecordNotFoundErrorCode {
+			return nil, err
+		}
+		for _, inst := range instances {
+			if constants.RequestStatus(inst.VMStatus) == constants.StatusSuccess {
+				continue
+			}
+			replay = append(replay, modals.VMDeployInstance{
+				VMName:   inst.VMName,
+				VMStatus: string(constants.StatusNew),
+			})
+		}
+		if len(replay) == 0 {
+			return nil, &dto.ApiResponseError{
+				ErrorCode: constants.LoadStatusConflictErrorCode,
+				Message:   fmt.Sprintf("VMRequest %s has no failed deploy instances to retry", requestID),
+			}
+		}
+	}
+
+	vmID, err := targetVMID(constants.OperationType(parent.Operation), parent.RequestMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	retry := &modals.VMRequest{
+		Operation:       parent.Operation,
+		RequestStatus:   string(constants.StatusNew),
+		DatacenterId:    parent.DatacenterId,
+		RequestMetadata: parent.RequestMetadata,
+		ParentRequestID: &parent.RequestID,
+		VMID:            vmID,
+		LockedVMID:      lockedVMID(constants.OperationType(parent.Operation), vmID),
+	}
+	err = s.vmRepo.WithTransaction(ctx, func(tx repo.VMRepository) *dto.ApiResponseError {
+		if err := tx.CreateRetryVMRequest(ctx, retry); err != nil {
+			s.logger.Error(constants.Internal, constants.Api, "Failed to create retry VM request", map[constants.ExtraKey]interface{}{
+				"requestID": requestID,
+				"error":     err.Message,
+			})
+			return err
+		}
+		if len(replay) == 0 {
+			return nil
+		}
+		for i := range replay {
+			replay[i].RequestID = retry.RequestID
+		}
+		if err := tx.CreateVMDeployInstances(ctx, replay); err != nil {
+			s.logger.Error(constants.Internal, constants.Api, "Failed to create VM deploy instances", map[constants.ExtraKey]interface{}{
+				"error": err.Message,
+			})
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(constants.Internal, constants.Api, "Successfully created retry VM request", map[constants.ExtraKey]interface{}{
+		"requestID":       retry.RequestID,
+		"parentRequestID": requestID,
+		"instances":       len(replay),
+	})
+	return retry, nil
+}
+
+// GetVMRequestRetry returns the request created by retrying requestID, or nil when it was not retried.
+func (s *vmService) GetVMRequestRetry(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError) {
+	retry, err := s.vmRepo.GetVMRequestRetry(ctx, requestID)
+	if err != nil {
+		if err.ErrorCode == constants.SQLRecordNotFoundErrorCode {
+			return nil, nil
+		}
+		s.logger.Error(constants.Internal, constants.Api, "Failed to get retry VM request", map[constants.ExtraKey]interface{}{
+			"requestID": requestID,
+			"error":     err.Message,
+		})
+		return nil, err
+	}
+	return retry, nil
+}
@@ -0,0 +1,161 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/internal/service"
+
+	mock_repo "vm/internal/repo/mock"
+	"vm/pkg/constants"
+	"vm/pkg/db"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+func TestRetryVMRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repo.NewMockVMRepository(ctrl)
+	vmSvc := service.NewVMService(mockRepo, &mock_logger.StubLogger{})
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+	// RetryVMRequest reads the request it retries from the primary.
+	primaryCtx := db.WithPrimary(ctx)
+
+	failedRequest := func(operation constants.OperationType) *modals.VMRequest {
+		metadata := `{"VMID":"vm-001"}`
+		if operation == constants.VMDeploy {
+			metadata = `{"vmConfig":{"name":"web","numberOfVms":3}}`
+		}
+		return &modals.VMRequest{
+			RequestID:       "req-100",
+			Operation:       string(operation),
+			RequestStatus:   string(constants.StatusFailure),
+			DatacenterId:    "dc-001",
+			RequestMetadata: metadata,
+		}
+	}
+
+	t.Run("Deploy retry replays only the instances that did not deploy", func(t *testing.T) {
+		mockRepo.EXPECT().GetVMRequest(primaryCtx, "req-100").Return(failedRequest(constants.VMDeploy), nil)
+		mockRepo.EXPECT().GetVMDeployInstances(primaryCtx, "req-100").Return([]*modals.VMDeployInstance{
+			{RequestID: "req-100", VMName: "web_1", VMStatus: string(constants.StatusSuccess), VMID: "vm-1"},
+			{RequestID: "req-100", VMName: "web_2", VMStatus: string(constants.StatusFailure), VMStateMessage: "datastore full"},
+			{RequestID: "req-100", VMName: "web_3", VMStatus: string(constants.StatusFailure)},
+		}, nil)
+		expectTransaction(mockRepo)
+		mockRepo.EXPECT().CreateRetryVMRequest(primaryCtx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *modals.VMRequest) *dto.ApiResponseError {
+				assert.Equal(t, "req-100", *req.ParentRequestID)
+				assert.Equal(t, string(constants.StatusNew), req.RequestStatus)
+				assert.Equal(t, `{"vmConfig":{"name":"web","numberOfVms":3}}`, req.RequestMetadata)
+				assert.Equal(t, "dc-001", req.DatacenterId)
+				req.RequestID = "req-200"
+				return nil
+			})
+		mockRepo.EXPECT().CreateVMDeployInstances(primaryCtx, []modals.VMDeployInstance{
+			{RequestID: "req-200", VMName: "web_2", VMStatus: string(constants.StatusNew)},
+			{RequestID: "req-200", VMName: "web_3", VMStatus: string(constants.StatusNew)},
+		}).Return(nil)
+
+		retry, err := vmSvc.RetryVMRequest(ctx, "req-100")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "req-200", retry.RequestID)
+	})
+
+	t.Run("Single VM operation is replayed as is", func(t *testing.T) {
+		mockRepo.EXPECT().GetVMRequest(primaryCtx, "req-100").Return(failedRequest(constants.VMPowerOn), nil)
+		expectTransaction(mockRepo)
+		mockRepo.EXPECT().CreateRetryVMRequest(primaryCtx, gomock.Any()).Return(nil)
+
+		retry, err := vmSvc.RetryVMRequest(ctx, "req-100")
+
+		assert.Nil(t, err)
+		assert.Equal(t, string(constants.VMPowerOn), retry.Operation)
+		if assert.NotNil(t, retry.LockedVMID) {
+			assert.Equal(t, "vm-001", *retry.LockedVMID)
+		}
+	})
+
+	t.Run("Only failed requests can be retried", func(t *testing.T) {
+		for _, status := range []constants.RequestStatus{constants.StatusNew, constants.StatusInProgress, constants.StatusSuccess, constants.StatusCancelled} {
+			req := failedRequest(constants.VMPowerOn)
+			req.RequestStatus = string(status)
+			mockRepo.EXPECT().GetVMRequest(primaryCtx, "req-100").Return(req, nil)
+
+			retry, err := vmSvc.RetryVMRequest(ctx, "req-100")
+
+			assert.Nil(t, retry)
+			assert.NotNil(t, err)
+			assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode, status)
+		}
+	})
+
+	t.Run("Deploy without failed instances cannot be retried", func(t *testing.T) {
+		mockRepo.EXPECT().GetVMRequest(primaryCtx, "req-100").Return(failedRequest(constants.VMDeploy), nil)
+		mockRepo.EXPECT().GetVMDeployInstances(primaryCtx, "req-100").Return([]*modals.VMDeployInstance{
+			{RequestID: "req-100", VMName: "web_1", VMStatus: string(constants.StatusSuccess)},
+		}, nil)
+
+		_, err := vmSvc.RetryVMRequest(ctx, "req-100")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Request that was already retried", func(t *testing.T) {
+		mockRepo.EXPECT().GetVMRequest(primaryCtx, "req-100").Return(failedRequest(constants.VMPowerOn), nil)
+		expectTransaction(mockRepo)
+		mockRepo.EXPECT().CreateRetryVMRequest(primaryCtx, gomock.Any()).
+			Return(&dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: "VMRequest req-100 was already retried"})
+
+		_, err := vmSvc.RetryVMRequest(ctx, "req-100")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Unknown request", func(t *testing.T) {
+		mockRepo.EXPECT().GetVMRequest(primaryCtx, "req-100").
+			Return(nil, &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "VMRequest not found"})
+
+		_, err := vmSvc.RetryVMRequest(ctx, "req-100")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.SQLRecordNotFoundErrorCode, err.ErrorCode)
+	})
+}
+
+func TestGetVMRequestRetry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repo.NewMockVMRepository(ctrl)
+	vmSvc := service.NewVMService(mockRepo, &mock_logger.StubLogger{})
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	t.Run("Not retried", func(t *testing.T) {
+		mockRepo.EXPECT().GetVMRequestRetry(ctx, "req-100").
+			Return(nil, &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode})
+
+		retry, err := vmSvc.GetVMRequestRetry(ctx, "req-100")
+		assert.Nil(t, err)
+		assert.Nil(t, retry)
+	})
+
+	t.Run("Lookup error", func(t *testing.T) {
+		mockRepo.EXPECT().GetVMRequestRetry(ctx, "req-100").
+			Return(nil, &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "db error"})
+
+		_, err := vmSvc.GetVMRequestRetry(ctx, "req-100")
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+	})
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/pkg/constants"
+)
+
+// ListVMs returns one page of the VMs deployed in the caller's workspace with their request
+// history, and the total number of deployed VMs.
+func (s *vmService) ListVMs(ctx context.Context, limit, offset int) ([]*dto.VMRecord, int, *dto.ApiResponseError) {
+	s.logger.Info(constants.Internal, constants.Api, "ListVMs service function invoked", nil)
+
+	if limit <= 0 {
+		limit = DefaultPageLimit
+	} else if limit > MaxPageLimit {
+		limit = MaxPageLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	instances, total, err := s.vmRepo.GetDeployedVMs(ctx, limit, offset)
+	if err != nil {
+		s.logger.Error(constants.Internal, constants.Api, "Failed to list deployed VMs", map[constants.ExtraKey]interface{}{
+			"error": err.Message,
+		})
+		return nil, 0, err
+	}
+
+	records, err := s.vmRecords(ctx, instances)
+	if err != nil {
+		return nil, 0, err
+	}
+	return records, int(total), nil
+}
+
+// GetVM returns the deployed VM vmID with its request history.
+func (s *vmService) GetVM(ctx context.Context, vmID string) (*dto.VMRecord, *dto.ApiResponseError) {
+	s.logger.Info(constants.Internal, constants.Api, "GetVM service function invoked", map[constants.ExtraKey]interface{}{
+		"vmID": vmID,
+	})
+
+	instance, err := s.vmRepo.GetDeployedVM(ctx, vmID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.vmRecords(ctx, []*modals.VMDeployInstance{instance})
+	if err != nil {
+		return nil, err
+	}
+	return records[0], nil
+}
+
+// vmRecords attaches to each instance the requests that targeted its VM.
+func (s *vmService) vmRecords(ctx context.Context, instances []*modals.VMDeployInstance) ([]*dto.VMRecord, *dto.ApiResponseError) {
+	records := make([]*dto.VMRecord, len(instances))
+	byVMID := make(map[string]*dto.VMRecord, len(instances))
+	vmIDs := make([]string, 0, len(instances))
+	for i, inst := range instances {
+		records[i] = &dto.VMRecord{Instance: inst}
+		byVMID[inst.VMID] = records[i]
+		vmIDs = append(vmIDs, inst.VMID)
+	}
+
+	requests, err := s.vmRepo.GetVMRequestsForVMs(ctx, vmIDs)
+	if err != nil {
+		s.logger.Error(constants.Internal, constants.Api, "Failed to get VM request history", map[constants.ExtraKey]interface{}{
+			"error": err.Message,
+		})
+		return nil, err
+	}
+	for _, req := range requests {
+		if record, ok := byVMID[req.VMID]; ok {
+			record.Requests = append(record.Requests, req)
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	"vm/internal/service"
+
+	mock_repo "vm/internal/repo/mock"
+	"vm/pkg/constants"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+func TestListVMs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repo.NewMockVMRepository(ctrl)
+	vmSvc := service.NewVMService(mockRepo, &mock_logger.StubLogger{})
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	t.Run("Attaches each request to the VM it targets", func(t *testing.T) {
+		instances := []*modals.VMDeployInstance{
+			{RequestID: "req-1", VMName: "web_1", VMID: "vm-1", VMStatus: string(constants.StatusSuccess)},
+			{RequestID: "req-1", VMName: "web_2", VMID: "vm-2", VMStatus: string(constants.StatusSuccess)},
+		}
+		mockRepo.EXPECT().GetDeployedVMs(ctx, 50, 0).Return(instances, int64(2), nil)
+		mockRepo.EXPECT().GetVMRequestsForVMs(ctx, []string{"vm-1", "vm-2"}).Return([]*modals.VMRequest{
+			{RequestID: "req-3", Operation: string(constants.VMPowerOff), VMID: "vm-2"},
+			{RequestID: "req-2", Operation: string(constants.VMReconfigure), VMID: "vm-1"},
+			// A request for a VM not on the page is ignored.
+			{RequestID: "req-4", Operation: string(constants.VMPowerOn), VMID: "vm-10"},
+		}, nil)
+
+		records, total, err := vmSvc.ListVMs(ctx, 0, -1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, total)
+		assert.Len(t, records, 2)
+		assert.Equal(t, "vm-1", records[0].Instance.VMID)
+		assert.Len(t, records[0].Requests, 1)
+		assert.Equal(t, "req-2", records[0].Requests[0].RequestID)
+		assert.Len(t, records[1].Requests, 1)
+		assert.Equal(t, "req-3", records[1].Requests[0].RequestID)
+	})
+
+	t.Run("Caps the page size", func(t *testing.T) {
+		mockRepo.EXPECT().GetDeployedVMs(ctx, service.MaxPageLimit, 10).Return([]*modals.VMDeployInstance{}, int64(0), nil)
+		mockRepo.EXPECT().GetVMRequestsForVMs(ctx, []string{}).Return([]*modals.VMRequest{}, nil)
+
+		records, _, err := vmSvc.ListVMs(ctx, 10000, 10)
+
+		assert.Nil(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("Repository failure", func(t *testing.T) {
+		mockRepo.EXPECT().GetDeployedVMs(ctx, 50, 0).
+			Return(nil, int64(0), &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "db error"})
+
+		_, _, err := vmSvc.ListVMs(ctx, 50, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+	})
+}
+
+func TestGetVM(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repo.NewMockVMRepository(ctrl)
+	vmSvc := service.NewVMService(mockRepo, &mock_logger.StubLogger{})
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	t.Run("Deployed VM", func(t *testing.T) {
+		instance := &modals.VMDeployInstance{RequestID: "req-1", VMName: "web_1", VMID: "vm-1", VMStatus: string(constants.StatusSuccess)}
+		mockRepo.EXPECT().GetDeployedVM(ctx, "vm-1").Return(instance, nil)
+		mockRepo.EXPECT().GetVMRequestsForVMs(ctx, []string{"vm-1"}).Return([]*modals.VMRequest{
+			{RequestID: "req-2", Operation: string(constants.VMDelete), VMID: "vm-1"},
+		}, nil)
+
+		record, err := vmSvc.GetVM(ctx, "vm-1")
+
+		assert.Nil(t, err)
+		assert.Equal(t, instance, record.Instance)
+		assert.Len(t, record.Requests, 1)
+	})
+
+	t.Run("VM not deployed in the workspace", func(t *testing.T) {
+		mockRepo.EXPECT().GetDeployedVM(ctx, "vm-9").
+			Return(nil, &dto.ApiResponseError{ErrorCode: constants.SQLRecordNotFoundErrorCode, Message: "VM not found"})
+
+		_, err := vmSvc.GetVM(ctx, "vm-9")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.SQLRecordNotFoundErrorCode, err.ErrorCode)
+	})
+}
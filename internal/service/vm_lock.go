@@ -0,0 +1,33 @@
+package service
+
+import (
+	"encoding/json"
+	dto "vm/internal/dtos"
+	"vm/pkg/constants"
+)
+
+// targetVMID returns the VM a request for operation targets, read from its metadata. A deploy
+// creates its VMs, so it targets none.
+func targetVMID(operation constants.OperationType, metadata string) (string, *dto.ApiResponseError) {
+	if operation == constants.VMDeploy {
+		return "", nil
+	}
+	// Reconfigure metadata also carries the target under the VMID key.
+	var target dto.VMTargetMetadata
+	if err := json.Unmarshal([]byte(metadata), &target); err != nil {
+		return "", &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: err.Error()}
+	}
+	if target.VMID == "" {
+		return "", &dto.ApiResponseError{ErrorCode: constants.InternalServerErrorCode, Message: "Request metadata does not name the target VM"}
+	}
+	return target.VMID, nil
+}
+
+// lockedVMID returns the VM a request for operation locks, or nil when the operation does not
+// lock.
+func lockedVMID(operation constants.OperationType, vmID string) *string {
+	if !operation.LocksVM() {
+		return nil
+	}
+	return &vmID
+}
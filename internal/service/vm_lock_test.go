@@ -0,0 +1,82 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	dto "vm/internal/dtos"
+	"vm/internal/modals"
+	mock_repo "vm/internal/repo/mock"
+	"vm/internal/service"
+	"vm/pkg/constants"
+	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
+)
+
+func TestCreateVMRequest_VMLock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repo.NewMockVMRepository(ctrl)
+	vmSvc := service.NewVMService(mockRepo, &mock_logger.StubLogger{})
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
+
+	t.Run("Mutating operations lock their VM", func(t *testing.T) {
+		metadata := map[constants.OperationType]string{
+			constants.VMPowerOn:         `{"VMID":"vm-001"}`,
+			constants.VMPowerOff:        `{"VMID":"vm-001"}`,
+			constants.VMReset:           `{"VMID":"vm-001"}`,
+			constants.VMRestartGuestOS:  `{"VMID":"vm-001"}`,
+			constants.VMShutdownGuestOS: `{"VMID":"vm-001"}`,
+			constants.VMDelete:          `{"VMID":"vm-001","Force":true}`,
+			constants.VMReconfigure:     `{"VMID":"vm-001","spec":{}}`,
+		}
+		for operation, md := range metadata {
+			expectTransaction(mockRepo)
+			mockRepo.EXPECT().CreateVMRequest(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, req *modals.VMRequest) *dto.ApiResponseError {
+					if assert.NotNil(t, req.LockedVMID, operation) {
+						assert.Equal(t, "vm-001", *req.LockedVMID)
+					}
+					assert.Equal(t, "vm-001", req.VMID, operation)
+					return nil
+				})
+
+			_, err := vmSvc.CreateVMRequest(ctx, operation, constants.StatusNew, md)
+			assert.Nil(t, err)
+		}
+	})
+
+	t.Run("Refresh runs alongside other operations", func(t *testing.T) {
+		expectTransaction(mockRepo)
+		mockRepo.EXPECT().CreateVMRequest(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *modals.VMRequest) *dto.ApiResponseError {
+				assert.Nil(t, req.LockedVMID)
+				// It still targets the VM, and is listed in its history.
+				assert.Equal(t, "vm-001", req.VMID)
+				return nil
+			})
+
+		_, err := vmSvc.CreateVMRequest(ctx, constants.VMRefresh, constants.StatusNew, `{"VMID":"vm-001"}`)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Lock conflict is returned as is", func(t *testing.T) {
+		expectTransaction(mockRepo)
+		mockRepo.EXPECT().CreateVMRequest(ctx, gomock.Any()).
+			Return(&dto.ApiResponseError{ErrorCode: constants.LoadStatusConflictErrorCode, Message: "VM vm-001 has another operation in progress"})
+
+		_, err := vmSvc.CreateVMRequest(ctx, constants.VMPowerOff, constants.StatusNew, `{"VMID":"vm-001"}`)
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+	})
+
+	t.Run("Locking operation without a target VM", func(t *testing.T) {
+		_, err := vmSvc.CreateVMRequest(ctx, constants.VMPowerOff, constants.StatusNew, `{}`)
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
+	})
+}
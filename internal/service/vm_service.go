@@ -20,9 +20,24 @@ type VMService interface {
 	CreateVMRequest(ctx context.Context, operation constants.OperationType, status constants.RequestStatus, metadata string) (*modals.VMRequest, *dto.ApiResponseError)
 	GetVMRequest(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError)
 	GetVMDeployInstances(ctx context.Context, requestID string) ([]*modals.VMDeployInstance, *dto.ApiResponseError)
-	GetAllVMRequestsWithInstances(ctx context.Context) ([]*modals.VMRequest, []*modals.VMDeployInstance, int, int, *dto.ApiResponseError)
+	GetAllVMRequestsWithInstances(ctx context.Context, filter dto.VMRequestFilter) ([]*modals.VMRequest, []*modals.VMDeployInstance, int, int, *dto.ApiResponseError)
+	CancelVMRequest(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError)
+	RetryVMRequest(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError)
+	GetVMRequestRetry(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError)
+	ListVMs(ctx context.Context, limit, offset int) ([]*dto.VMRecord, int, *dto.ApiResponseError)
+	GetVM(ctx context.Context, vmID string) (*dto.VMRecord, *dto.ApiResponseError)
+	GetInventoryVM(ctx context.Context, vmID string) (*modals.VirtualMachine, *dto.ApiResponseError)
+	FindIdempotentVMRequest(ctx context.Context, key string, operation constants.OperationType, fingerprint string) (*modals.VMRequest, *dto.ApiResponseError)
+	CreateIdempotentVMRequest(ctx context.Context, key, fingerprint string, operation constants.OperationType, status constants.RequestStatus, metadata string) (*modals.VMRequest, *dto.ApiResponseError)
 }
 
+const (
+	// DefaultPageLimit is the page size used when a list request does not set one.
+	DefaultPageLimit = 50
+	// MaxPageLimit caps the page size of a list request.
+	MaxPageLimit = 500
+)
+
 // vmService implements the VMService interface.
 type vmService struct {
 	vmRepo repo.VMRepository
@@ -52,22 +67,19 @@ func (s *vmService) CreateVMRequest(ctx context.Context, operation constants.Ope
 		s.logger.Error(constants.Internal, constants.Api, "Missing or invalid workspace_id in context", map[constants.ExtraKey]interface{}{
 			"error": errUtlis.Error(),
 		})
+		return nil, &dto.ApiResponseError{ErrorCode: constants.UnauthorizedErrorCode, Message: errUtlis.Error()}
 	}
 
-	vmRequest := &modals.VMRequest{
-		Operation:       string(operation),
-		RequestStatus:   string(status),
-		RequestMetadata: metadata,
-		WorkspaceId:     workspaceID,
-	}
-	err := s.vmRepo.CreateVMRequest(ctx, vmRequest)
+	// Everything is validated before the first write, so a bad payload leaves nothing behind.
+	vmID, err := targetVMID(operation, metadata)
 	if err != nil {
-		s.logger.Error(constants.Internal, constants.Api, "Failed to deploy VM", map[constants.ExtraKey]interface{}{
+		s.logger.Error(constants.Internal, constants.Api, "Failed to read the target VM", map[constants.ExtraKey]interface{}{
 			"error": err.Message,
 		})
 		return nil, err
 	}
 
+	var instances []modals.VMDeployInstance
 	switch operation {
 	case constants.VMDeploy:
 		var deployReq api.HCIDeployVM
@@ -81,25 +93,48 @@ func (s *vmService) CreateVMRequest(ctx context.Context, operation constants.Ope
 		numVMs := deployReq.VmConfig.NumberOfVms.Value
 		vmName := deployReq.VmConfig.Name
 
-		if numVMs > 0 {
-			var instances []modals.VMDeployInstance
-			for i := 1; i <= numVMs; i++ {
-				instances = append(instances, modals.VMDeployInstance{
-					RequestID: vmRequest.RequestID,
-					VMName:    fmt.Sprintf("%s_%d", vmName, i),
-					VMStatus:  string(constants.VMINIT),
-				})
-			}
-
-			if err := s.vmRepo.CreateVMDeployInstances(ctx, instances); err != nil {
-				s.logger.Error(constants.Internal, constants.Api, "Failed to create VM deploy instances", map[constants.ExtraKey]interface{}{
-					"error": err.Message,
-				})
-				return nil, err
-			}
+		for i := 1; i <= numVMs; i++ {
+			instances = append(instances, modals.VMDeployInstance{
+				VMName:   fmt.Sprintf("%s_%d", vmName, i),
+				VMStatus: string(constants.StatusNew),
+			})
 		}
 	}
 
+	vmRequest := &modals.VMRequest{
+		Operation:       string(operation),
+		RequestStatus:   string(status),
+		RequestMetadata: metadata,
+		WorkspaceId:     workspaceID,
+		VMID:            vmID,
+		LockedVMID:      lockedVMID(operation, vmID),
+	}
+	// The request and its instances commit together, so a failed insert leaves no orphans.
+	err = s.vmRepo.WithTransaction(ctx, func(tx repo.VMRepository) *dto.ApiResponseError {
+		if err := tx.CreateVMRequest(ctx, vmRequest); err != nil {
+			s.logger.Error(constants.Internal, constants.Api, "Failed to deploy VM", map[constants.ExtraKey]interface{}{
+				"error": err.Message,
+			})
+			return err
+		}
+		if len(instances) == 0 {
+			return nil
+		}
+		for i := range instances {
+			instances[i].RequestID = vmRequest.RequestID
+		}
+		if err := tx.CreateVMDeployInstances(ctx, instances); err != nil {
+			s.logger.Error(constants.Internal, constants.Api, "Failed to create VM deploy instances", map[constants.ExtraKey]interface{}{
+				"error": err.Message,
+			})
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	s.logger.Info(constants.Internal, constants.Api, "Successfully created VM request", nil)
 
 	return vmRequest, nil
@@ -139,10 +174,46 @@ func (s *vmService) GetVMRequest(ctx context.Context, requestID string) (*modals
 	return vmRequest, nil
 }
 
-func (s *vmService) GetAllVMRequestsWithInstances(ctx context.Context) ([]*modals.VMRequest, []*modals.VMDeployInstance, int, int, *dto.ApiResponseError) {
+// CancelVMRequest cancels a New request or asks the executor to stop an Inprogress one.
+func (s *vmService) CancelVMRequest(ctx context.Context, requestID string) (*modals.VMRequest, *dto.ApiResponseError) {
+	s.logger.Info(constants.Internal, constants.Api, "CancelVMRequest service function invoked", map[constants.ExtraKey]interface{}{
+		"requestID": requestID,
+	})
+
+	vmRequest, err := s.vmRepo.CancelVMRequest(ctx, requestID)
+	if err != nil {
+		s.logger.Error(constants.Internal, constants.Api, "Failed to cancel VM request", map[constants.ExtraKey]interface{}{
+			"requestID": requestID,
+			"error":     err.Message,
+		})
+		return nil, err
+	}
+
+	s.logger.Info(constants.Internal, constants.Api, "VM request cancellation accepted", map[constants.ExtraKey]interface{}{
+		"requestID":       vmRequest.RequestID,
+		"status":          vmRequest.RequestStatus,
+		"cancelRequested": vmRequest.CancelRequested,
+	})
+	return vmRequest, nil
+}
+
+// GetAllVMRequestsWithInstances handles the business logic for listing one page of VM requests.
+func (s *vmService) GetAllVMRequestsWithInstances(ctx context.Context, filter dto.VMRequestFilter) ([]*modals.VMRequest, []*modals.VMDeployInstance, int, int, *dto.ApiResponseError) {
 	s.logger.Info(constants.Internal, constants.Api, "GetAllVMRequestsWithInstances service function invoked", nil)
 
-	vmRequests, vmInstances, err := s.vmRepo.GetAllVMRequestsWithInstances(ctx)
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultPageLimit
+	} else if filter.Limit > MaxPageLimit {
+		filter.Limit = MaxPageLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	if filter.CreatedAfter != nil && filter.CreatedBefore != nil && !filter.CreatedAfter.Before(*filter.CreatedBefore) {
+		return nil, nil, 0, 0, &dto.ApiResponseError{ErrorCode: constants.InvalidRequestErrorCode, Message: "createdAfter must be before createdBefore"}
+	}
+
+	vmRequests, vmInstances, reqCount, instCount, err := s.vmRepo.GetAllVMRequestsWithInstances(ctx, filter)
 	if err != nil {
 		s.logger.Error(constants.Internal, constants.Api, "Failed to get all VM requests and instances", map[constants.ExtraKey]interface{}{
 			"error": err.Message,
@@ -151,9 +222,10 @@ func (s *vmService) GetAllVMRequestsWithInstances(ctx context.Context) ([]*modal
 	}
 
 	s.logger.Info(constants.Internal, constants.Api, "Successfully retrieved all VM requests and instances", map[constants.ExtraKey]interface{}{
-		"request_count":  len(vmRequests),
-		"instance_count": len(vmInstances),
+		"request_count":  reqCount,
+		"instance_count": instCount,
+		"page_size":      len(vmRequests),
 	})
 
-	return vmRequests, vmInstances, len(vmRequests), len(vmInstances), nil
+	return vmRequests, vmInstances, int(reqCount), int(instCount), nil
 }
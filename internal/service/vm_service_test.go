@@ -13,11 +13,13 @@ import (
 	dto "vm/internal/dtos"
 	api "vm/internal/gen"
 	"vm/internal/modals"
+	"vm/internal/repo"
 	"vm/internal/service"
 
 	mock_repo "vm/internal/repo/mock"
 	"vm/pkg/constants"
 	mock_logger "vm/pkg/logger/mock"
+	"vm/pkg/utils"
 )
 
 func TestCreateVMRequest(t *testing.T) {
@@ -29,7 +31,7 @@ func TestCreateVMRequest(t *testing.T) {
 	mockRepo := mock_repo.NewMockVMRepository(ctrl)
 	vmSvc := service.NewVMService(mockRepo, logger)
 
-	ctx := context.Background()
+	ctx := context.WithValue(context.Background(), utils.WorkspaceIDKey, "workspace-001")
 
 	// Sample deploy metadata
 	deployReq := api.HCIDeployVM{
@@ -95,6 +97,7 @@ func TestCreateVMRequest(t *testing.T) {
 	metadata := string(metadataBytes)
 
 	t.Run("Successful VM deploy", func(t *testing.T) {
+		expectTransaction(mockRepo)
 		mockRepo.EXPECT().
 			CreateVMRequest(ctx, gomock.Any()).
 			DoAndReturn(func(_ context.Context, req *modals.VMRequest) *dto.ApiResponseError {
@@ -103,8 +106,8 @@ func TestCreateVMRequest(t *testing.T) {
 			})
 
 		expectedInstances := []modals.VMDeployInstance{
-			{RequestID: "req-123", VMName: "my-full-config-vm_1", VMStatus: string(constants.VMINIT)},
-			{RequestID: "req-123", VMName: "my-full-config-vm_2", VMStatus: string(constants.VMINIT)},
+			{RequestID: "req-123", VMName: "my-full-config-vm_1", VMStatus: string(constants.StatusNew)},
+			{RequestID: "req-123", VMName: "my-full-config-vm_2", VMStatus: string(constants.StatusNew)},
 		}
 
 		mockRepo.EXPECT().
@@ -121,9 +124,7 @@ func TestCreateVMRequest(t *testing.T) {
 	t.Run("Unmarshal failure", func(t *testing.T) {
 		badMetadata := `{"invalid_json":}`
 
-		mockRepo.EXPECT().
-			CreateVMRequest(ctx, gomock.Any()).Return(nil)
-
+		// Nothing is written for a payload that does not parse.
 		result, err := vmSvc.CreateVMRequest(ctx, constants.VMDeploy, constants.StatusNew, badMetadata)
 
 		assert.NotNil(t, err)
@@ -131,6 +132,7 @@ func TestCreateVMRequest(t *testing.T) {
 	})
 
 	t.Run("CreateVMRequest fails", func(t *testing.T) {
+		expectTransaction(mockRepo)
 		mockRepo.EXPECT().
 			CreateVMRequest(ctx, gomock.Any()).
 			Return(&dto.ApiResponseError{
@@ -147,6 +149,7 @@ func TestCreateVMRequest(t *testing.T) {
 	})
 
 	t.Run("CreateVMDeployInstances fails", func(t *testing.T) {
+		expectTransaction(mockRepo)
 		mockRepo.EXPECT().
 			CreateVMRequest(ctx, gomock.Any()).
 			DoAndReturn(func(_ context.Context, req *modals.VMRequest) error {
@@ -156,8 +159,8 @@ func TestCreateVMRequest(t *testing.T) {
 
 		vmName := deployReq.VmConfig.Name
 		expectedInstances := []modals.VMDeployInstance{
-			{RequestID: "req-456", VMName: fmt.Sprintf("%s_1", vmName), VMStatus: string(constants.VMINIT)},
-			{RequestID: "req-456", VMName: fmt.Sprintf("%s_2", vmName), VMStatus: string(constants.VMINIT)},
+			{RequestID: "req-456", VMName: fmt.Sprintf("%s_1", vmName), VMStatus: string(constants.StatusNew)},
+			{RequestID: "req-456", VMName: fmt.Sprintf("%s_2", vmName), VMStatus: string(constants.StatusNew)},
 		}
 		mockRepo.EXPECT().
 			CreateVMDeployInstances(ctx, expectedInstances).
@@ -174,6 +177,14 @@ func TestCreateVMRequest(t *testing.T) {
 		assert.Equal(t, "deploy error", err.Message)
 
 	})
+
+	t.Run("Missing workspace is unauthorized", func(t *testing.T) {
+		result, err := vmSvc.CreateVMRequest(context.Background(), constants.VMDeploy, constants.StatusNew, metadata)
+
+		assert.Nil(t, result)
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.UnauthorizedErrorCode, err.ErrorCode)
+	})
 }
 
 func TestGetVMDeployInstances(t *testing.T) {
@@ -189,8 +200,8 @@ func TestGetVMDeployInstances(t *testing.T) {
 
 	t.Run("Successful retrieval of VM deploy instances", func(t *testing.T) {
 		expectedInstances := []*modals.VMDeployInstance{
-			{RequestID: requestID, VMName: "vm-1", VMStatus: string(constants.VMINIT)},
-			{RequestID: requestID, VMName: "vm-2", VMStatus: string(constants.VMINIT)},
+			{RequestID: requestID, VMName: "vm-1", VMStatus: string(constants.StatusNew)},
+			{RequestID: requestID, VMName: "vm-2", VMStatus: string(constants.StatusNew)},
 		}
 
 		mockRepo.EXPECT().
@@ -221,6 +232,41 @@ func TestGetVMDeployInstances(t *testing.T) {
 
 }
 
+func TestCancelVMRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := &mock_logger.StubLogger{}
+	mockRepo := mock_repo.NewMockVMRepository(ctrl)
+	vmSvc := service.NewVMService(mockRepo, logger)
+
+	ctx := context.Background()
+	requestID := "req-123"
+
+	t.Run("Cancellation accepted", func(t *testing.T) {
+		expected := &modals.VMRequest{RequestID: requestID, RequestStatus: string(constants.StatusInProgress), CancelRequested: true}
+		mockRepo.EXPECT().CancelVMRequest(ctx, requestID).Return(expected, nil)
+
+		result, err := vmSvc.CancelVMRequest(ctx, requestID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Cancellation rejected", func(t *testing.T) {
+		mockRepo.EXPECT().CancelVMRequest(ctx, requestID).Return(nil, &dto.ApiResponseError{
+			ErrorCode: constants.LoadStatusConflictErrorCode,
+			Message:   "conflict",
+		})
+
+		result, err := vmSvc.CancelVMRequest(ctx, requestID)
+
+		assert.Nil(t, result)
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.LoadStatusConflictErrorCode, err.ErrorCode)
+	})
+}
+
 func TestGetVMRequest(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -294,7 +340,7 @@ func TestGetAllVMRequestsWithInstances(t *testing.T) {
 			{
 				RequestID:       "req-2",
 				Operation:       string(constants.VMDelete),
-				RequestStatus:   string(constants.StatusDone),
+				RequestStatus:   string(constants.StatusSuccess),
 				WorkspaceId:     "workspace-002",
 				DatacenterId:    "dc-002",
 				RequestMetadata: `{"key":"value"}`,
@@ -302,32 +348,54 @@ func TestGetAllVMRequestsWithInstances(t *testing.T) {
 		}
 
 		expectedInstances := []*modals.VMDeployInstance{
-			{RequestID: "req-1", VMName: "vm-1", VMStatus: string(constants.VMINIT)},
-			{RequestID: "req-2", VMName: "vm-2", VMStatus: string(constants.VMCLOSE)},
+			{RequestID: "req-1", VMName: "vm-1", VMStatus: string(constants.StatusNew)},
+			{RequestID: "req-2", VMName: "vm-2", VMStatus: string(constants.StatusFailure)},
 		}
 
 		mockRepo.EXPECT().
-			GetAllVMRequestsWithInstances(ctx).
-			Return(expectedRequests, expectedInstances, nil)
+			GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{Limit: 2}).
+			Return(expectedRequests, expectedInstances, int64(40), int64(75), nil)
 
-		reqs, insts, reqCount, instCount, err := vmSvc.GetAllVMRequestsWithInstances(ctx)
+		reqs, insts, reqCount, instCount, err := vmSvc.GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{Limit: 2})
 
 		assert.Nil(t, err)
 		assert.Equal(t, expectedRequests, reqs)
 		assert.Equal(t, expectedInstances, insts)
-		assert.Equal(t, 2, reqCount)
-		assert.Equal(t, 2, instCount)
+		assert.Equal(t, 40, reqCount)
+		assert.Equal(t, 75, instCount)
+	})
+
+	t.Run("Applies page defaults and bounds", func(t *testing.T) {
+		mockRepo.EXPECT().
+			GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{Limit: service.DefaultPageLimit}).
+			Return([]*modals.VMRequest{}, []*modals.VMDeployInstance{}, int64(0), int64(0), nil)
+		_, _, _, _, err := vmSvc.GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{Offset: -5})
+		assert.Nil(t, err)
+
+		mockRepo.EXPECT().
+			GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{Limit: service.MaxPageLimit, Offset: 10}).
+			Return([]*modals.VMRequest{}, []*modals.VMDeployInstance{}, int64(0), int64(0), nil)
+		_, _, _, _, err = vmSvc.GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{Limit: 10000, Offset: 10})
+		assert.Nil(t, err)
+	})
+
+	t.Run("Rejects an empty created-at range", func(t *testing.T) {
+		now := time.Now()
+		_, _, _, _, err := vmSvc.GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{CreatedAfter: &now, CreatedBefore: &now})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, constants.InvalidRequestErrorCode, err.ErrorCode)
 	})
 
 	t.Run("Failure to retrieve requests and instances", func(t *testing.T) {
 		mockRepo.EXPECT().
-			GetAllVMRequestsWithInstances(ctx).
-			Return(nil, nil, &dto.ApiResponseError{
+			GetAllVMRequestsWithInstances(ctx, gomock.Any()).
+			Return(nil, nil, int64(0), int64(0), &dto.ApiResponseError{
 				ErrorCode: constants.InternalServerErrorCode,
 				Message:   "db error",
 			})
 
-		reqs, insts, reqCount, instCount, err := vmSvc.GetAllVMRequestsWithInstances(ctx)
+		reqs, insts, reqCount, instCount, err := vmSvc.GetAllVMRequestsWithInstances(ctx, dto.VMRequestFilter{})
 
 		assert.NotNil(t, err)
 		assert.Equal(t, constants.InternalServerErrorCode, err.ErrorCode)
@@ -338,3 +406,11 @@ func TestGetAllVMRequestsWithInstances(t *testing.T) {
 		assert.Equal(t, 0, instCount)
 	})
 }
+
+// expectTransaction lets one WithTransaction call run its work against mockRepo itself.
+func expectTransaction(mockRepo *mock_repo.MockVMRepository) *gomock.Call {
+	return mockRepo.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(repo.VMRepository) *dto.ApiResponseError) *dto.ApiResponseError {
+			return fn(mockRepo)
+		})
+}
@@ -17,10 +17,14 @@ import (
 	promexporter "go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/sdk/metric"
 
+	"vm/internal/executor"
 	api "vm/internal/gen"
 	"vm/internal/handler_impl"
+	"vm/internal/inventory"
 	"vm/internal/repo"
 	"vm/internal/service"
+	"vm/pkg/auth"
+	configmanager "vm/pkg/config-manager"
 	"vm/pkg/constants"
 	"vm/pkg/dependency"
 	"vm/pkg/middleware"
@@ -30,8 +34,13 @@ func main() {
 	// Create a root context
 	ctx := context.Background()
 
+	// `vm migrate ...` manages the schema and exits without starting the server
+	if migrateCommand() {
+		os.Exit(runMigrate(ctx, os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	// Setup dependencies
-	deps, err := dependency.Setup(ctx)
+	deps, err := dependency.Setup(ctx, os.Args[1:])
 	if err != nil {
 		panic(err)
 	}
@@ -44,13 +53,47 @@ func main() {
 	provider := metric.NewMeterProvider(metric.WithReader(exporter))
 	otel.SetMeterProvider(provider)
 
+	// Apply changes to the reloadable settings of the config file while running
+	deps.Holder.Watch(deps.Logger)
+
+	// Watch the database connection and reconnect when it drops
+	deps.Database.Start(ctx)
+
 	// Initialize repository and service
 	vmRepo := repo.NewVMRepository(deps.Database, deps.Logger)
 	vmService := service.NewVMService(vmRepo, deps.Logger)
 
+	// Start the background request executor
+	var requestExecutor executor.Executor
+	if deps.Config.App.Executor.Enabled {
+		backend, err := executor.NewBackend(deps.Config.App.Executor.Backend)
+		if err != nil {
+			deps.Logger.Fatal(constants.General, constants.Startup, "failed to create executor backend", map[constants.ExtraKey]interface{}{"error": err})
+		}
+		requestExecutor = executor.NewExecutor(vmRepo, backend, deps.Config.App.Executor, deps.Logger)
+		requestExecutor.Start(ctx)
+	}
+
+	// Keep the VM inventory in step with vm-monitor
+	var inventoryReconciler inventory.Reconciler
+	if deps.Config.App.Inventory.SyncEnabled {
+		inventoryReconciler = inventory.NewReconciler(vmRepo, deps.ClientDependency.VmMonitorClient, deps.Config.App.Inventory, deps.Logger)
+		inventoryReconciler.Start(ctx)
+	}
+
 	// Initialize handlers
 	handler := handler_impl.NewHandler(vmService, deps)
-	securityHandler := handler_impl.NewSecurityHandler(deps.Logger)
+	verifier, err := auth.NewRotatingVerifier(deps.Config.App.Auth, func() configmanager.Secret {
+		return deps.AppConfig().Auth.HMACSecret
+	}, deps.Logger)
+	if err != nil {
+		deps.Logger.Fatal(constants.General, constants.Startup, "failed to create token verifier", map[constants.ExtraKey]interface{}{"error": err})
+	}
+	policy, err := auth.LoadPolicy(deps.Config.App.Auth.PolicyFile)
+	if err != nil {
+		deps.Logger.Fatal(constants.General, constants.Startup, "failed to load authorization policy", map[constants.ExtraKey]interface{}{"error": err})
+	}
+	securityHandler := handler_impl.NewSecurityHandler(deps.Logger, verifier, policy)
 
 	// Create new server with OTel support
 	server, err := api.NewServer(
@@ -58,14 +101,25 @@ func main() {
 		securityHandler,
 		api.WithTracerProvider(otel.GetTracerProvider()),
 		api.WithMeterProvider(otel.GetMeterProvider()), // Add meter provider
+		api.WithErrorHandler(handler_impl.NewErrorHandler(deps.Logger)),
 	)
 	if err != nil {
 		deps.Logger.Fatal(constants.General, constants.Startup, "failed to create server", map[constants.ExtraKey]interface{}{"error": err})
 	}
 
+	// Liveness and readiness probes sit beside the API, outside its authentication
+	healthChecker, err := newHealthChecker(deps)
+	if err != nil {
+		deps.Logger.Fatal(constants.General, constants.Startup, "failed to create health checks", map[constants.ExtraKey]interface{}{"error": err})
+	}
+	mux := http.NewServeMux()
+	mux.Handle("GET /healthz", healthChecker.Liveness())
+	mux.Handle("GET /readyz", healthChecker.Readiness())
+	mux.Handle("/", server)
+
 	// Start main application server
 	addr := ":" + deps.Config.App.Application.Port
-	wrappedHandler := middleware.RecoveryMiddleware(deps.Logger)(middleware.RequestIDMiddleware(server))
+	wrappedHandler := middleware.RecoveryMiddleware(deps.Logger)(middleware.RequestIDMiddleware(mux))
 	httpServer := &http.Server{Addr: addr, Handler: wrappedHandler}
 
 	// Start metrics server on a separate port
@@ -103,6 +157,15 @@ func main() {
 		deps.Logger.Error(constants.General, constants.Startup, "main server shutdown error", map[constants.ExtraKey]interface{}{"error": err})
 	}
 
+	// Stop claiming new requests and let in-flight ones finish
+	if requestExecutor != nil {
+		requestExecutor.Stop()
+	}
+	if inventoryReconciler != nil {
+		inventoryReconciler.Stop()
+	}
+	deps.Database.Stop()
+
 	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
 		deps.Logger.Error(constants.General, constants.Startup, "metrics server shutdown error", map[constants.ExtraKey]interface{}{"error": err})
 	}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"vm/pkg/dependency"
+)
+
+const migrateUsage = `usage: vm migrate <command>
+
+commands:
+  up [version]    apply pending migrations, or those up to and including version
+  down [version]  roll back the last migration, or those applied after version
+  status          list the migrations and whether each is applied
+`
+
+// runMigrate runs the migrate command line with args and returns the process exit code. It
+// only touches the schema; the server is not started.
+func runMigrate(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || len(args) > 2 || (args[0] == "status" && len(args) > 1) {
+		fmt.Fprint(stderr, migrateUsage)
+		return 2
+	}
+	command, version := args[0], ""
+	if len(args) == 2 {
+		version = args[1]
+	}
+	if command != "up" && command != "down" && command != "status" {
+		fmt.Fprint(stderr, migrateUsage)
+		return 2
+	}
+
+	migrator, _, err := dependency.SetupMigrator(ctx)
+	if err != nil {
+		fmt.Fprintf(stderr, "migrate: %v\n", err)
+		return 1
+	}
+
+	switch {
+	case command == "up" && version == "":
+		err = migrator.MigrateAllTables()
+	case command == "up":
+		err = migrator.MigrateTo(version)
+	case command == "down" && version == "":
+		err = migrator.RollbackLast()
+	case command == "down":
+		err = migrator.RollbackTo(version)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "migrate: %v\n", err)
+		return 1
+	}
+
+	statuses, err := migrator.Status()
+	if err != nil {
+		fmt.Fprintf(stderr, "migrate: %v\n", err)
+		return 1
+	}
+	w := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "MIGRATION\tSTATUS")
+	for _, s := range statuses {
+		status := "pending"
+		if s.Applied {
+			status = "applied"
+		}
+		fmt.Fprintf(w, "%s\t%s\n", s.ID, status)
+	}
+	w.Flush()
+	return 0
+}
+
+// migrateCommand reports whether the process was started as `vm migrate ...`.
+func migrateCommand() bool {
+	return len(os.Args) > 1 && os.Args[1] == "migrate"
+}
@@ -0,0 +1,274 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/sync/singleflight"
+
+	"vm/pkg/cinterface"
+	"vm/pkg/constants"
+)
+
+const (
+	defaultJWKSRefresh = 15 * time.Minute
+	// minJWKSRefetch limits how often an unknown kid can force a refetch.
+	minJWKSRefetch   = 30 * time.Second
+	jwksFetchTimeout = 10 * time.Second
+)
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jwkSet struct {
+	Keys []jwk `json:"keys"`
+}
+
+// jwksKeySource looks keys up by kid in a JWKS document. The document is cached and
+// refetched when it is older than the refresh interval or a token names an unknown kid,
+// so keys rotated by the issuer are picked up without a restart.
+type jwksKeySource struct {
+	fetch   func(ctx context.Context) ([]byte, error)
+	refresh time.Duration
+	now     func() time.Time
+	logger  cinterface.Logger
+
+	// reloads shares one fetch between the tokens that need it, made without holding mu so
+	// that tokens whose keys are cached are verified meanwhile.
+	reloads singleflight.Group
+
+	mu          sync.RWMutex
+	keys        map[string]interface{}
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+// NewJWKSKeySource returns a KeySource backed by the JWKS document at url or in file.
+// The document is loaded once up front so a bad configuration fails at startup. Keys that
+// cannot be used, such as keys of an unsupported type, are logged and skipped.
+func NewJWKSKeySource(url, file string, refresh time.Duration, logger cinterface.Logger) (KeySource, error) {
+	var fetch func(ctx context.Context) ([]byte, error)
+	if url != "" {
+		client := &http.Client{Timeout: jwksFetchTimeout}
+		fetch = func(ctx context.Context) ([]byte, error) { return fetchURL(ctx, client, url) }
+	} else {
+		fetch = func(context.Context) ([]byte, error) { return os.ReadFile(file) }
+	}
+	return newJWKSKeySource(fetch, refresh, logger)
+}
+
+func newJWKSKeySource(fetch func(ctx context.Context) ([]byte, error), refresh time.Duration, logger cinterface.Logger) (*jwksKeySource, error) {
+	if refresh <= 0 {
+		refresh = defaultJWKSRefresh
+	}
+	s := &jwksKeySource{fetch: fetch, refresh: refresh, now: time.Now, logger: logger}
+	if err := s.reload(context.Background()); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *jwksKeySource) Key(ctx context.Context, token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	s.mu.RLock()
+	key, ok := s.lookup(kid)
+	stale := s.due(ok)
+	s.mu.RUnlock()
+
+	if stale {
+		// The fetch outlives a caller that gives up, since others may be waiting on it. A
+		// failed refetch keeps serving the cached keys.
+		_, err, _ := s.reloads.Do("", func() (interface{}, error) {
+			return nil, s.reloadIfDue(context.WithoutCancel(ctx), ok)
+		})
+		if err == nil {
+			s.mu.RLock()
+			key, ok = s.lookup(kid)
+			s.mu.RUnlock()
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("no JWKS key for kid %q", kid)
+	}
+	if err := checkKeyMatchesMethod(key, token.Method); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// due reports whether the keys should be refetched, for a token whose key was found or not.
+// Callers must hold s.mu.
+func (s *jwksKeySource) due(found bool) bool {
+	now := s.now()
+	return now.Sub(s.attemptedAt) > minJWKSRefetch && (!found || now.Sub(s.fetchedAt) > s.refresh)
+}
+
+// reloadIfDue reloads the keys unless a fetch that just finished made it unnecessary.
+func (s *jwksKeySource) reloadIfDue(ctx context.Context, found bool) error {
+	s.mu.RLock()
+	due := s.due(found)
+	s.mu.RUnlock()
+	if !due {
+		return nil
+	}
+	return s.reload(ctx)
+}
+
+// lookup finds kid in the cached keys. A token without a kid is only accepted when the set has exactly one key.
+func (s *jwksKeySource) lookup(kid string) (interface{}, bool) {
+	if kid == "" {
+		if len(s.keys) != 1 {
+			return nil, false
+		}
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// reload fetches and parses the JWKS document, and then swaps it in.
+func (s *jwksKeySource) reload(ctx context.Context) error {
+	s.mu.Lock()
+	attempted := s.now()
+	s.attemptedAt = attempted
+	s.mu.Unlock()
+
+	data, err := s.fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("auth: fetching JWKS: %w", err)
+	}
+	keys, skipped, err := parseJWKS(data)
+	if err != nil {
+		return err
+	}
+	for _, err := range skipped {
+		s.logger.Warn(constants.General, constants.Auth, "Skipping unusable JWKS key", map[constants.ExtraKey]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+	s.fetchedAt = attempted
+	return nil
+}
+
+// parseJWKS returns the signing keys of a JWKS document by kid, and why each key it could
+// not use was skipped. Identity providers publish keys of types not supported here next to
+// the ones that are, so only a set without a single usable signing key is an error.
+func parseJWKS(data []byte) (map[string]interface{}, []error, error) {
+	var set jwkSet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, nil, fmt.Errorf("auth: decoding JWKS: %w", err)
+	}
+	keys := make(map[string]interface{}, len(set.Keys))
+	var skipped []error
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			skipped = append(skipped, fmt.Errorf("auth: JWKS key %q: %w", k.Kid, err))
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, nil, errors.Join(append([]error{errors.New("auth: JWKS has no usable signing keys")}, skipped...)...)
+	}
+	return keys, skipped, nil
+}
+
+func (k jwk) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() {
+			return nil, errors.New("RSA exponent is too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("EC point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func fetchURL(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(res.Body, 1<<20))
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// hmacKeySource verifies tokens with a shared secret.
+type hmacKeySource struct {
+	secret func() []byte
+}
+
+// NewHMACKeySource returns a KeySource for HS256, HS384 and HS512 tokens.
+func NewHMACKeySource(secret []byte) KeySource {
+	return NewRotatingHMACKeySource(func() []byte { return secret })
+}
+
+// NewRotatingHMACKeySource is NewHMACKeySource for a secret that may be rotated: secret is
+// called for every token.
+func NewRotatingHMACKeySource(secret func() []byte) KeySource {
+	return &hmacKeySource{secret: secret}
+}
+
+func (s *hmacKeySource) Key(ctx context.Context, token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+	return s.secret(), nil
+}
+
+// staticKeySource verifies tokens with a single RSA or ECDSA public key.
+type staticKeySource struct {
+	key interface{}
+}
+
+// NewStaticKeySource returns a KeySource for an *rsa.PublicKey or *ecdsa.PublicKey.
+func NewStaticKeySource(key interface{}) (KeySource, error) {
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+		return &staticKeySource{key: key}, nil
+	default:
+		return nil, fmt.Errorf("auth: unsupported public key type %T", key)
+	}
+}
+
+// NewStaticKeySourceFromFile reads a PEM encoded RSA or ECDSA public key.
+func NewStaticKeySourceFromFile(path string) (KeySource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("auth: reading public key: %w", err)
+	}
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(data); err == nil {
+		return NewStaticKeySource(key)
+	}
+	if key, err := jwt.ParseECPublicKeyFromPEM(data); err == nil {
+		return NewStaticKeySource(key)
+	}
+	return nil, errors.New("auth: public key file is not a PEM encoded RSA or ECDSA key")
+}
+
+func (s *staticKeySource) Key(ctx context.Context, token *jwt.Token) (interface{}, error) {
+	if err := checkKeyMatchesMethod(s.key, token.Method); err != nil {
+		return nil, err
+	}
+	return s.key, nil
+}
+
+// checkKeyMatchesMethod stops a token signed for one key type from being checked with another.
+func checkKeyMatchesMethod(key interface{}, method jwt.SigningMethod) error {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		switch method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+			return nil
+		}
+	case *ecdsa.PublicKey:
+		if _, ok := method.(*jwt.SigningMethodECDSA); ok {
+			return nil
+		}
+	}
+	return fmt.Errorf("signing method %s does not match key type %T", method.Alg(), key)
+}
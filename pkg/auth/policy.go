@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	api "vm/internal/gen"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/spf13/viper"
+)
+
+// ErrForbidden is wrapped by every error Authorize returns.
+var ErrForbidden = errors.New("operation not permitted")
+
+// defaultPolicyClaims are the claims read for scopes and roles when the policy names none.
+var defaultPolicyClaims = []string{"scope", "scp", "roles"}
+
+// operations lists every operation a policy may grant.
+var operations = []api.OperationName{
+	api.CancelVirtualMachineRequestOperation,
+	api.EditVMOperation,
+	api.GetVirtualMachineOperation,
+	api.GetVirtualMachineListOperation,
+	api.GetVirtualMachineRequestOperation,
+	api.GetVirtualMachineRequestListOperation,
+	api.HCIDeployVMOperation,
+	api.RetryVirtualMachineRequestOperation,
+	api.VMDeleteOperation,
+	api.VMPowerOffOperation,
+	api.VMPowerOnOperation,
+	api.VMPowerResetOperation,
+	api.VMRefreshOperation,
+	api.VMRestartGuestOSOperation,
+	api.VMShutdownGuestOSOperation,
+}
+
+// PolicyConfig is the on-disk form of a Policy.
+type PolicyConfig struct {
+	// Claims are the token claims that hold scopes or roles, either as a
+	// space separated string or as a list.
+	Claims []string     `mapstructure:"claims"`
+	Rules  []PolicyRule `mapstructure:"rules"`
+}
+
+// PolicyRule grants Operations to callers holding any of Scopes.
+type PolicyRule struct {
+	Operations []string `mapstructure:"operations"`
+	Scopes     []string `mapstructure:"scopes"`
+}
+
+// Authorizer decides whether verified claims may call an operation.
+type Authorizer interface {
+	Authorize(operation api.OperationName, claims jwt.MapClaims) error
+}
+
+// Policy decides which scopes or roles may call each operation. Operations
+// without a rule are denied to everyone.
+type Policy struct {
+	claims  []string
+	allowed map[api.OperationName]map[string]struct{}
+}
+
+// LoadPolicy reads a PolicyConfig from a YAML or JSON file.
+func LoadPolicy(path string) (*Policy, error) {
+	if path == "" {
+		return nil, errors.New("auth: no policy file configured")
+	}
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("auth: reading policy: %w", err)
+	}
+	var cfg PolicyConfig
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("auth: decoding policy: %w", err)
+	}
+	return NewPolicy(cfg)
+}
+
+// NewPolicy validates cfg and builds a Policy from it.
+func NewPolicy(cfg PolicyConfig) (*Policy, error) {
+	known := make(map[api.OperationName]bool, len(operations))
+	for _, op := range operations {
+		known[op] = true
+	}
+
+	p := &Policy{claims: cfg.Claims, allowed: map[api.OperationName]map[string]struct{}{}}
+	if len(p.claims) == 0 {
+		p.claims = defaultPolicyClaims
+	}
+	for i, rule := range cfg.Rules {
+		if len(rule.Operations) == 0 || len(rule.Scopes) == 0 {
+			return nil, fmt.Errorf("auth: policy rule %d needs operations and scopes", i)
+		}
+		for _, name := range rule.Operations {
+			op := api.OperationName(name)
+			if !known[op] {
+				return nil, fmt.Errorf("auth: policy rule %d names unknown operation %q", i, name)
+			}
+			if p.allowed[op] == nil {
+				p.allowed[op] = map[string]struct{}{}
+			}
+			for _, scope := range rule.Scopes {
+				p.allowed[op][scope] = struct{}{}
+			}
+		}
+	}
+	return p, nil
+}
+
+// Authorize reports whether claims grant a scope or role allowed to call operation.
+func (p *Policy) Authorize(operation api.OperationName, claims jwt.MapClaims) error {
+	allowed := p.allowed[operation]
+	for _, scope := range p.grants(claims) {
+		if _, ok := allowed[scope]; ok {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrForbidden, operation)
+}
+
+// grants collects the scopes and roles in the configured claims.
+func (p *Policy) grants(claims jwt.MapClaims) []string {
+	var grants []string
+	for _, name := range p.claims {
+		switch value := claims[name].(type) {
+		case string:
+			grants = append(grants, strings.Fields(value)...)
+		case []interface{}:
+			for _, v := range value {
+				if s, ok := v.(string); ok {
+					grants = append(grants, s)
+				}
+			}
+		}
+	}
+	return grants
+}
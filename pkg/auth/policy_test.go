@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"testing"
+
+	api "vm/internal/gen"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPolicy_Default(t *testing.T) {
+	policy, err := LoadPolicy("../../config/auth-policy.yaml")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		operation api.OperationName
+		claims    jwt.MapClaims
+		allowed   bool
+	}{
+		{"Read scope lists requests", api.GetVirtualMachineRequestListOperation, jwt.MapClaims{"scope": "vm:read"}, true},
+		{"Read scope gets a request", api.GetVirtualMachineRequestOperation, jwt.MapClaims{"scope": "openid vm:read"}, true},
+		{"Read scope cannot power on", api.VMPowerOnOperation, jwt.MapClaims{"scope": "vm:read"}, false},
+		{"Write scope powers on", api.VMPowerOnOperation, jwt.MapClaims{"scp": []interface{}{"vm:write"}}, true},
+		{"Write scope cannot delete", api.VMDeleteOperation, jwt.MapClaims{"scope": "vm:write"}, false},
+		{"Admin role deletes", api.VMDeleteOperation, jwt.MapClaims{"roles": []interface{}{"vm:admin"}}, true},
+		{"No scopes", api.GetVirtualMachineRequestListOperation, jwt.MapClaims{}, false},
+		{"Scope in an unknown claim", api.VMDeleteOperation, jwt.MapClaims{"permissions": "vm:admin"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Authorize(tt.operation, tt.claims)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrForbidden)
+			}
+		})
+	}
+}
+
+func TestNewPolicy(t *testing.T) {
+	t.Run("Operations without a rule are denied", func(t *testing.T) {
+		policy, err := NewPolicy(PolicyConfig{Rules: []PolicyRule{
+			{Operations: []string{"VMRefresh"}, Scopes: []string{"vm:write"}},
+		}})
+		require.NoError(t, err)
+		assert.NoError(t, policy.Authorize(api.VMRefreshOperation, jwt.MapClaims{"scope": "vm:write"}))
+		assert.ErrorIs(t, policy.Authorize(api.VMPowerOnOperation, jwt.MapClaims{"scope": "vm:write"}), ErrForbidden)
+	})
+
+	t.Run("Custom claims replace the defaults", func(t *testing.T) {
+		policy, err := NewPolicy(PolicyConfig{
+			Claims: []string{"groups"},
+			Rules:  []PolicyRule{{Operations: []string{"VMDelete"}, Scopes: []string{"admins"}}},
+		})
+		require.NoError(t, err)
+		assert.NoError(t, policy.Authorize(api.VMDeleteOperation, jwt.MapClaims{"groups": []interface{}{"admins"}}))
+		assert.ErrorIs(t, policy.Authorize(api.VMDeleteOperation, jwt.MapClaims{"scope": "admins"}), ErrForbidden)
+	})
+
+	t.Run("Unknown operation", func(t *testing.T) {
+		_, err := NewPolicy(PolicyConfig{Rules: []PolicyRule{
+			{Operations: []string{"VMDestroy"}, Scopes: []string{"vm:admin"}},
+		}})
+		assert.Error(t, err)
+	})
+
+	t.Run("Rule without scopes", func(t *testing.T) {
+		_, err := NewPolicy(PolicyConfig{Rules: []PolicyRule{{Operations: []string{"VMDelete"}}}})
+		assert.Error(t, err)
+	})
+
+	t.Run("Missing file", func(t *testing.T) {
+		_, err := LoadPolicy("testdata/missing.yaml")
+		assert.Error(t, err)
+	})
+}
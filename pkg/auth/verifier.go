@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"vm/pkg/cinterface"
+	configmanager "vm/pkg/config-manager"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	ModeHMAC  = "hmac"
+	ModeRSA   = "rsa"
+	ModeECDSA = "ecdsa"
+	ModeJWKS  = "jwks"
+)
+
+var (
+	hmacMethods       = []string{"HS256", "HS384", "HS512"}
+	rsaMethods        = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}
+	ecdsaMethods      = []string{"ES256", "ES384", "ES512"}
+	asymmetricMethods = append(append([]string{}, rsaMethods...), ecdsaMethods...)
+)
+
+// ErrInvalidToken is wrapped by every error Verify returns.
+var ErrInvalidToken = errors.New("invalid bearer token")
+
+// Verifier checks a bearer token's signature and registered claims.
+type Verifier interface {
+	Verify(ctx context.Context, token string) (jwt.MapClaims, error)
+}
+
+// KeySource returns the key that verifies a parsed, not yet verified, token.
+type KeySource interface {
+	Key(ctx context.Context, token *jwt.Token) (interface{}, error)
+}
+
+// verifier implements the Verifier interface.
+type verifier struct {
+	keys     KeySource
+	methods  []string
+	issuer   string
+	audience string
+	leeway   time.Duration
+	now      func() time.Time
+}
+
+// NewVerifier builds the Verifier selected by cfg.Mode.
+func NewVerifier(cfg configmanager.Auth, logger cinterface.Logger) (Verifier, error) {
+	return NewRotatingVerifier(cfg, func() configmanager.Secret { return cfg.HMACSecret }, logger)
+}
+
+// NewRotatingVerifier is NewVerifier with the HMAC secret read from hmacSecret for every
+// token, so that a rotated secret applies without a restart.
+func NewRotatingVerifier(cfg configmanager.Auth, hmacSecret func() configmanager.Secret, logger cinterface.Logger) (Verifier, error) {
+	var (
+		keys    KeySource
+		methods []string
+		err     error
+	)
+	switch cfg.Mode {
+	case ModeHMAC:
+		if cfg.HMACSecret == "" {
+			return nil, errors.New("auth: hmac mode requires a secret")
+		}
+		keys = NewRotatingHMACKeySource(func() []byte { return []byte(hmacSecret()) })
+		methods = hmacMethods
+	case ModeRSA, ModeECDSA:
+		if cfg.PublicKeyFile == "" {
+			return nil, fmt.Errorf("auth: %s mode requires a public key file", cfg.Mode)
+		}
+		if keys, err = NewStaticKeySourceFromFile(cfg.PublicKeyFile); err != nil {
+			return nil, err
+		}
+		methods = rsaMethods
+		if cfg.Mode == ModeECDSA {
+			methods = ecdsaMethods
+		}
+	case ModeJWKS:
+		if (cfg.JWKSURL == "") == (cfg.JWKSFile == "") {
+			return nil, errors.New("auth: jwks mode requires exactly one of a JWKS URL or file")
+		}
+		if keys, err = NewJWKSKeySource(cfg.JWKSURL, cfg.JWKSFile, time.Duration(cfg.JWKSRefreshInterval)*time.Second, logger); err != nil {
+			return nil, err
+		}
+		methods = asymmetricMethods
+	default:
+		return nil, fmt.Errorf("auth: unknown mode %q", cfg.Mode)
+	}
+	return NewVerifierWithKeys(keys, methods, cfg.Issuer, cfg.Audience, time.Duration(cfg.LeewaySeconds)*time.Second), nil
+}
+
+// NewVerifierWithKeys creates a Verifier that accepts the given signing methods and,
+// when set, requires the issuer and audience. leeway absorbs clock skew on exp and nbf.
+func NewVerifierWithKeys(keys KeySource, methods []string, issuer, audience string, leeway time.Duration) Verifier {
+	return &verifier{
+		keys:     keys,
+		methods:  methods,
+		issuer:   issuer,
+		audience: audience,
+		leeway:   leeway,
+		now:      time.Now,
+	}
+}
+
+// Verify parses token, checks its signature and exp, nbf, iss and aud, and returns its claims.
+func (v *verifier) Verify(ctx context.Context, token string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	// Registered claims are checked below so that leeway applies to them.
+	parser := jwt.NewParser(jwt.WithValidMethods(v.methods), jwt.WithoutClaimsValidation())
+	_, err := parser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		return v.keys.Key(ctx, t)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	now := v.now()
+	if !claims.VerifyExpiresAt(now.Add(-v.leeway).Unix(), true) {
+		return nil, fmt.Errorf("%w: token is expired or has no exp claim", ErrInvalidToken)
+	}
+	if !claims.VerifyNotBefore(now.Add(v.leeway).Unix(), false) {
+		return nil, fmt.Errorf("%w: token is not valid yet", ErrInvalidToken)
+	}
+	if v.issuer != "" && !claims.VerifyIssuer(v.issuer, true) {
+		return nil, fmt.Errorf("%w: unexpected issuer", ErrInvalidToken)
+	}
+	if v.audience != "" && !claims.VerifyAudience(v.audience, true) {
+		return nil, fmt.Errorf("%w: unexpected audience", ErrInvalidToken)
+	}
+	return claims, nil
+}
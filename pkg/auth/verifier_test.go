@@ -0,0 +1,324 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	configmanager "vm/pkg/config-manager"
+	mock_logger "vm/pkg/logger/mock"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	data, err := os.ReadFile("testdata/rsa_private.pem")
+	require.NoError(t, err)
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+	require.NoError(t, err)
+	return key
+}
+
+func loadECKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	data, err := os.ReadFile("testdata/ec_private.pem")
+	require.NoError(t, err)
+	key, err := jwt.ParseECPrivateKeyFromPEM(data)
+	require.NoError(t, err)
+	return key
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"id":  "workspace-001",
+		"iss": "https://issuer.example",
+		"aud": "vm",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestVerifier_JWKSFile(t *testing.T) {
+	v, err := NewVerifier(configmanager.Auth{
+		Mode:     ModeJWKS,
+		JWKSFile: "testdata/jwks.json",
+		Issuer:   "https://issuer.example",
+		Audience: "vm",
+	}, &mock_logger.StubLogger{})
+	require.NoError(t, err)
+	rsaKey, ecKey := loadRSAKey(t), loadECKey(t)
+
+	with := func(mutate func(c jwt.MapClaims)) jwt.MapClaims {
+		c := validClaims()
+		mutate(c)
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{"RSA key by kid", sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, validClaims()), false},
+		{"EC key by kid", sign(t, jwt.SigningMethodES256, "ec-1", ecKey, validClaims()), false},
+		{"Audience list", sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, with(func(c jwt.MapClaims) { c["aud"] = []string{"other", "vm"} })), false},
+		{"Unknown kid", sign(t, jwt.SigningMethodRS256, "rsa-2", rsaKey, validClaims()), true},
+		{"Encryption key is not used", sign(t, jwt.SigningMethodRS256, "enc-1", rsaKey, validClaims()), true},
+		{"Missing kid with several keys", sign(t, jwt.SigningMethodRS256, "", rsaKey, validClaims()), true},
+		{"Method does not match key", sign(t, jwt.SigningMethodES256, "rsa-1", ecKey, validClaims()), true},
+		{"HMAC is not accepted", sign(t, jwt.SigningMethodHS256, "rsa-1", []byte("secret"), validClaims()), true},
+		{"Expired", sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, with(func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() })), true},
+		{"Missing exp", sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, with(func(c jwt.MapClaims) { delete(c, "exp") })), true},
+		{"Not valid yet", sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, with(func(c jwt.MapClaims) { c["nbf"] = time.Now().Add(time.Hour).Unix() })), true},
+		{"Wrong issuer", sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, with(func(c jwt.MapClaims) { c["iss"] = "https://evil.example" })), true},
+		{"Wrong audience", sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, with(func(c jwt.MapClaims) { c["aud"] = "other" })), true},
+		{"Malformed", "not-a-token", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := v.Verify(context.Background(), tt.token)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidToken)
+				assert.Nil(t, claims)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "workspace-001", claims["id"])
+		})
+	}
+}
+
+func TestVerifier_Leeway(t *testing.T) {
+	keys := NewHMACKeySource([]byte("secret"))
+	claims := jwt.MapClaims{"exp": time.Now().Add(-10 * time.Second).Unix()}
+	token := sign(t, jwt.SigningMethodHS256, "", []byte("secret"), claims)
+
+	_, err := NewVerifierWithKeys(keys, hmacMethods, "", "", 0).Verify(context.Background(), token)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = NewVerifierWithKeys(keys, hmacMethods, "", "", 30*time.Second).Verify(context.Background(), token)
+	assert.NoError(t, err)
+}
+
+func TestVerifier_RotatedHMACSecret(t *testing.T) {
+	secret := configmanager.Secret("first")
+	v, err := NewRotatingVerifier(configmanager.Auth{Mode: ModeHMAC, HMACSecret: secret}, func() configmanager.Secret { return secret }, &mock_logger.StubLogger{})
+	require.NoError(t, err)
+	claims := jwt.MapClaims{"exp": time.Now().Add(time.Hour).Unix()}
+
+	_, err = v.Verify(context.Background(), sign(t, jwt.SigningMethodHS256, "", []byte("first"), claims))
+	assert.NoError(t, err)
+
+	secret = "second"
+	_, err = v.Verify(context.Background(), sign(t, jwt.SigningMethodHS256, "", []byte("first"), claims))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	_, err = v.Verify(context.Background(), sign(t, jwt.SigningMethodHS256, "", []byte("second"), claims))
+	assert.NoError(t, err)
+}
+
+func TestVerifier_StaticKeys(t *testing.T) {
+	tests := []struct {
+		mode   string
+		file   string
+		method jwt.SigningMethod
+		key    interface{}
+	}{
+		{ModeRSA, "testdata/rsa_public.pem", jwt.SigningMethodRS256, loadRSAKey(t)},
+		{ModeECDSA, "testdata/ec_public.pem", jwt.SigningMethodES256, loadECKey(t)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			v, err := NewVerifier(configmanager.Auth{Mode: tt.mode, PublicKeyFile: tt.file}, &mock_logger.StubLogger{})
+			require.NoError(t, err)
+
+			_, err = v.Verify(context.Background(), sign(t, tt.method, "", tt.key, validClaims()))
+			assert.NoError(t, err)
+
+			_, err = v.Verify(context.Background(), sign(t, jwt.SigningMethodHS256, "", []byte("secret"), validClaims()))
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
+
+func TestNewVerifier_Config(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  configmanager.Auth
+	}{
+		{"Unknown mode", configmanager.Auth{Mode: "none"}},
+		{"HMAC without secret", configmanager.Auth{Mode: ModeHMAC}},
+		{"RSA without key file", configmanager.Auth{Mode: ModeRSA}},
+		{"RSA with missing key file", configmanager.Auth{Mode: ModeRSA, PublicKeyFile: "testdata/missing.pem"}},
+		{"JWKS without source", configmanager.Auth{Mode: ModeJWKS}},
+		{"JWKS with both sources", configmanager.Auth{Mode: ModeJWKS, JWKSFile: "testdata/jwks.json", JWKSURL: "http://localhost"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewVerifier(tt.cfg, &mock_logger.StubLogger{})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestJWKSKeySource_URLRotation(t *testing.T) {
+	data, err := os.ReadFile("testdata/jwks.json")
+	require.NoError(t, err)
+	var set map[string][]map[string]string
+	require.NoError(t, json.Unmarshal(data, &set))
+
+	// The server starts with only the RSA key and later rotates to rsa-2.
+	rotated := atomic.Bool{}
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		key := map[string]string{}
+		for k, v := range set["keys"][0] {
+			key[k] = v
+		}
+		if rotated.Load() {
+			key["kid"] = "rsa-2"
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{key}})
+	}))
+	defer server.Close()
+
+	src, err := NewJWKSKeySource(server.URL, "", time.Hour, &mock_logger.StubLogger{})
+	require.NoError(t, err)
+	jwks := src.(*jwksKeySource)
+	now := time.Now()
+	jwks.now = func() time.Time { return now }
+	v := NewVerifierWithKeys(src, asymmetricMethods, "", "", 0)
+	rsaKey := loadRSAKey(t)
+
+	_, err = v.Verify(context.Background(), sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	// A token without a kid is accepted while the set has a single key.
+	_, err = v.Verify(context.Background(), sign(t, jwt.SigningMethodRS256, "", rsaKey, validClaims()))
+	assert.NoError(t, err)
+
+	rotated.Store(true)
+	rotatedToken := sign(t, jwt.SigningMethodRS256, "rsa-2", rsaKey, validClaims())
+
+	// Unknown kids only trigger a refetch once the minimum interval has passed.
+	_, err = v.Verify(context.Background(), rotatedToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	now = now.Add(minJWKSRefetch + time.Second)
+	_, err = v.Verify(context.Background(), rotatedToken)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), fetches.Load())
+
+	// Stale keys keep working when a refresh fails.
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	now = now.Add(2 * time.Hour)
+	_, err = v.Verify(context.Background(), rotatedToken)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), fetches.Load())
+
+	// Failed refreshes are retried no more often than unknown kids.
+	_, err = v.Verify(context.Background(), rotatedToken)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), fetches.Load())
+}
+
+func TestJWKSKeySource_RefetchDoesNotBlockCachedKeys(t *testing.T) {
+	data, err := os.ReadFile("testdata/jwks.json")
+	require.NoError(t, err)
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	src, err := newJWKSKeySource(func(ctx context.Context) ([]byte, error) {
+		if fetches.Add(1) > 1 {
+			<-release
+		}
+		return data, nil
+	}, time.Hour, &mock_logger.StubLogger{})
+	require.NoError(t, err)
+	start := time.Now()
+	src.now = func() time.Time { return start.Add(time.Minute) }
+	v := NewVerifierWithKeys(src, asymmetricMethods, "", "", 0)
+	rsaKey := loadRSAKey(t)
+
+	// Tokens with an unknown kid wait on a single refetch.
+	unknown := sign(t, jwt.SigningMethodRS256, "rsa-unknown", rsaKey, validClaims())
+	done := make(chan struct{})
+	for i := 0; i < 3; i++ {
+		go func() {
+			_, _ = v.Verify(context.Background(), unknown)
+			done <- struct{}{}
+		}()
+	}
+	require.Eventually(t, func() bool { return fetches.Load() == 2 }, time.Second, time.Millisecond)
+
+	// Meanwhile a token whose key is cached is verified.
+	_, err = v.Verify(context.Background(), sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, validClaims()))
+	assert.NoError(t, err)
+
+	close(release)
+	for i := 0; i < 3; i++ {
+		<-done
+	}
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+func TestJWKSKeySource_SkipsUnusableKeys(t *testing.T) {
+	data, err := os.ReadFile("testdata/jwks.json")
+	require.NoError(t, err)
+	var set map[string][]map[string]string
+	require.NoError(t, json.Unmarshal(data, &set))
+	rsa := set["keys"][0]
+	okp := map[string]string{"kty": "OKP", "crv": "Ed25519", "kid": "ed-1", "use": "sig", "x": "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}
+	oct := map[string]string{"kty": "oct", "kid": "hmac-1", "k": "c2VjcmV0"}
+	encode := func(keys ...map[string]string) []byte {
+		data, err := json.Marshal(map[string]interface{}{"keys": keys})
+		require.NoError(t, err)
+		return data
+	}
+
+	t.Run("Mixed RSA and OKP set keeps the RSA key", func(t *testing.T) {
+		mixed := encode(okp, rsa, oct)
+		keys, skipped, err := parseJWKS(mixed)
+		require.NoError(t, err)
+		assert.Len(t, keys, 1)
+		assert.Contains(t, keys, "rsa-1")
+		assert.Len(t, skipped, 2)
+
+		src, err := newJWKSKeySource(func(context.Context) ([]byte, error) { return mixed, nil }, time.Hour, &mock_logger.StubLogger{})
+		require.NoError(t, err)
+		v := NewVerifierWithKeys(src, asymmetricMethods, "", "", 0)
+		_, err = v.Verify(context.Background(), sign(t, jwt.SigningMethodRS256, "rsa-1", loadRSAKey(t), validClaims()))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Set without a usable key is refused", func(t *testing.T) {
+		_, _, err := parseJWKS(encode(okp, oct))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no usable signing keys")
+		assert.Contains(t, err.Error(), `unsupported key type "OKP"`)
+	})
+}
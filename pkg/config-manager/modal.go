@@ -16,6 +16,11 @@ type ApplicationConfig struct {
 	Application Application `mapstructure:"app"`
 	Database    Database    `mapstructure:"database"`
 	Log         Log         `mapstructure:"log"`
+	Executor    Executor    `mapstructure:"executor"`
+	Inventory   Inventory   `mapstructure:"inventory"`
+	Auth        Auth        `mapstructure:"auth"`
+	Health      Health      `mapstructure:"health"`
+	Clients     Clients     `mapstructure:"clients"`
 }
 
 type Application struct {
@@ -29,14 +34,32 @@ type Application struct {
 }
 
 type Database struct {
+	// Driver is mysql, postgres or sqlite. For sqlite DBName is the database file.
+	Driver                string `mapstructure:"driver"`
 	Host                  string `mapstructure:"host"`
 	Port                  int    `mapstructure:"port"`
 	DBName                string `mapstructure:"dbName"`
 	Username              string `mapstructure:"username"`
-	Password              string `mapstructure:"password"`
+	Password              Secret `mapstructure:"password"`
+	SSLMode               string `mapstructure:"sslMode"`
 	MaxIdleConnection     int    `mapstructure:"maxIdleConnection"`
 	MaxOpenConnection     int    `mapstructure:"maxOpenConnection"`
 	MaxConnectionLifeTime int    `mapstructure:"MaxConnectionLifeTime"`
+	// Replicas are the host or host:port of read replicas of the database. They share its
+	// name and credentials.
+	Replicas []string `mapstructure:"replicas"`
+	// ReplicaMaxLag is how many seconds a replica may fall behind before reads skip it.
+	ReplicaMaxLag int `mapstructure:"replicaMaxLag"`
+	// ReplicaCheckInterval is how many seconds a replica health check result is reused.
+	ReplicaCheckInterval int `mapstructure:"replicaCheckInterval"`
+	// HealthCheckInterval is how many seconds apart the primary is probed while it is up.
+	HealthCheckInterval int `mapstructure:"healthCheckInterval"`
+	// ReconnectMaxBackoff caps, in seconds, the wait between reconnect attempts while the
+	// primary is down.
+	ReconnectMaxBackoff int `mapstructure:"reconnectMaxBackoff"`
+	// HealthCheckFailures is how many probes in a row must fail before the primary is marked
+	// down, so that a single slow probe does not fail every write.
+	HealthCheckFailures int `mapstructure:"healthCheckFailures"`
 }
 
 type Log struct {
@@ -47,3 +70,78 @@ type Log struct {
 	EnableConsole bool   `mapstructure:"EnableConsole"`
 	EnableFile    bool   `mapstructure:"EnableFile"`
 }
+
+type Executor struct {
+	Enabled      bool   `mapstructure:"enabled"`
+	Backend      string `mapstructure:"backend"`
+	PollInterval int    `mapstructure:"pollInterval"`
+	BatchSize    int    `mapstructure:"batchSize"`
+	Workers      int    `mapstructure:"workers"`
+	// ClaimTimeout is how many seconds a claimed request may go without its executor
+	// renewing the claim before it is failed.
+	ClaimTimeout int `mapstructure:"claimTimeout"`
+}
+
+type Inventory struct {
+	SyncEnabled  bool `mapstructure:"syncEnabled"`
+	SyncInterval int  `mapstructure:"syncInterval"`
+	BatchSize    int  `mapstructure:"batchSize"`
+	Workers      int  `mapstructure:"workers"`
+}
+
+type Auth struct {
+	Mode                string `mapstructure:"mode"`
+	HMACSecret          Secret `mapstructure:"hmacSecret"`
+	PublicKeyFile       string `mapstructure:"publicKeyFile"`
+	JWKSURL             string `mapstructure:"jwksUrl"`
+	JWKSFile            string `mapstructure:"jwksFile"`
+	JWKSRefreshInterval int    `mapstructure:"jwksRefreshInterval"`
+	Issuer              string `mapstructure:"issuer"`
+	Audience            string `mapstructure:"audience"`
+	LeewaySeconds       int    `mapstructure:"leewaySeconds"`
+	PolicyFile          string `mapstructure:"policyFile"`
+}
+
+// Health sets how much each readiness check matters: critical checks take the pod out of
+// service when they fail, optional ones are only reported, and off ones are not run.
+type Health struct {
+	// Timeout is how many seconds each check may take.
+	Timeout      int    `mapstructure:"timeout"`
+	Database     string `mapstructure:"database"`
+	Migrations   string `mapstructure:"migrations"`
+	ImageManager string `mapstructure:"imageManager"`
+	InfraMonitor string `mapstructure:"infraMonitor"`
+	VmMonitor    string `mapstructure:"vmMonitor"`
+}
+
+// Clients configures the calls to each downstream service.
+type Clients struct {
+	ImageManager Client `mapstructure:"imageManager"`
+	InfraMonitor Client `mapstructure:"infraMonitor"`
+	VmMonitor    Client `mapstructure:"vmMonitor"`
+}
+
+// Client configures the calls to a downstream service.
+//
+// Mode selects the bearer token they carry: the caller's own token (passthrough), a fixed
+// token (static), or one obtained with the OAuth2 client-credentials grant (oauth2).
+type Client struct {
+	Mode  string `mapstructure:"mode"`
+	Token Secret `mapstructure:"token"`
+	// TokenURL, ClientID, ClientSecret and Scopes configure the oauth2 mode.
+	TokenURL     string   `mapstructure:"tokenUrl"`
+	ClientID     string   `mapstructure:"clientId"`
+	ClientSecret Secret   `mapstructure:"clientSecret"`
+	Scopes       []string `mapstructure:"scopes"`
+
+	// ConnectTimeout and Timeout are how many seconds connecting and a whole call, retries
+	// included, may take.
+	ConnectTimeout int `mapstructure:"connectTimeout"`
+	Timeout        int `mapstructure:"timeout"`
+	// Retries is how many times a failed GET is retried.
+	Retries int `mapstructure:"retries"`
+	// BreakerThreshold consecutive failed calls open the circuit breaker, which fails calls
+	// without making them for BreakerCooldown seconds. A threshold of 0 disables it.
+	BreakerThreshold int `mapstructure:"breakerThreshold"`
+	BreakerCooldown  int `mapstructure:"breakerCooldown"`
+}
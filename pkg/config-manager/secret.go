@@ -0,0 +1,24 @@
+package configmanager
+
+import "encoding/json"
+
+const redacted = "[REDACTED]"
+
+// Secret is a configuration value that must never be logged, such as a password or a signing
+// key. It prints and marshals as [REDACTED]; convert it to a string to use it.
+type Secret string
+
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+func (s Secret) GoString() string {
+	return `"` + s.String() + `"`
+}
+
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
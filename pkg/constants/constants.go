@@ -6,7 +6,6 @@ type ExtraKey string
 
 type OperationType string
 type RequestStatus string
-type VMDeployStatus string
 type ContextKey string
 
 const BearerTokenKey ContextKey = "bearer_token"
@@ -39,6 +38,11 @@ const (
 	HashPassword        SubCategory = "HashPassword"
 	DefaultRoleNotFound SubCategory = "DefaultRoleNotFound"
 	FailedToCreateUser  SubCategory = "FailedToCreateUser"
+	Executor            SubCategory = "Executor"
+	Auth                SubCategory = "Auth"
+	Inventory           SubCategory = "Inventory"
+	Health              SubCategory = "Health"
+	Config              SubCategory = "Config"
 
 	// Validation
 	MobileValidation   SubCategory = "MobileValidation"
@@ -75,13 +79,12 @@ const (
 	VMReconfigure     OperationType = "vmReconfigure"
 	VMMachine         OperationType = "vmRequest"
 	VMMachineList     OperationType = "vmRequestList"
-
-	StatusNew     RequestStatus = "New"
-	StatusPending RequestStatus = "Pending"
-	StatusDone    RequestStatus = "Done"
-
-	VMINIT  VMDeployStatus = "Init"
-	VMCLOSE VMDeployStatus = "Close"
+	VMMachineCancel   OperationType = "vmRequestCancel"
+	VMMachineRetry    OperationType = "vmRequestRetry"
+	VMGet             OperationType = "vmGet"
+	VMList            OperationType = "vmList"
 )
 
 const VMRequestBasePath = "/virtualization/v1beta1/virtual-machines-request/"
+
+const VMBasePath = "/virtualization/v1beta1/virtual-machines/"
@@ -9,74 +9,136 @@ import (
 
 const (
 	InvalidJSONFormatErrorCode  = "INVALID_JSON"
+	InvalidRequestErrorCode     = "INVALID_REQUEST"
 	SQLRecordNotFoundErrorCode  = "RECORD_NOT_FOUND"
 	LoadStatusConflictErrorCode = "STATUS_CONFLICT"
 	ValidationErrorCode         = "VALIDATION_ERROR"
 	UnauthorizedErrorCode       = "UNAUTHORIZED"
 	AuthorizationErrorCode      = "FORBIDDEN"
 	InternalServerErrorCode     = "INTERNAL_ERROR"
+	ServiceUnavailableErrorCode = "SERVICE_UNAVAILABLE"
 )
 
 var ErrorCodeToStatus = map[string]int{
 	InvalidJSONFormatErrorCode:  http.StatusBadRequest,
+	InvalidRequestErrorCode:     http.StatusBadRequest,
 	SQLRecordNotFoundErrorCode:  http.StatusNotFound,
 	LoadStatusConflictErrorCode: http.StatusConflict,
 	ValidationErrorCode:         http.StatusUnprocessableEntity,
 	UnauthorizedErrorCode:       http.StatusUnauthorized,
 	AuthorizationErrorCode:      http.StatusForbidden,
+	ServiceUnavailableErrorCode: http.StatusServiceUnavailable,
 }
 
 var responseRegistry = map[OperationType]map[int]func(api.ErrorResponse) any{
 	VMPowerOff: {
 		http.StatusBadRequest:          func(e api.ErrorResponse) any { return (*api.VMPowerOffBadRequest)(&e) },
+		http.StatusConflict:            func(e api.ErrorResponse) any { return (*api.VMPowerOffConflict)(&e) },
 		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.VMPowerOffInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.VMPowerOffServiceUnavailable)(&e) },
 		http.StatusNotFound:            func(e api.ErrorResponse) any { return (*api.VMPowerOffNotFound)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.VMPowerOffUnauthorized)(&e) },
 	},
 	VMPowerOn: {
 		http.StatusBadRequest:          func(e api.ErrorResponse) any { return (*api.VMPowerOnBadRequest)(&e) },
+		http.StatusConflict:            func(e api.ErrorResponse) any { return (*api.VMPowerOnConflict)(&e) },
 		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.VMPowerOnInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.VMPowerOnServiceUnavailable)(&e) },
 		http.StatusNotFound:            func(e api.ErrorResponse) any { return (*api.VMPowerOnNotFound)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.VMPowerOnUnauthorized)(&e) },
 	},
 	VMDelete: {
 		http.StatusBadRequest:          func(e api.ErrorResponse) any { return (*api.VMDeleteBadRequest)(&e) },
+		http.StatusConflict:            func(e api.ErrorResponse) any { return (*api.VMDeleteConflict)(&e) },
 		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.VMDeleteInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.VMDeleteServiceUnavailable)(&e) },
 		http.StatusNotFound:            func(e api.ErrorResponse) any { return (*api.VMDeleteNotFound)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.VMDeleteUnauthorized)(&e) },
 	},
 	VMDeploy: {
 		http.StatusBadRequest:          func(e api.ErrorResponse) any { return (*api.HCIDeployVMBadRequest)(&e) },
+		http.StatusConflict:            func(e api.ErrorResponse) any { return (*api.HCIDeployVMConflict)(&e) },
 		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.HCIDeployVMInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.HCIDeployVMServiceUnavailable)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.HCIDeployVMUnauthorized)(&e) },
 	},
 	VMReset: {
 		http.StatusBadRequest:          func(e api.ErrorResponse) any { return (*api.VMPowerResetBadRequest)(&e) },
+		http.StatusConflict:            func(e api.ErrorResponse) any { return (*api.VMPowerResetConflict)(&e) },
 		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.VMPowerResetInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.VMPowerResetServiceUnavailable)(&e) },
 		http.StatusNotFound:            func(e api.ErrorResponse) any { return (*api.VMPowerResetNotFound)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.VMPowerResetUnauthorized)(&e) },
 	},
 	VMRefresh: {
+		http.StatusConflict:            func(e api.ErrorResponse) any { return (*api.VMRefreshConflict)(&e) },
 		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.VMRefreshInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.VMRefreshServiceUnavailable)(&e) },
 		http.StatusNotFound:            func(e api.ErrorResponse) any { return (*api.VMRefreshNotFound)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.VMRefreshUnauthorized)(&e) },
 	},
 	VMRestartGuestOS: {
 		http.StatusBadRequest:          func(e api.ErrorResponse) any { return (*api.VMRestartGuestOSBadRequest)(&e) },
+		http.StatusConflict:            func(e api.ErrorResponse) any { return (*api.VMRestartGuestOSConflict)(&e) },
 		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.VMRestartGuestOSInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.VMRestartGuestOSServiceUnavailable)(&e) },
 		http.StatusNotFound:            func(e api.ErrorResponse) any { return (*api.VMRestartGuestOSNotFound)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.VMRestartGuestOSUnauthorized)(&e) },
 	},
 	VMShutdownGuestOS: {
 		http.StatusBadRequest:          func(e api.ErrorResponse) any { return (*api.VMShutdownGuestOSBadRequest)(&e) },
+		http.StatusConflict:            func(e api.ErrorResponse) any { return (*api.VMShutdownGuestOSConflict)(&e) },
 		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.VMShutdownGuestOSInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.VMShutdownGuestOSServiceUnavailable)(&e) },
 		http.StatusNotFound:            func(e api.ErrorResponse) any { return (*api.VMShutdownGuestOSNotFound)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.VMShutdownGuestOSUnauthorized)(&e) },
 	},
 	VMReconfigure: {
 		http.StatusBadRequest:          func(e api.ErrorResponse) any { return (*api.EditVMBadRequest)(&e) },
+		http.StatusConflict:            func(e api.ErrorResponse) any { return (*api.EditVMConflict)(&e) },
 		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.EditVMInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.EditVMServiceUnavailable)(&e) },
 		http.StatusNotFound:            func(e api.ErrorResponse) any { return (*api.EditVMNotFound)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.EditVMUnauthorized)(&e) },
+	},
+	VMGet: {
+		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.GetVirtualMachineInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.GetVirtualMachineServiceUnavailable)(&e) },
+		http.StatusNotFound:            func(e api.ErrorResponse) any { return (*api.GetVirtualMachineNotFound)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.GetVirtualMachineUnauthorized)(&e) },
+	},
+	VMList: {
+		http.StatusBadRequest:          func(e api.ErrorResponse) any { return (*api.GetVirtualMachineListBadRequest)(&e) },
+		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.GetVirtualMachineListInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.GetVirtualMachineListServiceUnavailable)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.GetVirtualMachineListUnauthorized)(&e) },
 	},
 	VMMachine: {
 		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.GetVirtualMachineRequestInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.GetVirtualMachineRequestServiceUnavailable)(&e) },
 		http.StatusNotFound:            func(e api.ErrorResponse) any { return (*api.GetVirtualMachineRequestNotFound)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.GetVirtualMachineRequestUnauthorized)(&e) },
+	},
+	VMMachineCancel: {
+		http.StatusConflict:            func(e api.ErrorResponse) any { return (*api.CancelVirtualMachineRequestConflict)(&e) },
+		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.CancelVirtualMachineRequestInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.CancelVirtualMachineRequestServiceUnavailable)(&e) },
+		http.StatusNotFound:            func(e api.ErrorResponse) any { return (*api.CancelVirtualMachineRequestNotFound)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.CancelVirtualMachineRequestUnauthorized)(&e) },
+	},
+	VMMachineRetry: {
+		http.StatusConflict:            func(e api.ErrorResponse) any { return (*api.RetryVirtualMachineRequestConflict)(&e) },
+		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.RetryVirtualMachineRequestInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.RetryVirtualMachineRequestServiceUnavailable)(&e) },
+		http.StatusNotFound:            func(e api.ErrorResponse) any { return (*api.RetryVirtualMachineRequestNotFound)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.RetryVirtualMachineRequestUnauthorized)(&e) },
 	},
 	VMMachineList: {
+		http.StatusBadRequest:          func(e api.ErrorResponse) any { return (*api.GetVirtualMachineRequestListBadRequest)(&e) },
 		http.StatusInternalServerError: func(e api.ErrorResponse) any { return (*api.GetVirtualMachineRequestListInternalServerError)(&e) },
+		http.StatusServiceUnavailable:  func(e api.ErrorResponse) any { return (*api.GetVirtualMachineRequestListServiceUnavailable)(&e) },
 		http.StatusNotFound:            func(e api.ErrorResponse) any { return (*api.GetVirtualMachineRequestListNotFound)(&e) },
+		http.StatusUnauthorized:        func(e api.ErrorResponse) any { return (*api.GetVirtualMachineRequestListUnauthorized)(&e) },
 	},
 }
 
@@ -126,10 +188,18 @@ func fallbackErrorResponse(op OperationType, errRes api.ErrorResponse) any {
 		return (*api.VMShutdownGuestOSInternalServerError)(&errRes)
 	case VMReconfigure:
 		return (*api.EditVMInternalServerError)(&errRes)
+	case VMGet:
+		return (*api.GetVirtualMachineInternalServerError)(&errRes)
+	case VMList:
+		return (*api.GetVirtualMachineListInternalServerError)(&errRes)
 	case VMMachine:
 		return (*api.GetVirtualMachineRequestInternalServerError)(&errRes)
 	case VMMachineList:
 		return (*api.GetVirtualMachineRequestListInternalServerError)(&errRes)
+	case VMMachineCancel:
+		return (*api.CancelVirtualMachineRequestInternalServerError)(&errRes)
+	case VMMachineRetry:
+		return (*api.RetryVirtualMachineRequestInternalServerError)(&errRes)
 	default:
 		// Generic fallback if operation is unknown
 		return (*api.ErrorResponse)(&errRes)
@@ -0,0 +1,11 @@
+package constants
+
+// VMState is the lifecycle state of a VM in the local inventory.
+type VMState string
+
+const (
+	// VMStateActive VMs exist on the backend and are refreshed by the inventory reconciler.
+	VMStateActive VMState = "Active"
+	// VMStateDeleted VMs were deleted by a request; their rows are kept for history.
+	VMStateDeleted VMState = "Deleted"
+)
@@ -0,0 +1,20 @@
+package constants
+
+// vmLockingOperations change an existing VM, so at most one of them may be New or Inprogress
+// per VM at a time. Operations missing from it, such as refresh, only re-read the VM and run
+// alongside anything.
+var vmLockingOperations = map[OperationType]bool{
+	VMPowerOn:         true,
+	VMPowerOff:        true,
+	VMReset:           true,
+	VMRestartGuestOS:  true,
+	VMShutdownGuestOS: true,
+	VMReconfigure:     true,
+	VMDelete:          true,
+}
+
+// LocksVM reports whether a request for op holds the per-VM operation lock of its target VM
+// until it finishes.
+func (op OperationType) LocksVM() bool {
+	return vmLockingOperations[op]
+}
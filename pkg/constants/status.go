@@ -0,0 +1,48 @@
+package constants
+
+// RequestStatus values match the VMRequest.requestStatus enum in doc/openapi.yaml
+// and are shared by VMRequest and VMDeployInstance rows.
+const (
+	StatusNew        RequestStatus = "New"
+	StatusInProgress RequestStatus = "Inprogress"
+	StatusSuccess    RequestStatus = "Success"
+	StatusFailure    RequestStatus = "Failure"
+	StatusCancelled  RequestStatus = "Cancelled"
+)
+
+// statusTransitions lists the statuses each status may move to.
+// Success, Failure and Cancelled are terminal.
+var statusTransitions = map[RequestStatus][]RequestStatus{
+	StatusNew:        {StatusInProgress, StatusFailure, StatusCancelled},
+	StatusInProgress: {StatusSuccess, StatusFailure, StatusCancelled},
+	StatusSuccess:    {},
+	StatusFailure:    {},
+	StatusCancelled:  {},
+}
+
+// Statuses returns every known status in lifecycle order.
+func Statuses() []RequestStatus {
+	return []RequestStatus{StatusNew, StatusInProgress, StatusSuccess, StatusFailure, StatusCancelled}
+}
+
+// IsValid reports whether s is a known status.
+func (s RequestStatus) IsValid() bool {
+	_, ok := statusTransitions[s]
+	return ok
+}
+
+// IsTerminal reports whether no further transition is allowed from s.
+func (s RequestStatus) IsTerminal() bool {
+	next, ok := statusTransitions[s]
+	return ok && len(next) == 0
+}
+
+// CanTransitionTo reports whether the state machine allows moving from s to next.
+func (s RequestStatus) CanTransitionTo(next RequestStatus) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
@@ -1,63 +1,225 @@
 package db
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"vm/pkg/cinterface"
 	configmanager "vm/pkg/config-manager"
 	"vm/pkg/constants"
 
-	"gorm.io/driver/mysql"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"gorm.io/gorm"
 )
 
 type Database interface {
 	InitDB(config configmanager.ApplicationConfig) (*gorm.DB, error)
+	// GetWriter returns the primary. Writes, and reads that must see them, go to it. While
+	// the primary is down, every statement run on it fails at once with ErrUnavailable.
+	GetWriter() *gorm.DB
+	// GetReader returns a healthy read replica, or the primary when none is available.
 	GetReader() *gorm.DB
+	// Ping checks that db can reach its database, within the context set on db.
 	Ping(db *gorm.DB) error
+	// Start probes the primary in the background until Stop is called, reconnecting with
+	// exponential backoff while it is down.
+	Start(ctx context.Context)
+	Stop()
+	// Health returns the state of the primary as last observed by the supervisor.
+	Health() Health
 }
 
 type DatabaseImpl struct {
-	Logger       cinterface.Logger
-	Db           *gorm.DB
-	lastPingTime time.Time
-	initOnce     sync.Once
+	Logger cinterface.Logger
+	Db     *gorm.DB
+	config configmanager.Database
+	// prober holds the supervisor's own connection to the primary, so that a probe does not
+	// wait behind requests for a connection from a busy pool.
+	prober *gorm.DB
+
+	// mu guards health, which the supervisor updates while requests read it.
+	mu     sync.RWMutex
+	health Health
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// password returns the password new connections log in with.
+	password func() configmanager.Secret
+
+	replicas    []*replica
+	next        atomic.Uint64
+	fallingBack atomic.Bool
+	reads       metric.Int64Counter
 }
 
-func NewDatabase(logger cinterface.Logger) Database {
-	return &DatabaseImpl{
+// Option configures a Database made by NewDatabase.
+type Option func(*DatabaseImpl)
+
+// WithPassword makes new connections to the primary and the replicas log in with the
+// password password returns when they are opened, instead of the one given to InitDB, so
+// that a rotated password takes effect without a restart.
+func WithPassword(password func() configmanager.Secret) Option {
+	return func(rd *DatabaseImpl) {
+		rd.password = password
+	}
+}
+
+func NewDatabase(logger cinterface.Logger, opts ...Option) Database {
+	reads, _ := otel.Meter("vm/pkg/db").Int64Counter("vm.db.reads",
+		metric.WithDescription("Reads routed by GetReader, by the pool that served them"))
+	rd := &DatabaseImpl{
 		Logger: logger,
+		health: Health{Healthy: true, Since: time.Now().UTC()},
+		reads:  reads,
+	}
+	for _, opt := range opts {
+		opt(rd)
 	}
+	return rd
 }
 
 func (rd *DatabaseImpl) InitDB(config configmanager.ApplicationConfig) (*gorm.DB, error) {
-	var err error
-	dsn := createDSN(config.Database)
-	rd.Db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	if len(config.Database.Replicas) > 0 && config.Database.Driver == DriverSQLite {
+		return nil, fmt.Errorf("%s does not support read replicas", DriverSQLite)
+	}
+
+	if rd.password == nil {
+		password := config.Database.Password
+		rd.password = func() configmanager.Secret { return password }
+	}
+
+	primary, err := open(config.Database, rd.password, &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	rd.Db = primary
+	rd.config = config.Database
+	if config.Database.Driver != DriverSQLite {
+		// Every connection to :memory: opens a separate empty database, so SQLite is probed
+		// through its pool.
+		proberConfig := config.Database
+		proberConfig.MaxOpenConnection, proberConfig.MaxIdleConnection = 1, 1
+		if rd.prober, err = open(proberConfig, rd.password, &gorm.Config{DisableAutomaticPing: true}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, address := range config.Database.Replicas {
+		replicaConfig, err := replicaDatabase(config.Database, address)
+		if err != nil {
+			return nil, err
+		}
+		// A replica that is down must not stop the server; its health check keeps reads
+		// away from it until it is back.
+		replicaDB, err := open(replicaConfig, rd.password, &gorm.Config{DisableAutomaticPing: true})
+		if err != nil {
+			return nil, fmt.Errorf("read replica %s: %w", address, err)
+		}
+		rd.replicas = append(rd.replicas, newReplica(address, replicaDB, config.Database))
+	}
+
+	return primary, nil
+}
+
+// open connects to the database config describes, logging in with the password password
+// returns, and sizes its pool.
+func open(config configmanager.Database, password func() configmanager.Secret, gormConfig *gorm.Config) (*gorm.DB, error) {
+	dialector, err := dialector(config, password)
+	if err != nil {
+		return nil, err
+	}
+	db, err := gorm.Open(dialector, gormConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	sqlDB, err := rd.Db.DB()
+	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get sql.DB: %w", err)
 	}
 
-	sqlDB.SetMaxOpenConns(config.Database.MaxOpenConnection)
-	sqlDB.SetMaxIdleConns(config.Database.MaxIdleConnection)
-	sqlDB.SetConnMaxLifetime(time.Minute * time.Duration(config.Database.MaxConnectionLifeTime))
+	maxOpen := config.MaxOpenConnection
+	if config.Driver == DriverSQLite {
+		// SQLite allows one writer at a time, and every connection to :memory: opens a
+		// separate empty database.
+		maxOpen = 1
+	}
+	sqlDB.SetMaxOpenConns(maxOpen)
+	sqlDB.SetMaxIdleConns(config.MaxIdleConnection)
+	sqlDB.SetConnMaxLifetime(time.Minute * time.Duration(config.MaxConnectionLifeTime))
+	return db, nil
+}
+
+// replicaDatabase returns config with the host and port of the replica at address.
+func replicaDatabase(config configmanager.Database, address string) (configmanager.Database, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		// No port: the replica listens where the primary does.
+		config.Host = address
+		return config, nil
+	}
+	config.Host = host
+	if config.Port, err = strconv.Atoi(port); err != nil {
+		return config, fmt.Errorf("invalid port in read replica address %q", address)
+	}
+	return config, nil
+}
 
-	rd.lastPingTime = time.Now().UTC()
-	return rd.Db, nil
+func (rd *DatabaseImpl) GetWriter() *gorm.DB {
+	rd.mu.RLock()
+	defer rd.mu.RUnlock()
+	if rd.Db == nil || rd.health.Healthy {
+		return rd.Db
+	}
+	unavailable := rd.Db.Session(&gorm.Session{NewDB: true})
+	_ = unavailable.AddError(ErrUnavailable)
+	return unavailable
 }
 
+// GetReader spreads reads over the healthy replicas in turn. When no replica is configured or
+// every replica is down or lagging, reads go to the primary.
 func (rd *DatabaseImpl) GetReader() *gorm.DB {
-	if rd.Db == nil || (rd.lastPingTime.Add(time.Minute*5).Before(time.Now().UTC()) && rd.Ping(rd.Db) != nil) {
-		rd.Logger.Info(constants.MySql, constants.Startup, "Re-initializing database connection", nil)
+	if len(rd.replicas) == 0 {
+		rd.countRead("primary", "no_replicas")
+		return rd.GetWriter()
 	}
-	return rd.Db
+
+	start := rd.next.Add(1)
+	for i := range rd.replicas {
+		r := rd.replicas[(start+uint64(i))%uint64(len(rd.replicas))]
+		if r.available(rd.Logger) {
+			if rd.fallingBack.CompareAndSwap(true, false) {
+				rd.Logger.Info(constants.MySql, constants.Select, "Read replicas are back; reads leave the primary", nil)
+			}
+			rd.countRead(r.address, "replica")
+			return r.db
+		}
+	}
+
+	if rd.fallingBack.CompareAndSwap(false, true) {
+		rd.Logger.Warn(constants.MySql, constants.Select, "No read replica is available; reads fall back to the primary", map[constants.ExtraKey]interface{}{
+			"replicas": len(rd.replicas),
+		})
+	}
+	rd.countRead("primary", "fallback")
+	return rd.GetWriter()
+}
+
+func (rd *DatabaseImpl) countRead(target, reason string) {
+	if rd.reads == nil {
+		return
+	}
+	rd.reads.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("target", target),
+		attribute.String("reason", reason),
+	))
 }
 
 func (rd *DatabaseImpl) Ping(db *gorm.DB) error {
@@ -65,15 +227,5 @@ func (rd *DatabaseImpl) Ping(db *gorm.DB) error {
 	if err != nil {
 		return err
 	}
-	pingErr := sqlDB.Ping()
-	if pingErr == nil {
-		rd.lastPingTime = time.Now().UTC()
-	}
-	return pingErr
-}
-
-func createDSN(config configmanager.Database) string {
-	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true",
-		config.Username, config.Password, config.Host, config.Port, config.DBName,
-	)
+	return sqlDB.PingContext(db.Statement.Context)
 }
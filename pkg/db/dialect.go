@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	configmanager "vm/pkg/config-manager"
+
+	"github.com/glebarez/sqlite"
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Supported values of configmanager.Database.Driver.
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// DefaultPort returns the port the server of driver listens on by default.
+func DefaultPort(driver string) int {
+	if driver == DriverPostgres {
+		return 5432
+	}
+	return 3306
+}
+
+// dialector returns the gorm dialector for the driver config selects. An empty driver is
+// MySQL. SQLite uses a pure-Go driver, so it works in CGO_ENABLED=0 builds.
+//
+// MySQL and Postgres connections log in with the password password returns as each one is
+// opened, so a rotated password is picked up without reconnecting the whole pool.
+func dialector(config configmanager.Database, password func() configmanager.Secret) (gorm.Dialector, error) {
+	switch config.Driver {
+	case "", DriverMySQL:
+		dsn, err := mysqldriver.ParseDSN(createDSN(config))
+		if err != nil {
+			return nil, fmt.Errorf("invalid database configuration: %w", err)
+		}
+		_ = dsn.Apply(mysqldriver.BeforeConnect(func(_ context.Context, cfg *mysqldriver.Config) error {
+			cfg.Passwd = string(password())
+			return nil
+		}))
+		connector, err := mysqldriver.NewConnector(dsn)
+		if err != nil {
+			return nil, fmt.Errorf("invalid database configuration: %w", err)
+		}
+		return mysql.New(mysql.Config{Conn: sql.OpenDB(connector), DSNConfig: dsn}), nil
+	case DriverPostgres:
+		connConfig, err := pgx.ParseConfig(createPostgresDSN(config))
+		if err != nil {
+			return nil, fmt.Errorf("invalid database configuration: %w", err)
+		}
+		conn := stdlib.OpenDB(*connConfig, stdlib.OptionBeforeConnect(func(_ context.Context, connConfig *pgx.ConnConfig) error {
+			connConfig.Password = string(password())
+			return nil
+		}))
+		return postgres.New(postgres.Config{Conn: conn}), nil
+	case DriverSQLite:
+		return sqlite.Open(createSQLiteDSN(config)), nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", config.Driver)
+	}
+}
+
+func createDSN(config configmanager.Database) string {
+	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true",
+		config.Username, string(config.Password), config.Host, config.Port, config.DBName,
+	)
+}
+
+func createPostgresDSN(config configmanager.Database) string {
+	sslMode := config.SSLMode
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s TimeZone=UTC",
+		config.Host, config.Port, config.Username, string(config.Password), config.DBName, sslMode,
+	)
+}
+
+// createSQLiteDSN waits on a locked database instead of failing at once.
+func createSQLiteDSN(config configmanager.Database) string {
+	separator := "?"
+	if strings.Contains(config.DBName, "?") {
+		separator = "&"
+	}
+	return config.DBName + separator + "_pragma=busy_timeout(5000)"
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+
+	configmanager "vm/pkg/config-manager"
+)
+
+func TestDialectorReadsPasswordPerConnection(t *testing.T) {
+	// Nothing listens on the port, so each ping opens a new connection, which fails.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	for _, driver := range []string{DriverMySQL, DriverPostgres} {
+		t.Run(driver, func(t *testing.T) {
+			var asked []configmanager.Secret
+			current := configmanager.Secret("first")
+			password := func() configmanager.Secret {
+				asked = append(asked, current)
+				return current
+			}
+			dialect, err := dialector(configmanager.Database{
+				Driver: driver, Host: "127.0.0.1", Port: port, Username: "vm", Password: "startup", DBName: "vm",
+			}, password)
+			require.NoError(t, err)
+
+			var pool *sql.DB
+			switch d := dialect.(type) {
+			case *mysql.Dialector:
+				pool = d.Conn.(*sql.DB)
+			case *postgres.Dialector:
+				pool = d.Conn.(*sql.DB)
+			}
+			require.NotNil(t, pool)
+			defer pool.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			assert.Error(t, pool.PingContext(ctx))
+			current = "second"
+			assert.Error(t, pool.PingContext(ctx))
+
+			assert.Equal(t, []configmanager.Secret{"first", "second"}, asked)
+		})
+	}
+}
@@ -3,17 +3,25 @@ package db
 import (
 	"fmt"
 
-	"vm/internal/modals"
 	"vm/pkg/cinterface"
 	"vm/pkg/constants"
 
 	"github.com/go-gormigrate/gormigrate/v2"
-	"github.com/google/uuid"
-	"gorm.io/gorm"
 )
 
+// Migrate applies and rolls back the versioned schema migrations.
 type Migrate interface {
 	MigrateAllTables() error
+	MigrateTo(migrationID string) error
+	RollbackTo(migrationID string) error
+	RollbackLast() error
+	Status() ([]MigrationStatus, error)
+}
+
+// MigrationStatus reports whether a migration has been applied.
+type MigrationStatus struct {
+	ID      string
+	Applied bool
 }
 
 type MigrateImpl struct {
@@ -28,51 +36,69 @@ func NewMigrateAllTables(db Database, logger cinterface.Logger) Migrate {
 	}
 }
 
+func (t *MigrateImpl) migrator() *gormigrate.Gormigrate {
+	return gormigrate.New(t.db.GetWriter(), gormigrate.DefaultOptions, migrations())
+}
+
+// MigrateAllTables applies every migration that has not been applied yet.
 func (t *MigrateImpl) MigrateAllTables() error {
-	migrationID := uuid.New().String()
-	db := t.db.GetReader()
-
-	m := gormigrate.New(db, gormigrate.DefaultOptions, []*gormigrate.Migration{
-		{
-			ID: migrationID,
-			Migrate: func(tx *gorm.DB) error {
-				entities := []interface{}{
-					&modals.VMRequest{},
-					&modals.VMDeployInstance{},
-				}
-
-				for _, entity := range entities {
-					if err := tx.AutoMigrate(entity); err != nil {
-						t.Logger.Error(constants.MySql, constants.Migration, "Migration failed for entity", map[constants.ExtraKey]interface{}{
-							"Entity":      fmt.Sprintf("%T", entity),
-							"MigrationID": migrationID,
-							"Error":       err,
-						})
-						return tx.Rollback().Error
-					}
-					t.Logger.Info(constants.MySql, constants.Migration, "Migration successful for entity", map[constants.ExtraKey]interface{}{
-						"Entity":      fmt.Sprintf("%T", entity),
-						"MigrationID": migrationID,
-					})
-				}
-
-				t.Logger.Info(constants.MySql, constants.Migration, "All migrations applied successfully", map[constants.ExtraKey]interface{}{
-					"MigrationID": migrationID,
-				})
-				return nil
-			},
-		},
-	})
+	return t.run("up", "", t.migrator().Migrate)
+}
+
+// MigrateTo applies the migrations up to and including migrationID.
+func (t *MigrateImpl) MigrateTo(migrationID string) error {
+	return t.run("up", migrationID, func() error { return t.migrator().MigrateTo(migrationID) })
+}
+
+// RollbackTo rolls back the migrations applied after migrationID, leaving migrationID applied.
+func (t *MigrateImpl) RollbackTo(migrationID string) error {
+	return t.run("down", migrationID, func() error { return t.migrator().RollbackTo(migrationID) })
+}
+
+// RollbackLast rolls back the most recently applied migration.
+func (t *MigrateImpl) RollbackLast() error {
+	return t.run("down", "", t.migrator().RollbackLast)
+}
+
+// Status lists every migration in the order they are applied.
+func (t *MigrateImpl) Status() ([]MigrationStatus, error) {
+	db := t.db.GetWriter()
+	opts := gormigrate.DefaultOptions
+
+	applied := map[string]bool{}
+	if db.Migrator().HasTable(opts.TableName) {
+		var ids []string
+		if err := db.Table(opts.TableName).Pluck(opts.IDColumnName, &ids).Error; err != nil {
+			return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+		}
+		for _, id := range ids {
+			applied[id] = true
+		}
+	}
+
+	var statuses []MigrationStatus
+	for _, m := range migrations() {
+		statuses = append(statuses, MigrationStatus{ID: m.ID, Applied: applied[m.ID]})
+	}
+	return statuses, nil
+}
 
-	if err := m.Migrate(); err != nil {
+// run logs and wraps the outcome of a migration step in direction up or down.
+func (t *MigrateImpl) run(direction, migrationID string, step func() error) error {
+	if err := step(); err != nil {
 		t.Logger.Error(constants.MySql, constants.Migration, "Migration process failed", map[constants.ExtraKey]interface{}{
+			"Direction":   direction,
 			"MigrationID": migrationID,
 			"Error":       err,
 		})
-		return fmt.Errorf("migration process failed for Migration ID %s: %w", migrationID, err)
+		if migrationID == "" {
+			return fmt.Errorf("migration %s failed: %w", direction, err)
+		}
+		return fmt.Errorf("migration %s to %s failed: %w", direction, migrationID, err)
 	}
 
 	t.Logger.Info(constants.MySql, constants.Migration, "Migration completed successfully", map[constants.ExtraKey]interface{}{
+		"Direction":   direction,
 		"MigrationID": migrationID,
 	})
 	return nil
@@ -0,0 +1,86 @@
+package db_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"vm/pkg/db"
+	mock_db "vm/pkg/db/mock"
+	mock_logger "vm/pkg/logger/mock"
+)
+
+func TestMigrateStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	setup := func(t *testing.T) (db.Migrate, sqlmock.Sqlmock) {
+		sqlDB, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		t.Cleanup(func() { sqlDB.Close() })
+
+		gormDB, _ := gorm.Open(mysql.New(mysql.Config{
+			Conn:                      sqlDB,
+			SkipInitializeWithVersion: true,
+		}), &gorm.Config{})
+		mockDB := mock_db.NewMockDatabase(ctrl)
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+		return db.NewMigrateAllTables(mockDB, &mock_logger.StubLogger{}), mock
+	}
+	expectMigrationsTable := func(mock sqlmock.Sqlmock, exists bool) {
+		count := 0
+		if exists {
+			count = 1
+		}
+		mock.ExpectQuery("SELECT DATABASE\\(\\)").WillReturnRows(sqlmock.NewRows([]string{"DATABASE()"}).AddRow("vmdb"))
+		mock.ExpectQuery("SELECT SCHEMA_NAME from Information_schema.SCHEMATA").
+			WillReturnRows(sqlmock.NewRows([]string{"SCHEMA_NAME"}).AddRow("vmdb"))
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM information_schema.tables").
+			WithArgs("vmdb", "migrations", "BASE TABLE").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+	}
+
+	t.Run("Lists the migrations in order with the applied ones marked", func(t *testing.T) {
+		migrator, mock := setup(t)
+		expectMigrationsTable(mock, true)
+		// The random IDs the old AutoMigrate recorded are not listed.
+		mock.ExpectQuery("SELECT `id` FROM `migrations`").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).
+				AddRow("0c51db32-b358-471d-976f-7bb6ce3aaf1e").
+				AddRow("20261016_0001_initial_schema").
+				AddRow("20261016_0002_normalize_request_status"))
+
+		statuses, err := migrator.Status()
+
+		assert.NoError(t, err)
+		assert.Equal(t, []db.MigrationStatus{
+			{ID: "20261016_0001_initial_schema", Applied: true},
+			{ID: "20261016_0002_normalize_request_status", Applied: true},
+			{ID: "20261016_0003_vm_request_indexes", Applied: false},
+			{ID: "20261016_0004_portable_column_types", Applied: false},
+			{ID: "20261016_0005_vm_request_vm_id", Applied: false},
+			{ID: "20261016_0006_vm_request_claim", Applied: false},
+		}, statuses)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Nothing is applied on a new database", func(t *testing.T) {
+		migrator, mock := setup(t)
+		expectMigrationsTable(mock, false)
+
+		statuses, err := migrator.Status()
+
+		assert.NoError(t, err)
+		seen := map[string]bool{}
+		for _, s := range statuses {
+			assert.False(t, s.Applied)
+			assert.False(t, seen[s.ID], "duplicate migration ID %s", s.ID)
+			seen[s.ID] = true
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,364 @@
+package db
+
+import (
+	"encoding/json"
+	"time"
+
+	"vm/pkg/constants"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// Migration IDs are applied in the order listed in migrations and must never change once
+// released; a schema change is a new migration appended to the list.
+const (
+	migrationInitialSchema    = "20261016_0001_initial_schema"
+	migrationNormalizeStatus  = "20261016_0002_normalize_request_status"
+	migrationVMRequestIndexes = "20261016_0003_vm_request_indexes"
+	migrationPortableColumns  = "20261016_0004_portable_column_types"
+	migrationVMRequestVMID    = "20261016_0005_vm_request_vm_id"
+	migrationVMRequestClaim   = "20261016_0006_vm_request_claim"
+)
+
+// The structs below freeze the schema each migration creates, so that running a migration
+// gives the same tables no matter how the models in internal/modals have moved on since.
+
+type vmRequestV1 struct {
+	RequestID       string     `gorm:"column:request_id;primaryKey;type:char(36)"`
+	Operation       string     `gorm:"column:operation;not null;type:varchar(50)"`
+	RequestStatus   string     `gorm:"column:request_status;not null;type:varchar(50)"`
+	WorkspaceId     string     `gorm:"column:workspace_id;type:varchar(50);default:''"`
+	DatacenterId    string     `gorm:"column:datacenter_id;type:varchar(50);default:''"`
+	CreatedAt       time.Time  `gorm:"column:created_at;autoCreateTime;type:timestamp"`
+	CompletedAt     *time.Time `gorm:"column:completed_at;type:timestamp"`
+	RequestMetadata string     `gorm:"column:request_metadata;type:text"`
+	CancelRequested bool       `gorm:"column:cancel_requested;not null;default:false"`
+	ParentRequestID *string    `gorm:"column:parent_request_id;type:char(36);uniqueIndex"`
+	LockedVMID      *string    `gorm:"column:locked_vm_id;type:varchar(50);uniqueIndex"`
+}
+
+func (vmRequestV1) TableName() string { return "vm_requests" }
+
+type vmDeployInstanceV1 struct {
+	RequestID      string     `gorm:"column:request_id;primaryKey;type:char(36)"`
+	VMName         string     `gorm:"column:vm_name;primaryKey;type:varchar(255)"`
+	VMID           string     `gorm:"column:vm_id;type:varchar(50)"`
+	VMStatus       string     `gorm:"column:vm_status;not null;type:varchar(50)"`
+	VMStateMessage string     `gorm:"column:vm_state_message;type:text"`
+	CompletedAt    *time.Time `gorm:"column:completed_at;type:timestamp"`
+}
+
+func (vmDeployInstanceV1) TableName() string { return "vm_deploy_instances" }
+
+type idempotencyKeyV1 struct {
+	WorkspaceId    string    `gorm:"column:workspace_id;primaryKey;type:varchar(50)"`
+	IdempotencyKey string    `gorm:"column:idempotency_key;primaryKey;type:varchar(255)"`
+	Operation      string    `gorm:"column:operation;not null;type:varchar(50)"`
+	Fingerprint    string    `gorm:"column:fingerprint;not null;type:char(64)"`
+	RequestID      string    `gorm:"column:request_id;type:char(36);default:''"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime;type:timestamp"`
+}
+
+func (idempotencyKeyV1) TableName() string { return "idempotency_keys" }
+
+type virtualMachineV1 struct {
+	VMID           string     `gorm:"column:vm_id;primaryKey;type:varchar(50)"`
+	WorkspaceId    string     `gorm:"column:workspace_id;not null;type:varchar(50);index"`
+	VMName         string     `gorm:"column:vm_name;type:varchar(255);default:''"`
+	RequestID      string     `gorm:"column:request_id;type:char(36);default:''"`
+	State          string     `gorm:"column:state;not null;type:varchar(20)"`
+	PowerState     string     `gorm:"column:power_state;type:varchar(50);default:''"`
+	CPUCores       int        `gorm:"column:cpu_cores;not null;default:0"`
+	CPUUsage       float32    `gorm:"column:cpu_usage;not null;default:0"`
+	MemSize        float32    `gorm:"column:mem_size;not null;default:0"`
+	MemUsage       float32    `gorm:"column:mem_usage;not null;default:0"`
+	Guest          string     `gorm:"column:guest;type:varchar(255);default:''"`
+	NetworkAddress string     `gorm:"column:network_address;type:varchar(255);default:''"`
+	SyncError      string     `gorm:"column:sync_error;type:text"`
+	SyncedAt       *time.Time `gorm:"column:synced_at;type:timestamp"`
+	CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime;type:timestamp"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;autoUpdateTime;type:timestamp"`
+}
+
+func (virtualMachineV1) TableName() string { return "virtual_machines" }
+
+// vmRequestIndexesV3 carries the definitions of the vm_requests indexes added by
+// migrationVMRequestIndexes.
+type vmRequestIndexesV3 struct {
+	RequestStatus string    `gorm:"column:request_status;index:idx_vm_requests_request_status"`
+	WorkspaceId   string    `gorm:"column:workspace_id;index:idx_vm_requests_workspace_id"`
+	CreatedAt     time.Time `gorm:"column:created_at;index:idx_vm_requests_created_at"`
+}
+
+func (vmRequestIndexesV3) TableName() string { return "vm_requests" }
+
+var vmRequestIndexNames = []string{
+	"idx_vm_requests_request_status",
+	"idx_vm_requests_workspace_id",
+	"idx_vm_requests_created_at",
+}
+
+// The structs below carry the definitions migrationPortableColumns gives the char and
+// timestamp columns of the first schema: varchar, and each driver's own time type, which
+// unlike MySQL's timestamp holds dates past 2038.
+
+type vmRequestColumnsV4 struct {
+	RequestID       string     `gorm:"column:request_id;primaryKey;type:varchar(36)"`
+	CreatedAt       time.Time  `gorm:"column:created_at;autoCreateTime"`
+	CompletedAt     *time.Time `gorm:"column:completed_at"`
+	ParentRequestID *string    `gorm:"column:parent_request_id;type:varchar(36)"`
+}
+
+func (vmRequestColumnsV4) TableName() string { return "vm_requests" }
+
+type vmDeployInstanceColumnsV4 struct {
+	RequestID   string     `gorm:"column:request_id;primaryKey;type:varchar(36)"`
+	CompletedAt *time.Time `gorm:"column:completed_at"`
+}
+
+func (vmDeployInstanceColumnsV4) TableName() string { return "vm_deploy_instances" }
+
+type idempotencyKeyColumnsV4 struct {
+	Fingerprint string    `gorm:"column:fingerprint;not null;type:varchar(64)"`
+	RequestID   string    `gorm:"column:request_id;type:varchar(36);default:''"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (idempotencyKeyColumnsV4) TableName() string { return "idempotency_keys" }
+
+type virtualMachineColumnsV4 struct {
+	RequestID string     `gorm:"column:request_id;type:varchar(36);default:''"`
+	SyncedAt  *time.Time `gorm:"column:synced_at"`
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (virtualMachineColumnsV4) TableName() string { return "virtual_machines" }
+
+// portableColumns pairs the models migrationPortableColumns alters with the models of the
+// first schema that rolling it back restores, and the fields of both to alter.
+var portableColumns = []struct {
+	model, previous interface{}
+	fields          []string
+}{
+	{&vmRequestColumnsV4{}, &vmRequestV1{}, []string{"RequestID", "CreatedAt", "CompletedAt", "ParentRequestID"}},
+	{&vmDeployInstanceColumnsV4{}, &vmDeployInstanceV1{}, []string{"RequestID", "CompletedAt"}},
+	{&idempotencyKeyColumnsV4{}, &idempotencyKeyV1{}, []string{"Fingerprint", "RequestID", "CreatedAt"}},
+	{&virtualMachineColumnsV4{}, &virtualMachineV1{}, []string{"RequestID", "SyncedAt", "CreatedAt", "UpdatedAt"}},
+}
+
+// alterPortableColumns alters the columns of portableColumns to their definitions in the
+// models chosen by pick. SQLite ignores declared column types, so it is left alone rather
+// than have every table rebuilt.
+func alterPortableColumns(tx *gorm.DB, pick func(model, previous interface{}) interface{}) error {
+	if tx.Dialector.Name() == DriverSQLite {
+		return nil
+	}
+	for _, table := range portableColumns {
+		model := pick(table.model, table.previous)
+		for _, field := range table.fields {
+			if err := tx.Migrator().AlterColumn(model, field); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// vmRequestVMIDV5 carries the vm_id column of vm_requests added by migrationVMRequestVMID,
+// and the columns its backfill reads.
+type vmRequestVMIDV5 struct {
+	RequestID       string `gorm:"column:request_id;primaryKey;type:varchar(36)"`
+	Operation       string `gorm:"column:operation"`
+	RequestMetadata string `gorm:"column:request_metadata"`
+	VMID            string `gorm:"column:vm_id;type:varchar(50);default:'';index:idx_vm_requests_vm_id"`
+}
+
+func (vmRequestVMIDV5) TableName() string { return "vm_requests" }
+
+const vmRequestVMIDIndex = "idx_vm_requests_vm_id"
+
+// backfillVMRequestVMID copies the target VM of every request but deploys out of its
+// metadata into vm_id, in batches so that a long history is not loaded at once.
+func backfillVMRequestVMID(tx *gorm.DB) error {
+	var requests []vmRequestVMIDV5
+	return tx.Model(&vmRequestVMIDV5{}).
+		Where("vm_id = '' AND operation <> ?", constants.VMDeploy).
+		FindInBatches(&requests, 500, func(batch *gorm.DB, _ int) error {
+			for _, req := range requests {
+				var target struct{ VMID string }
+				if json.Unmarshal([]byte(req.RequestMetadata), &target) != nil || target.VMID == "" {
+					continue
+				}
+				if err := tx.Model(&vmRequestVMIDV5{}).Where("request_id = ?", req.RequestID).
+					Update("vm_id", target.VMID).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+}
+
+// vmRequestClaimV6 carries the claim columns of vm_requests added by migrationVMRequestClaim,
+// and the index expired claims are found by.
+type vmRequestClaimV6 struct {
+	RequestStatus string     `gorm:"column:request_status;index:idx_vm_requests_claim,priority:1"`
+	ClaimedBy     string     `gorm:"column:claimed_by;type:varchar(100);default:''"`
+	ClaimedAt     *time.Time `gorm:"column:claimed_at;index:idx_vm_requests_claim,priority:2"`
+}
+
+func (vmRequestClaimV6) TableName() string { return "vm_requests" }
+
+const vmRequestClaimIndex = "idx_vm_requests_claim"
+
+// migrations returns every schema migration, oldest first.
+func migrations() []*gormigrate.Migration {
+	return []*gormigrate.Migration{
+		{
+			// Creates the tables. It is safe on databases that were set up by the AutoMigrate
+			// this replaced: existing tables only gain what they are missing.
+			ID: migrationInitialSchema,
+			Migrate: func(tx *gorm.DB) error {
+				return tx.AutoMigrate(&vmRequestV1{}, &vmDeployInstanceV1{}, &idempotencyKeyV1{}, &virtualMachineV1{})
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.Migrator().DropTable(&virtualMachineV1{}, &idempotencyKeyV1{}, &vmDeployInstanceV1{}, &vmRequestV1{})
+			},
+		},
+		{
+			// Maps the pre-OpenAPI status values onto New/Inprogress/Success/Failure.
+			ID: migrationNormalizeStatus,
+			Migrate: func(tx *gorm.DB) error {
+				requestStatuses := map[string]constants.RequestStatus{
+					"Pending": constants.StatusInProgress,
+					"Done":    constants.StatusSuccess,
+				}
+				for old, status := range requestStatuses {
+					if err := tx.Model(&vmRequestV1{}).Where("request_status = ?", old).
+						Update("request_status", status).Error; err != nil {
+						return err
+					}
+				}
+
+				instanceStatuses := map[string]constants.RequestStatus{
+					"Init":  constants.StatusNew,
+					"Close": constants.StatusSuccess,
+				}
+				for old, status := range instanceStatuses {
+					if err := tx.Model(&vmDeployInstanceV1{}).Where("vm_status = ?", old).
+						Update("vm_status", status).Error; err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			// The old values cannot be told apart from rows that were written with the new
+			// ones, so rolling back leaves the statuses as they are.
+			Rollback: func(tx *gorm.DB) error {
+				return nil
+			},
+		},
+		{
+			// Indexes the columns request listings filter and sort on.
+			ID: migrationVMRequestIndexes,
+			Migrate: func(tx *gorm.DB) error {
+				for _, name := range vmRequestIndexNames {
+					if tx.Migrator().HasIndex(&vmRequestIndexesV3{}, name) {
+						continue
+					}
+					if err := tx.Migrator().CreateIndex(&vmRequestIndexesV3{}, name); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) error {
+				for _, name := range vmRequestIndexNames {
+					if !tx.Migrator().HasIndex(&vmRequestIndexesV3{}, name) {
+						continue
+					}
+					if err := tx.Migrator().DropIndex(&vmRequestIndexesV3{}, name); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+		{
+			// Replaces the char and timestamp columns of the first schema with types MySQL,
+			// PostgreSQL and SQLite all handle the same way.
+			ID: migrationPortableColumns,
+			Migrate: func(tx *gorm.DB) error {
+				return alterPortableColumns(tx, func(model, previous interface{}) interface{} { return model })
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return alterPortableColumns(tx, func(model, previous interface{}) interface{} { return previous })
+			},
+		},
+		{
+			// Stores the VM each request targets in an indexed column, so that the requests of
+			// a page of VMs are found without scanning the metadata of every request.
+			ID: migrationVMRequestVMID,
+			Migrate: func(tx *gorm.DB) error {
+				if !tx.Migrator().HasColumn(&vmRequestVMIDV5{}, "VMID") {
+					if err := tx.Migrator().AddColumn(&vmRequestVMIDV5{}, "VMID"); err != nil {
+						return err
+					}
+				}
+				if !tx.Migrator().HasIndex(&vmRequestVMIDV5{}, vmRequestVMIDIndex) {
+					if err := tx.Migrator().CreateIndex(&vmRequestVMIDV5{}, vmRequestVMIDIndex); err != nil {
+						return err
+					}
+				}
+				return backfillVMRequestVMID(tx)
+			},
+			Rollback: func(tx *gorm.DB) error {
+				if tx.Migrator().HasIndex(&vmRequestVMIDV5{}, vmRequestVMIDIndex) {
+					if err := tx.Migrator().DropIndex(&vmRequestVMIDV5{}, vmRequestVMIDIndex); err != nil {
+						return err
+					}
+				}
+				return tx.Migrator().DropColumn(&vmRequestVMIDV5{}, "VMID")
+			},
+		},
+		{
+			// Records which executor claimed a request and when it last renewed the claim, so
+			// that a request left Inprogress by an executor that died can be failed.
+			ID: migrationVMRequestClaim,
+			Migrate: func(tx *gorm.DB) error {
+				for _, field := range []string{"ClaimedBy", "ClaimedAt"} {
+					if tx.Migrator().HasColumn(&vmRequestClaimV6{}, field) {
+						continue
+					}
+					if err := tx.Migrator().AddColumn(&vmRequestClaimV6{}, field); err != nil {
+						return err
+					}
+				}
+				if !tx.Migrator().HasIndex(&vmRequestClaimV6{}, vmRequestClaimIndex) {
+					if err := tx.Migrator().CreateIndex(&vmRequestClaimV6{}, vmRequestClaimIndex); err != nil {
+						return err
+					}
+				}
+				// Requests already Inprogress are claimed from now on, so the executors running
+				// them have one claim timeout to finish before they are failed.
+				return tx.Model(&vmRequestClaimV6{}).
+					Where("request_status = ? AND claimed_at IS NULL", constants.StatusInProgress).
+					Update("claimed_at", time.Now().UTC()).Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				if tx.Migrator().HasIndex(&vmRequestClaimV6{}, vmRequestClaimIndex) {
+					if err := tx.Migrator().DropIndex(&vmRequestClaimV6{}, vmRequestClaimIndex); err != nil {
+						return err
+					}
+				}
+				for _, field := range []string{"ClaimedAt", "ClaimedBy"} {
+					if err := tx.Migrator().DropColumn(&vmRequestClaimV6{}, field); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+	}
+}
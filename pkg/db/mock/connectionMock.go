@@ -1,12 +1,14 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: C:\Users\USER\Downloads\vm-poc-main\pkg\db\connection.go
+// Source: pkg/db/connection.go
 
 // Package mock_db is a generated GoMock package.
 package mock_db
 
 import (
+	context "context"
 	reflect "reflect"
 	configmanager "vm/pkg/config-manager"
+	db "vm/pkg/db"
 
 	gomock "github.com/golang/mock/gomock"
 	gorm "gorm.io/gorm"
@@ -49,6 +51,34 @@ func (mr *MockDatabaseMockRecorder) GetReader() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReader", reflect.TypeOf((*MockDatabase)(nil).GetReader))
 }
 
+// GetWriter mocks base method.
+func (m *MockDatabase) GetWriter() *gorm.DB {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWriter")
+	ret0, _ := ret[0].(*gorm.DB)
+	return ret0
+}
+
+// GetWriter indicates an expected call of GetWriter.
+func (mr *MockDatabaseMockRecorder) GetWriter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWriter", reflect.TypeOf((*MockDatabase)(nil).GetWriter))
+}
+
+// Health mocks base method.
+func (m *MockDatabase) Health() db.Health {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Health")
+	ret0, _ := ret[0].(db.Health)
+	return ret0
+}
+
+// Health indicates an expected call of Health.
+func (mr *MockDatabaseMockRecorder) Health() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockDatabase)(nil).Health))
+}
+
 // InitDB mocks base method.
 func (m *MockDatabase) InitDB(config configmanager.ApplicationConfig) (*gorm.DB, error) {
 	m.ctrl.T.Helper()
@@ -77,3 +107,27 @@ func (mr *MockDatabaseMockRecorder) Ping(db interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockDatabase)(nil).Ping), db)
 }
+
+// Start mocks base method.
+func (m *MockDatabase) Start(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start", ctx)
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockDatabaseMockRecorder) Start(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockDatabase)(nil).Start), ctx)
+}
+
+// Stop mocks base method.
+func (m *MockDatabase) Stop() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop")
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockDatabaseMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockDatabase)(nil).Stop))
+}
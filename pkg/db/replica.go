@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"vm/pkg/cinterface"
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/constants"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultReplicaMaxLag        = 5 * time.Second
+	defaultReplicaCheckInterval = 10 * time.Second
+	replicaCheckTimeout         = 2 * time.Second
+)
+
+// replica is a read replica together with the outcome of its last health check.
+type replica struct {
+	address  string
+	db       *gorm.DB
+	maxLag   time.Duration
+	interval time.Duration
+	lag      func(ctx context.Context, db *gorm.DB) (time.Duration, error)
+
+	mu        sync.Mutex
+	healthy   atomic.Bool
+	checkedAt atomic.Int64
+}
+
+func newReplica(address string, db *gorm.DB, config configmanager.Database) *replica {
+	r := &replica{
+		address:  address,
+		db:       db,
+		maxLag:   defaultReplicaMaxLag,
+		interval: defaultReplicaCheckInterval,
+		lag:      mysqlReplicaLag,
+	}
+	if config.ReplicaMaxLag > 0 {
+		r.maxLag = time.Duration(config.ReplicaMaxLag) * time.Second
+	}
+	if config.ReplicaCheckInterval > 0 {
+		r.interval = time.Duration(config.ReplicaCheckInterval) * time.Second
+	}
+	if config.Driver == DriverPostgres {
+		r.lag = postgresReplicaLag
+	}
+	// Replicas start out healthy so that the first read checks them instead of skipping them.
+	r.healthy.Store(true)
+	return r
+}
+
+// available reports whether reads may go to the replica. The result of a check is reused for
+// the check interval; when it has expired, one caller checks again while the others keep
+// using the previous result.
+func (r *replica) available(logger cinterface.Logger) bool {
+	if time.Since(time.Unix(0, r.checkedAt.Load())) < r.interval || !r.mu.TryLock() {
+		return r.healthy.Load()
+	}
+	defer r.mu.Unlock()
+	if time.Since(time.Unix(0, r.checkedAt.Load())) < r.interval {
+		return r.healthy.Load()
+	}
+
+	err := r.check()
+	r.checkedAt.Store(time.Now().UnixNano())
+	healthy := err == nil
+	if r.healthy.Swap(healthy) != healthy {
+		if healthy {
+			logger.Info(constants.MySql, constants.Select, "Read replica is healthy again", map[constants.ExtraKey]interface{}{
+				"replica": r.address,
+			})
+		} else {
+			logger.Warn(constants.MySql, constants.Select, "Read replica is unhealthy; reads skip it", map[constants.ExtraKey]interface{}{
+				"replica": r.address,
+				"error":   err.Error(),
+			})
+		}
+	}
+	return healthy
+}
+
+// check returns why the replica cannot serve reads, or nil when it can.
+func (r *replica) check() error {
+	ctx, cancel := context.WithTimeout(context.Background(), replicaCheckTimeout)
+	defer cancel()
+
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return err
+	}
+	lag, err := r.lag(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	if lag > r.maxLag {
+		return fmt.Errorf("replication lag %s exceeds %s", lag, r.maxLag)
+	}
+	return nil
+}
+
+// mysqlReplicaLag reads Seconds_Behind_Source from the replica status, falling back to the
+// statement and column names of servers older than MySQL 8.0.22.
+func mysqlReplicaLag(ctx context.Context, db *gorm.DB) (time.Duration, error) {
+	lag, err := replicaStatusLag(ctx, db, "SHOW REPLICA STATUS")
+	if err != nil {
+		lag, err = replicaStatusLag(ctx, db, "SHOW SLAVE STATUS")
+	}
+	return lag, err
+}
+
+func replicaStatusLag(ctx context.Context, db *gorm.DB, statement string) (time.Duration, error) {
+	rows, err := db.WithContext(ctx).Raw(statement).Rows()
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	if !rows.Next() {
+		// Not configured as a replica: it is the primary or a copy of it, so nothing lags.
+		return 0, rows.Err()
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, err
+	}
+	for i, column := range columns {
+		if column != "Seconds_Behind_Source" && column != "Seconds_Behind_Master" {
+			continue
+		}
+		if !values[i].Valid {
+			return 0, errors.New("replication is not running")
+		}
+		seconds, err := strconv.Atoi(values[i].String)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s %q", column, values[i].String)
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return 0, errors.New("replica status has no lag column")
+}
+
+// postgresReplicaLag is the age of the last replayed transaction. A standby that has replayed
+// everything it received has no lag, however long ago the primary last wrote.
+func postgresReplicaLag(ctx context.Context, db *gorm.DB) (time.Duration, error) {
+	var seconds float64
+	err := db.WithContext(ctx).Raw(`SELECT CASE
+		WHEN NOT pg_is_in_recovery() THEN 0
+		WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+	END`).Scan(&seconds).Error
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
@@ -0,0 +1,173 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	configmanager "vm/pkg/config-manager"
+	mock_logger "vm/pkg/logger/mock"
+)
+
+func newMockGorm(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{DisableAutomaticPing: true})
+	require.NoError(t, err)
+	return gormDB, mock
+}
+
+func expectReplicaStatus(mock sqlmock.Sqlmock, lag interface{}) {
+	mock.ExpectPing()
+	mock.ExpectQuery("SHOW REPLICA STATUS").
+		WillReturnRows(sqlmock.NewRows([]string{"Replica_IO_State", "Seconds_Behind_Source"}).AddRow("Waiting for source", lag))
+}
+
+func newRoutingDatabase(t *testing.T, config configmanager.Database, replicas ...string) (*DatabaseImpl, *gorm.DB, map[string]sqlmock.Sqlmock) {
+	primary, _ := newMockGorm(t)
+	rd := NewDatabase(&mock_logger.StubLogger{}).(*DatabaseImpl)
+	rd.Db = primary
+
+	mocks := map[string]sqlmock.Sqlmock{}
+	for _, address := range replicas {
+		replicaDB, mock := newMockGorm(t)
+		rd.replicas = append(rd.replicas, newReplica(address, replicaDB, config))
+		mocks[address] = mock
+	}
+	return rd, primary, mocks
+}
+
+func TestGetReader(t *testing.T) {
+	config := configmanager.Database{ReplicaMaxLag: 5, ReplicaCheckInterval: 60}
+
+	t.Run("Without replicas reads go to the primary", func(t *testing.T) {
+		rd, primary, _ := newRoutingDatabase(t, config)
+
+		assert.Same(t, primary, rd.GetReader())
+	})
+
+	t.Run("Reads alternate between healthy replicas", func(t *testing.T) {
+		rd, primary, mocks := newRoutingDatabase(t, config, "replica-a", "replica-b")
+		expectReplicaStatus(mocks["replica-a"], 0)
+		expectReplicaStatus(mocks["replica-b"], 1)
+
+		first, second, third := rd.GetReader(), rd.GetReader(), rd.GetReader()
+
+		assert.NotSame(t, primary, first)
+		assert.NotSame(t, primary, second)
+		assert.NotSame(t, first, second)
+		assert.Same(t, first, third)
+		for _, mock := range mocks {
+			assert.NoError(t, mock.ExpectationsWereMet())
+		}
+	})
+
+	t.Run("Reads skip a replica that is down", func(t *testing.T) {
+		rd, primary, mocks := newRoutingDatabase(t, config, "replica-a", "replica-b")
+		mocks["replica-a"].ExpectPing().WillReturnError(errors.New("connection refused"))
+		expectReplicaStatus(mocks["replica-b"], 0)
+
+		for i := 0; i < 3; i++ {
+			assert.Same(t, rd.replicas[1].db, rd.GetReader())
+		}
+		assert.NotSame(t, primary, rd.replicas[1].db)
+	})
+
+	t.Run("Lagging or stopped replicas fall back to the primary", func(t *testing.T) {
+		rd, primary, mocks := newRoutingDatabase(t, config, "replica-a", "replica-b")
+		expectReplicaStatus(mocks["replica-a"], 6)
+		expectReplicaStatus(mocks["replica-b"], nil)
+
+		assert.Same(t, primary, rd.GetReader())
+		assert.True(t, rd.fallingBack.Load())
+	})
+
+	t.Run("A replica is used again once it recovers", func(t *testing.T) {
+		rd, primary, mocks := newRoutingDatabase(t, configmanager.Database{ReplicaMaxLag: 5}, "replica-a")
+		rd.replicas[0].interval = 0
+		mocks["replica-a"].ExpectPing().WillReturnError(errors.New("connection refused"))
+		expectReplicaStatus(mocks["replica-a"], 0)
+
+		assert.Same(t, primary, rd.GetReader())
+		assert.Same(t, rd.replicas[0].db, rd.GetReader())
+		assert.False(t, rd.fallingBack.Load())
+	})
+
+	t.Run("Routing is counted by target and reason", func(t *testing.T) {
+		reader := sdkmetric.NewManualReader()
+		reads, err := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test").Int64Counter("vm.db.reads")
+		require.NoError(t, err)
+		rd, _, mocks := newRoutingDatabase(t, config, "replica-a")
+		rd.reads = reads
+		mocks["replica-a"].ExpectPing().WillReturnError(errors.New("connection refused"))
+
+		rd.GetReader()
+		rd.GetReader()
+
+		var metrics metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(context.Background(), &metrics))
+		sum := metrics.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+		require.Len(t, sum.DataPoints, 1)
+		target, _ := sum.DataPoints[0].Attributes.Value("target")
+		reason, _ := sum.DataPoints[0].Attributes.Value("reason")
+		assert.Equal(t, "primary", target.AsString())
+		assert.Equal(t, "fallback", reason.AsString())
+		assert.Equal(t, int64(2), sum.DataPoints[0].Value)
+	})
+}
+
+func TestMySQLReplicaLag(t *testing.T) {
+	t.Run("Servers before 8.0.22 report Seconds_Behind_Master", func(t *testing.T) {
+		gormDB, mock := newMockGorm(t)
+		mock.ExpectQuery("SHOW REPLICA STATUS").WillReturnError(errors.New("You have an error in your SQL syntax"))
+		mock.ExpectQuery("SHOW SLAVE STATUS").
+			WillReturnRows(sqlmock.NewRows([]string{"Seconds_Behind_Master"}).AddRow(3))
+
+		lag, err := mysqlReplicaLag(context.Background(), gormDB)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3*time.Second, lag)
+	})
+
+	t.Run("A server that is not replicating has no lag", func(t *testing.T) {
+		gormDB, mock := newMockGorm(t)
+		mock.ExpectQuery("SHOW REPLICA STATUS").WillReturnRows(sqlmock.NewRows([]string{"Seconds_Behind_Source"}))
+
+		lag, err := mysqlReplicaLag(context.Background(), gormDB)
+
+		assert.NoError(t, err)
+		assert.Zero(t, lag)
+	})
+}
+
+func TestReplicaDatabase(t *testing.T) {
+	primary := configmanager.Database{Host: "db", Port: 3306, DBName: "vmdb"}
+
+	config, err := replicaDatabase(primary, "db-replica:3307")
+	assert.NoError(t, err)
+	assert.Equal(t, "db-replica", config.Host)
+	assert.Equal(t, 3307, config.Port)
+	assert.Equal(t, "vmdb", config.DBName)
+
+	config, err = replicaDatabase(primary, "db-replica")
+	assert.NoError(t, err)
+	assert.Equal(t, "db-replica", config.Host)
+	assert.Equal(t, 3306, config.Port)
+
+	_, err = replicaDatabase(primary, "db-replica:x")
+	assert.Error(t, err)
+}
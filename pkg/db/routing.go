@@ -0,0 +1,17 @@
+package db
+
+import "context"
+
+type primaryKey struct{}
+
+// WithPrimary returns a context whose reads must go to the primary, for work that reads rows
+// it has just written and cannot wait for the replicas to catch up.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// UsesPrimary reports whether ctx was returned by WithPrimary.
+func UsesPrimary(ctx context.Context) bool {
+	pinned, _ := ctx.Value(primaryKey{}).(bool)
+	return pinned
+}
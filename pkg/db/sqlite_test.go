@@ -0,0 +1,129 @@
+package db_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configmanager "vm/pkg/config-manager"
+	"vm/pkg/db"
+	mock_logger "vm/pkg/logger/mock"
+)
+
+func TestMigrations_SQLite(t *testing.T) {
+	database := db.NewDatabase(&mock_logger.StubLogger{})
+	_, err := database.InitDB(configmanager.ApplicationConfig{Database: configmanager.Database{
+		Driver: db.DriverSQLite,
+		DBName: filepath.Join(t.TempDir(), "vm.db"),
+	}})
+	require.NoError(t, err)
+	migrator := db.NewMigrateAllTables(database, &mock_logger.StubLogger{})
+	gormDB := database.GetReader()
+
+	require.NoError(t, migrator.MigrateAllTables())
+	for _, table := range []string{"vm_requests", "vm_deploy_instances", "idempotency_keys", "virtual_machines"} {
+		assert.True(t, gormDB.Migrator().HasTable(table), table)
+	}
+	for _, index := range []string{"idx_vm_requests_request_status", "idx_vm_requests_workspace_id", "idx_vm_requests_created_at", "idx_vm_requests_vm_id", "idx_vm_requests_claim"} {
+		assert.True(t, gormDB.Migrator().HasIndex("vm_requests", index), index)
+	}
+	statuses, err := migrator.Status()
+	require.NoError(t, err)
+	for _, s := range statuses {
+		assert.True(t, s.Applied, s.ID)
+	}
+
+	// Migrating again is a no-op.
+	require.NoError(t, migrator.MigrateAllTables())
+
+	// Down to the initial schema drops the indexes and keeps the tables.
+	require.NoError(t, migrator.RollbackTo("20261016_0001_initial_schema"))
+	assert.False(t, gormDB.Migrator().HasIndex("vm_requests", "idx_vm_requests_created_at"))
+	assert.True(t, gormDB.Migrator().HasTable("vm_requests"))
+	statuses, err = migrator.Status()
+	require.NoError(t, err)
+	assert.Equal(t, []db.MigrationStatus{
+		{ID: "20261016_0001_initial_schema", Applied: true},
+		{ID: "20261016_0002_normalize_request_status", Applied: false},
+		{ID: "20261016_0003_vm_request_indexes", Applied: false},
+		{ID: "20261016_0004_portable_column_types", Applied: false},
+		{ID: "20261016_0005_vm_request_vm_id", Applied: false},
+		{ID: "20261016_0006_vm_request_claim", Applied: false},
+	}, statuses)
+
+	// Rolling back the last migration drops the tables.
+	require.NoError(t, migrator.RollbackLast())
+	assert.False(t, gormDB.Migrator().HasTable("vm_requests"))
+
+	// Up to a version applies only the migrations up to it.
+	require.NoError(t, migrator.MigrateTo("20261016_0002_normalize_request_status"))
+	assert.True(t, gormDB.Migrator().HasTable("vm_requests"))
+	assert.False(t, gormDB.Migrator().HasIndex("vm_requests", "idx_vm_requests_request_status"))
+}
+
+func TestMigrations_SQLiteBackfillsVMID(t *testing.T) {
+	database := db.NewDatabase(&mock_logger.StubLogger{})
+	_, err := database.InitDB(configmanager.ApplicationConfig{Database: configmanager.Database{
+		Driver: db.DriverSQLite,
+		DBName: filepath.Join(t.TempDir(), "vm.db"),
+	}})
+	require.NoError(t, err)
+	migrator := db.NewMigrateAllTables(database, &mock_logger.StubLogger{})
+	gormDB := database.GetWriter()
+
+	require.NoError(t, migrator.MigrateTo("20261016_0004_portable_column_types"))
+	for _, row := range [][]string{
+		{"req-1", "vmPowerOff", `{"VMID":"vm-1"}`},
+		{"req-2", "vmReconfigure", `{"VMID":"vm-2","Body":{}}`},
+		{"req-3", "vmDeploy", `{"vm_config":{"name":"web"}}`},
+	} {
+		require.NoError(t, gormDB.Exec("INSERT INTO vm_requests (request_id, operation, request_status, request_metadata) VALUES (?, ?, 'Success', ?)",
+			row[0], row[1], row[2]).Error)
+	}
+
+	require.NoError(t, migrator.MigrateAllTables())
+
+	var vmIDs []string
+	require.NoError(t, gormDB.Table("vm_requests").Order("request_id").Pluck("vm_id", &vmIDs).Error)
+	assert.Equal(t, []string{"vm-1", "vm-2", ""}, vmIDs)
+	assert.True(t, gormDB.Migrator().HasIndex("vm_requests", "idx_vm_requests_vm_id"))
+
+	require.NoError(t, migrator.RollbackTo("20261016_0004_portable_column_types"))
+	assert.False(t, gormDB.Migrator().HasColumn("vm_requests", "vm_id"))
+}
+
+func TestMigrations_SQLiteClaimsRequestsInProgress(t *testing.T) {
+	database := db.NewDatabase(&mock_logger.StubLogger{})
+	_, err := database.InitDB(configmanager.ApplicationConfig{Database: configmanager.Database{
+		Driver: db.DriverSQLite,
+		DBName: filepath.Join(t.TempDir(), "vm.db"),
+	}})
+	require.NoError(t, err)
+	migrator := db.NewMigrateAllTables(database, &mock_logger.StubLogger{})
+	gormDB := database.GetWriter()
+
+	require.NoError(t, migrator.MigrateTo("20261016_0005_vm_request_vm_id"))
+	for _, row := range [][]string{{"req-1", "Inprogress"}, {"req-2", "Success"}} {
+		require.NoError(t, gormDB.Exec("INSERT INTO vm_requests (request_id, operation, request_status) VALUES (?, 'vmPowerOn', ?)",
+			row[0], row[1]).Error)
+	}
+
+	require.NoError(t, migrator.MigrateAllTables())
+
+	var claimed []string
+	require.NoError(t, gormDB.Table("vm_requests").Where("claimed_at IS NOT NULL").Pluck("request_id", &claimed).Error)
+	assert.Equal(t, []string{"req-1"}, claimed)
+
+	require.NoError(t, migrator.RollbackTo("20261016_0005_vm_request_vm_id"))
+	assert.False(t, gormDB.Migrator().HasColumn("vm_requests", "claimed_at"))
+	assert.False(t, gormDB.Migrator().HasColumn("vm_requests", "claimed_by"))
+}
+
+func TestInitDB_UnsupportedDriver(t *testing.T) {
+	database := db.NewDatabase(&mock_logger.StubLogger{})
+	_, err := database.InitDB(configmanager.ApplicationConfig{Database: configmanager.Database{Driver: "oracle"}})
+
+	assert.EqualError(t, err, `unsupported database driver "oracle"`)
+}
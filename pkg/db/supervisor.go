@@ -0,0 +1,154 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"vm/pkg/constants"
+)
+
+// ErrUnavailable is returned for statements run on the primary while the supervisor has it
+// marked down, instead of letting each one wait for a connection timeout.
+var ErrUnavailable = errors.New("database is unavailable")
+
+const (
+	defaultHealthCheckInterval = 5 * time.Second
+	defaultReconnectMaxBackoff = 30 * time.Second
+	defaultHealthCheckFailures = 3
+	reconnectInitialBackoff    = time.Second
+	probeTimeout               = 2 * time.Second
+)
+
+// Health is the state of the primary.
+type Health struct {
+	Healthy bool
+	// Since is when Healthy last changed.
+	Since time.Time
+	// LastError is why the last probe failed, and is empty once one succeeds.
+	LastError string
+	// Failures counts the probes that failed in a row. The primary is marked down once they
+	// reach the configured threshold.
+	Failures int
+}
+
+func (rd *DatabaseImpl) Health() Health {
+	rd.mu.RLock()
+	defer rd.mu.RUnlock()
+	return rd.health
+}
+
+func (rd *DatabaseImpl) Start(ctx context.Context) {
+	ctx, rd.cancel = context.WithCancel(ctx)
+	interval := durationOrDefault(rd.config.HealthCheckInterval, defaultHealthCheckInterval)
+	maxBackoff := durationOrDefault(rd.config.ReconnectMaxBackoff, defaultReconnectMaxBackoff)
+
+	rd.wg.Add(1)
+	go func() {
+		defer rd.wg.Done()
+		timer := time.NewTimer(interval)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+			if failures := rd.probe(ctx); failures > 0 {
+				timer.Reset(reconnectBackoff(failures, maxBackoff))
+			} else {
+				timer.Reset(interval)
+			}
+		}
+	}()
+}
+
+func (rd *DatabaseImpl) Stop() {
+	if rd.cancel != nil {
+		rd.cancel()
+	}
+	rd.wg.Wait()
+}
+
+// probe pings the primary, records the outcome and returns how many probes have failed in a
+// row. The primary is marked down only once the threshold of failures in a row is reached, so
+// that one probe timing out under load does not fail every write. database/sql discards
+// connections the server dropped, so once the primary is back the ping dials a fresh one and
+// the pool is usable again.
+func (rd *DatabaseImpl) probe(ctx context.Context) int {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	target := rd.prober
+	if target == nil {
+		target = rd.Db
+	}
+	sqlDB, err := target.DB()
+	if err == nil {
+		err = sqlDB.PingContext(ctx)
+	}
+
+	rd.mu.Lock()
+	defer rd.mu.Unlock()
+	now := time.Now().UTC()
+	if err == nil {
+		if !rd.health.Healthy {
+			rd.Logger.Info(constants.MySql, constants.Startup, "Database connection re-established", map[constants.ExtraKey]interface{}{
+				"attempts": rd.health.Failures,
+				"downtime": now.Sub(rd.health.Since).String(),
+			})
+			rd.health.Since = now
+		}
+		rd.health.Healthy = true
+		rd.health.LastError = ""
+		rd.health.Failures = 0
+		return 0
+	}
+
+	rd.health.LastError = err.Error()
+	rd.health.Failures++
+	threshold := rd.config.HealthCheckFailures
+	if threshold <= 0 {
+		threshold = defaultHealthCheckFailures
+	}
+	switch {
+	case rd.health.Healthy && rd.health.Failures >= threshold:
+		rd.Logger.Error(constants.MySql, constants.Startup, "Database is unreachable; failing queries until it is back", map[constants.ExtraKey]interface{}{
+			"failures": rd.health.Failures,
+			"error":    err.Error(),
+		})
+		rd.health.Healthy = false
+		rd.health.Since = now
+	case rd.health.Healthy:
+		rd.Logger.Warn(constants.MySql, constants.Startup, "Database probe failed", map[constants.ExtraKey]interface{}{
+			"failures":  rd.health.Failures,
+			"threshold": threshold,
+			"error":     err.Error(),
+		})
+	default:
+		rd.Logger.Warn(constants.MySql, constants.Startup, "Database reconnect failed", map[constants.ExtraKey]interface{}{
+			"attempt": rd.health.Failures,
+			"error":   err.Error(),
+		})
+	}
+	return rd.health.Failures
+}
+
+// reconnectBackoff doubles the wait after each failed attempt, up to max.
+func reconnectBackoff(failures int, max time.Duration) time.Duration {
+	backoff := reconnectInitialBackoff
+	for i := 1; i < failures && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		return max
+	}
+	return backoff
+}
+
+func durationOrDefault(seconds int, fallback time.Duration) time.Duration {
+	if seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return fallback
+}
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mock_logger "vm/pkg/logger/mock"
+)
+
+func TestProbe(t *testing.T) {
+	primary, mock := newMockGorm(t)
+	rd := NewDatabase(&mock_logger.StubLogger{}).(*DatabaseImpl)
+	rd.Db = primary
+	rd.config.HealthCheckFailures = 2
+
+	t.Run("A failure below the threshold keeps the primary up", func(t *testing.T) {
+		mock.ExpectPing().WillReturnError(errors.New("i/o timeout"))
+
+		assert.Equal(t, 1, rd.probe(context.Background()))
+
+		health := rd.Health()
+		assert.True(t, health.Healthy)
+		assert.Equal(t, "i/o timeout", health.LastError)
+		assert.Same(t, primary, rd.GetWriter())
+	})
+
+	t.Run("Failures up to the threshold mark the primary down and fail statements at once", func(t *testing.T) {
+		mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+
+		assert.Equal(t, 2, rd.probe(context.Background()))
+
+		health := rd.Health()
+		assert.False(t, health.Healthy)
+		assert.Equal(t, "connection refused", health.LastError)
+		var count int64
+		err := rd.GetWriter().Table("vm_requests").Count(&count).Error
+		assert.ErrorIs(t, err, ErrUnavailable)
+		// Reads fall back to the primary, so they fail the same way.
+		assert.ErrorIs(t, rd.GetReader().Table("vm_requests").Count(&count).Error, ErrUnavailable)
+	})
+
+	t.Run("Failures are counted until a probe succeeds", func(t *testing.T) {
+		mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+		mock.ExpectPing()
+
+		assert.Equal(t, 3, rd.probe(context.Background()))
+		assert.Equal(t, 0, rd.probe(context.Background()))
+
+		health := rd.Health()
+		assert.True(t, health.Healthy)
+		assert.Empty(t, health.LastError)
+		assert.Zero(t, health.Failures)
+		assert.Same(t, primary, rd.GetWriter())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Probes use their own connection", func(t *testing.T) {
+		prober, proberMock := newMockGorm(t)
+		rd.prober = prober
+		proberMock.ExpectPing()
+
+		assert.Equal(t, 0, rd.probe(context.Background()))
+
+		assert.NoError(t, proberMock.ExpectationsWereMet())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReconnectBackoff(t *testing.T) {
+	var waits []time.Duration
+	for failures := 1; failures <= 7; failures++ {
+		waits = append(waits, reconnectBackoff(failures, 30*time.Second))
+	}
+
+	assert.Equal(t, []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second,
+	}, waits)
+}
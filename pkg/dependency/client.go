@@ -17,19 +17,39 @@ type ClientDependency struct {
 	VmMonitorClient    *vmmonitor.Client
 }
 
-// SetupClientDependencies initializes all the clients.
-func SetupClientDependencies(config *configmanager.Config, logger cinterface.Logger) (*ClientDependency, error) {
+// SetupClientDependencies initializes all the clients. current returns the configuration as
+// it is now, from which the clients read rotated secrets.
+func SetupClientDependencies(config *configmanager.Config, current func() *configmanager.ApplicationConfig, logger cinterface.Logger) (*ClientDependency, error) {
+
+	// Initialize the token providers for the API clients.
+	imageManagerTokens, err := client.NewTokenProvider("image-manager", func() configmanager.Client { return current().Clients.ImageManager })
+	if err != nil {
+		logger.Error("dependency", "setup", "Failed to create image-manager token provider", map[constants.ExtraKey]interface{}{constants.ErrorMessage: err})
+		return nil, err
+	}
+	infraMonitorTokens, err := client.NewTokenProvider("infra-monitor", func() configmanager.Client { return current().Clients.InfraMonitor })
+	if err != nil {
+		logger.Error("dependency", "setup", "Failed to create infra-monitor token provider", map[constants.ExtraKey]interface{}{constants.ErrorMessage: err})
+		return nil, err
+	}
+	vmMonitorTokens, err := client.NewTokenProvider("vm-monitor", func() configmanager.Client { return current().Clients.VmMonitor })
+	if err != nil {
+		logger.Error("dependency", "setup", "Failed to create vm-monitor token provider", map[constants.ExtraKey]interface{}{constants.ErrorMessage: err})
+		return nil, err
+	}
 
 	// Initialize the security sources for the API clients.
-	imageManagerSecuritySource := &client.ImageManagerSecuritySource{}
-	infraMonitorSecuritySource := &client.InfraMonitorSecuritySource{}
-	vmMonitorSecuritySource := &client.VmMonitorSecuritySource{}
+	imageManagerSecuritySource := &client.ImageManagerSecuritySource{Tokens: imageManagerTokens}
+	infraMonitorSecuritySource := &client.InfraMonitorSecuritySource{Tokens: infraMonitorTokens}
+	vmMonitorSecuritySource := &client.VmMonitorSecuritySource{Tokens: vmMonitorTokens}
 
 	// Url from config
 	url := config.App.Application
 
 	// Initialize the image-manager client.
-	imageManagerClient, err := imagemanager.NewClient(url.ImageManagerServiceName, imageManagerSecuritySource)
+	imageManagerClient, err := imagemanager.NewClient(client.ServiceURL(url.ImageManagerServiceName), imageManagerSecuritySource,
+		imagemanager.WithClient(client.InvalidateOnUnauthorized(
+			client.NewHTTPClient("image-manager", func() configmanager.Client { return current().Clients.ImageManager }, logger), imageManagerTokens)))
 	if err != nil {
 		logger.Error("dependency", "setup", "Failed to create image-manager client", map[constants.ExtraKey]interface{}{constants.ErrorMessage: err})
 		return nil, err
@@ -37,7 +57,9 @@ func SetupClientDependencies(config *configmanager.Config, logger cinterface.Log
 	logger.Info("dependency", "setup", "Image-manager client initialized", nil)
 
 	// Initialize the infra-monitor client.
-	infraMonitorClient, err := inframonitor.NewClient(url.InfraMonitorServiceName, infraMonitorSecuritySource)
+	infraMonitorClient, err := inframonitor.NewClient(client.ServiceURL(url.InfraMonitorServiceName), infraMonitorSecuritySource,
+		inframonitor.WithClient(client.InvalidateOnUnauthorized(
+			client.NewHTTPClient("infra-monitor", func() configmanager.Client { return current().Clients.InfraMonitor }, logger), infraMonitorTokens)))
 	if err != nil {
 		logger.Error("dependency", "setup", "Failed to create infra-monitor client", map[constants.ExtraKey]interface{}{constants.ErrorMessage: err})
 		return nil, err
@@ -45,7 +67,9 @@ func SetupClientDependencies(config *configmanager.Config, logger cinterface.Log
 	logger.Info("dependency", "setup", "Infra-monitor client initialized", nil)
 
 	// Initialize the vm-monitor client.
-	vmMonitorClient, err := vmmonitor.NewClient(url.VmMonitorServiceName, vmMonitorSecuritySource)
+	vmMonitorClient, err := vmmonitor.NewClient(client.ServiceURL(url.VmMonitorServiceName), vmMonitorSecuritySource,
+		vmmonitor.WithClient(client.InvalidateOnUnauthorized(
+			client.NewHTTPClient("vm-monitor", func() configmanager.Client { return current().Clients.VmMonitor }, logger), vmMonitorTokens)))
 	if err != nil {
 		logger.Error("dependency", "setup", "Failed to create vm-monitor client", map[constants.ExtraKey]interface{}{constants.ErrorMessage: err})
 		return nil, err
@@ -3,7 +3,7 @@ package dependency
 import (
 	"context"
 	"os"
-	"strconv"
+	"vm/internal/config"
 	"vm/pkg/cinterface"
 	configmanager "vm/pkg/config-manager"
 	"vm/pkg/constants"
@@ -17,91 +17,76 @@ type Dependency struct {
 	Logger   cinterface.Logger
 	Database db.Database
 	Config   *configmanager.Config
+	// Holder holds the current configuration, which may have been reloaded since startup.
+	Holder *config.Holder
 }
 
-// getEnv returns the environment variable or default value if not set
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// AppConfig returns the current configuration: the reloaded one when there is a Holder, the
+// one loaded at startup otherwise.
+func (d *Dependency) AppConfig() *configmanager.ApplicationConfig {
+	if d.Holder != nil {
+		return d.Holder.Get()
 	}
-	return defaultValue
+	return &d.Config.App
 }
 
-// getEnvInt reads an env variable and converts it to int
-func getEnvInt(key string, defaultValue int) int {
-	if value := os.Getenv(key); value != "" {
-		if intVal, err := strconv.Atoi(value); err == nil {
-			return intVal
-		}
+// connectDatabase opens the database described by cfg.
+func connectDatabase(cfg *configmanager.Config, log cinterface.Logger, opts ...db.Option) (db.Database, error) {
+	database := db.NewDatabase(log, opts...)
+	if _, err := database.InitDB(cfg.App); err != nil {
+		log.Error(constants.MySql, constants.Startup, "Failed to connect to database", map[constants.ExtraKey]interface{}{"error": err})
+		return nil, err
 	}
-	return defaultValue
+	log.Info(constants.MySql, constants.Startup, "Database connection established", nil)
+	return database, nil
 }
 
-func Setup(ctx context.Context) (*Dependency, error) {
-	// Load DB config from environment
-	dbHost := getEnv("DB_HOST", "localhost")
-	dbPort := getEnvInt("DB_PORT", 3306)
-	dbName := getEnv("DB_NAME", "vmdb")
-	dbUser := getEnv("DB_USER", "root")
-	dbPass := getEnv("DB_PASS", "test@12")
-	maxIdle := getEnvInt("DB_MAX_IDLE", 10)
-	maxOpen := getEnvInt("DB_MAX_OPEN", 100)
-	connLife := getEnvInt("DB_CONN_LIFETIME", 60)
+// SetupMigrator connects to the database for the migrate command, without the clients and
+// the automatic migration the server needs.
+func SetupMigrator(ctx context.Context) (db.Migrate, cinterface.Logger, error) {
+	app, err := config.Load(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg := &configmanager.Config{App: *app}
+	log := logger.NewLogger(cfg)
 
-	// Load App config from environment
-	appPort := getEnv("APP_PORT", "8080")
-	imageManagerServiceName := getEnv("IMAGE_MANAGER_SERVICE_NAME", "image-manager:8081")
-	infraMonitorServiceName := getEnv("INFRA_MONITOR_SERVICE_NAME", "infra-monitor:8082")
-	vmMonitorServiceName := getEnv("VM_MONITOR_SERVICE_NAME", "vm-monitor:8083")
-	validateClientRequest := getEnv("VALIDATE_CLIENT_REQUEST", "false")
+	database, err := connectDatabase(cfg, log)
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewMigrateAllTables(database, log), log, nil
+}
 
-	// Build configuration
-	cfg := &configmanager.Config{
-		App: configmanager.ApplicationConfig{
-			Application: configmanager.Application{
-				Name:                    "vm",
-				Profile:                 "dev",
-				Port:                    appPort,
-				ImageManagerServiceName: imageManagerServiceName,
-				InfraMonitorServiceName: infraMonitorServiceName,
-				VmMonitorServiceName:    vmMonitorServiceName,
-				ValidateClientRequest:   validateClientRequest == "true",
-			},
-			Database: configmanager.Database{
-				Host:                  dbHost,
-				Port:                  dbPort,
-				DBName:                dbName,
-				Username:              dbUser,
-				Password:              dbPass,
-				MaxIdleConnection:     maxIdle,
-				MaxOpenConnection:     maxOpen,
-				MaxConnectionLifeTime: connLife,
-			},
-			Log: configmanager.Log{
-				Level:         "debug",
-				EnableConsole: true,
-			},
-		},
+// Setup loads the configuration, with args as the command-line flags, and builds the
+// dependencies of the server from it.
+func Setup(ctx context.Context, args []string) (*Dependency, error) {
+	holder, err := config.NewHolder(args)
+	if err != nil {
+		return nil, err
 	}
+	cfg := &configmanager.Config{App: *holder.Get()}
 
 	// Initialize logger
 	log := logger.NewLogger(cfg)
 	log.Info(constants.General, constants.Startup, "Logger initialized", nil)
+	log.Info(constants.General, constants.Startup, "Configuration loaded", map[constants.ExtraKey]interface{}{
+		"config": cfg.App,
+	})
 
 	// Initialize client dependencies
-	clientDeps, err := SetupClientDependencies(cfg, log)
+	clientDeps, err := SetupClientDependencies(cfg, holder.Get, log)
 	if err != nil {
 		return nil, err
 	}
 
-	// Initialize database
-	database := db.NewDatabase(log)
-	_, err = database.InitDB(cfg.App)
+	// Initialize database, picking up a rotated password for new connections
+	database, err := connectDatabase(cfg, log, db.WithPassword(func() configmanager.Secret {
+		return holder.Get().Database.Password
+	}))
 	if err != nil {
-		log.Error(constants.MySql, constants.Startup, "Failed to connect to database", map[constants.ExtraKey]interface{}{"error": err})
 		return nil, err
 	}
-	log.Info(constants.MySql, constants.Startup, "Database connection established", nil)
 
 	// Run migrations
 	migrator := db.NewMigrateAllTables(database, log)
@@ -117,5 +102,6 @@ func Setup(ctx context.Context) (*Dependency, error) {
 		Logger:           log,
 		Database:         database,
 		Config:           cfg,
+		Holder:           holder,
 	}, nil
 }
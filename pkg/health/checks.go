@@ -0,0 +1,102 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"vm/pkg/db"
+)
+
+// DatabaseCheck pings the primary within the check's timeout. While the supervisor has the
+// primary marked down, and writes fail with db.ErrUnavailable, it fails without pinging.
+func DatabaseCheck(database db.Database, criticality Criticality) Check {
+	return Check{
+		Name:        "database",
+		Criticality: criticality,
+		Run: func(ctx context.Context) error {
+			health := database.Health()
+			if !health.Healthy {
+				if health.LastError == "" {
+					return db.ErrUnavailable
+				}
+				return fmt.Errorf("%w since %s: %s", db.ErrUnavailable, health.Since.Format(time.RFC3339), health.LastError)
+			}
+			return database.Ping(database.GetWriter().WithContext(ctx))
+		},
+	}
+}
+
+// MigrationsCheck passes once every migration this build knows of is applied. Migrations
+// only change with a new build, so it stops querying once it has passed.
+func MigrationsCheck(migrator db.Migrate, criticality Criticality) Check {
+	var applied atomic.Bool
+	return Check{
+		Name:        "migrations",
+		Criticality: criticality,
+		Run: func(ctx context.Context) error {
+			if applied.Load() {
+				return nil
+			}
+			statuses, err := migrator.Status()
+			if err != nil {
+				return err
+			}
+			var pending []string
+			for _, status := range statuses {
+				if !status.Applied {
+					pending = append(pending, status.ID)
+				}
+			}
+			if len(pending) > 0 {
+				return fmt.Errorf("pending migrations: %s", strings.Join(pending, ", "))
+			}
+			applied.Store(true)
+			return nil
+		},
+	}
+}
+
+// ServiceCheck passes when a TCP connection to the service at address, a URL or host:port,
+// can be opened.
+func ServiceCheck(name, address string, criticality Criticality) Check {
+	return Check{
+		Name:        name,
+		Criticality: criticality,
+		Run: func(ctx context.Context) error {
+			hostPort, err := serviceHostPort(address)
+			if err != nil {
+				return err
+			}
+			var dialer net.Dialer
+			conn, err := dialer.DialContext(ctx, "tcp", hostPort)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		},
+	}
+}
+
+// serviceHostPort returns the host:port to dial for address, which is configured either as
+// a URL or as a bare host:port.
+func serviceHostPort(address string) (string, error) {
+	if u, err := url.Parse(address); err == nil && u.Host != "" {
+		if u.Port() != "" {
+			return u.Host, nil
+		}
+		port := "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+		return net.JoinHostPort(u.Hostname(), port), nil
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		return "", fmt.Errorf("invalid service address %q: %w", address, err)
+	}
+	return address, nil
+}
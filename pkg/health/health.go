@@ -0,0 +1,203 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"vm/pkg/cinterface"
+	"vm/pkg/constants"
+)
+
+// Criticality is how much a failing check matters to readiness.
+type Criticality string
+
+const (
+	// Critical checks make the service not ready when they fail.
+	Critical Criticality = "critical"
+	// Optional checks are reported but never make the service not ready.
+	Optional Criticality = "optional"
+	// Off checks are not run.
+	Off Criticality = "off"
+)
+
+const defaultTimeout = 2 * time.Second
+
+// ParseCriticality parses a configured criticality; empty selects fallback.
+func ParseCriticality(value string, fallback Criticality) (Criticality, error) {
+	switch Criticality(value) {
+	case "":
+		return fallback, nil
+	case Critical, Optional, Off:
+		return Criticality(value), nil
+	default:
+		return "", fmt.Errorf("unknown health check criticality %q, want %s, %s or %s", value, Critical, Optional, Off)
+	}
+}
+
+// Check is one dependency readiness looks at.
+type Check struct {
+	Name        string
+	Criticality Criticality
+	// Run returns nil when the dependency is usable. It should give up when ctx is done.
+	Run func(ctx context.Context) error
+}
+
+// Status values reported for the service and for each check.
+const (
+	StatusOK          = "ok"
+	StatusDegraded    = "degraded"
+	StatusUnavailable = "unavailable"
+	StatusFailed      = "failed"
+)
+
+// Report is the JSON body of /healthz and /readyz.
+type Report struct {
+	Status string        `json:"status"`
+	Checks []CheckResult `json:"checks,omitempty"`
+}
+
+// CheckResult is the outcome of one check.
+type CheckResult struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Critical   bool   `json:"critical"`
+	DurationMs int64  `json:"durationMs"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Checker serves the liveness and readiness endpoints.
+type Checker struct {
+	checks  []Check
+	timeout time.Duration
+	logger  cinterface.Logger
+	ready   atomic.Bool
+}
+
+// NewChecker returns a Checker that runs checks, skipping the ones that are Off, each with
+// timeout.
+func NewChecker(checks []Check, timeout time.Duration, logger cinterface.Logger) *Checker {
+	c := &Checker{timeout: timeout, logger: logger}
+	if c.timeout <= 0 {
+		c.timeout = defaultTimeout
+	}
+	for _, check := range checks {
+		if check.Criticality != Off {
+			c.checks = append(c.checks, check)
+		}
+	}
+	c.ready.Store(true)
+	return c
+}
+
+// Liveness answers /healthz. It depends on nothing but the process serving HTTP, so a
+// database outage does not get the pod restarted.
+func (c *Checker) Liveness() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.write(w, http.StatusOK, Report{Status: StatusOK})
+	})
+}
+
+// Readiness answers /readyz with 200 while every critical check passes and 503 otherwise.
+func (c *Checker) Readiness() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := c.Run(r.Context())
+		code := http.StatusOK
+		if report.Status == StatusUnavailable {
+			code = http.StatusServiceUnavailable
+		}
+		c.write(w, code, report)
+	})
+}
+
+// Run runs the checks concurrently and reports the outcome.
+func (c *Checker) Run(ctx context.Context) Report {
+	results := make([]CheckResult, len(c.checks))
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			results[i] = c.run(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Checks: results}
+	for _, result := range results {
+		switch {
+		case result.Status == StatusOK:
+		case result.Critical:
+			report.Status = StatusUnavailable
+		case report.Status == StatusOK:
+			report.Status = StatusDegraded
+		}
+	}
+
+	ready := report.Status != StatusUnavailable
+	if c.ready.Swap(ready) != ready {
+		if ready {
+			c.logger.Info(constants.Internal, constants.Health, "Service is ready", nil)
+		} else {
+			c.logger.Warn(constants.Internal, constants.Health, "Service is not ready", map[constants.ExtraKey]interface{}{
+				"checks": failed(results),
+			})
+		}
+	}
+	return report
+}
+
+// run runs check, giving up when the timeout passes even if check ignores its context.
+func (c *Checker) run(ctx context.Context, check Check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() { done <- check.Run(ctx) }()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = fmt.Errorf("timed out after %s", c.timeout)
+	}
+
+	result := CheckResult{
+		Name:       check.Name,
+		Status:     StatusOK,
+		Critical:   check.Criticality == Critical,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Status = StatusFailed
+		result.Error = err.Error()
+	}
+	return result
+}
+
+func (c *Checker) write(w http.ResponseWriter, code int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		c.logger.Error(constants.Internal, constants.Health, "Failed to write health report", map[constants.ExtraKey]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
+
+// failed lists the names of the checks that did not pass.
+func failed(results []CheckResult) []string {
+	var names []string
+	for _, result := range results {
+		if result.Status != StatusOK {
+			names = append(names, result.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,198 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"vm/pkg/db"
+	mock_db "vm/pkg/db/mock"
+	"vm/pkg/health"
+	mock_logger "vm/pkg/logger/mock"
+)
+
+func passing(name string, criticality health.Criticality) health.Check {
+	return health.Check{Name: name, Criticality: criticality, Run: func(context.Context) error { return nil }}
+}
+
+func failing(name string, criticality health.Criticality) health.Check {
+	return health.Check{Name: name, Criticality: criticality, Run: func(context.Context) error { return errors.New("connection refused") }}
+}
+
+func readyz(t *testing.T, checks ...health.Check) (int, health.Report) {
+	checker := health.NewChecker(checks, time.Second, &mock_logger.StubLogger{})
+	rec := httptest.NewRecorder()
+	checker.Readiness().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var report health.Report
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&report))
+	return rec.Code, report
+}
+
+func TestReadiness(t *testing.T) {
+	t.Run("All checks pass", func(t *testing.T) {
+		code, report := readyz(t, passing("database", health.Critical), passing("vm-monitor", health.Optional))
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, health.StatusOK, report.Status)
+		require.Len(t, report.Checks, 2)
+		assert.Equal(t, "database", report.Checks[0].Name)
+		assert.Equal(t, health.StatusOK, report.Checks[0].Status)
+		assert.True(t, report.Checks[0].Critical)
+		assert.Empty(t, report.Checks[0].Error)
+		assert.Equal(t, "vm-monitor", report.Checks[1].Name)
+		assert.False(t, report.Checks[1].Critical)
+	})
+
+	t.Run("Failing critical check makes the service unavailable", func(t *testing.T) {
+		code, report := readyz(t, failing("database", health.Critical), passing("migrations", health.Critical))
+
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, health.StatusUnavailable, report.Status)
+		assert.Equal(t, health.StatusFailed, report.Checks[0].Status)
+		assert.Equal(t, "connection refused", report.Checks[0].Error)
+		assert.Equal(t, health.StatusOK, report.Checks[1].Status)
+	})
+
+	t.Run("Failing optional check only degrades the service", func(t *testing.T) {
+		code, report := readyz(t, passing("database", health.Critical), failing("vm-monitor", health.Optional))
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, health.StatusDegraded, report.Status)
+		assert.Equal(t, health.StatusFailed, report.Checks[1].Status)
+	})
+
+	t.Run("Checks that are off are not run", func(t *testing.T) {
+		code, report := readyz(t, passing("database", health.Critical), failing("vm-monitor", health.Off))
+
+		assert.Equal(t, http.StatusOK, code)
+		require.Len(t, report.Checks, 1)
+		assert.Equal(t, "database", report.Checks[0].Name)
+	})
+
+	t.Run("Check that hangs fails at the timeout", func(t *testing.T) {
+		hang := make(chan struct{})
+		defer close(hang)
+		checker := health.NewChecker([]health.Check{{
+			Name:        "database",
+			Criticality: health.Critical,
+			Run:         func(context.Context) error { <-hang; return nil },
+		}}, 20*time.Millisecond, &mock_logger.StubLogger{})
+
+		report := checker.Run(context.Background())
+
+		assert.Equal(t, health.StatusUnavailable, report.Status)
+		assert.Equal(t, "timed out after 20ms", report.Checks[0].Error)
+	})
+}
+
+func TestLiveness(t *testing.T) {
+	checker := health.NewChecker([]health.Check{failing("database", health.Critical)}, time.Second, &mock_logger.StubLogger{})
+	rec := httptest.NewRecorder()
+
+	checker.Liveness().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+}
+
+func TestParseCriticality(t *testing.T) {
+	criticality, err := health.ParseCriticality("", health.Off)
+	assert.NoError(t, err)
+	assert.Equal(t, health.Off, criticality)
+
+	criticality, err = health.ParseCriticality("optional", health.Critical)
+	assert.NoError(t, err)
+	assert.Equal(t, health.Optional, criticality)
+
+	_, err = health.ParseCriticality("sometimes", health.Critical)
+	assert.EqualError(t, err, `unknown health check criticality "sometimes", want critical, optional or off`)
+}
+
+func TestDatabaseCheck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mock_db.NewMockDatabase(ctrl)
+	check := health.DatabaseCheck(mockDB, health.Critical)
+
+	t.Run("Pings the primary within the check's context", func(t *testing.T) {
+		sqlDB, _, err := sqlmock.New()
+		require.NoError(t, err)
+		defer sqlDB.Close()
+		gormDB, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{})
+		require.NoError(t, err)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		mockDB.EXPECT().Health().Return(db.Health{Healthy: true})
+		mockDB.EXPECT().GetWriter().Return(gormDB)
+		mockDB.EXPECT().Ping(gomock.Any()).DoAndReturn(func(writer *gorm.DB) error {
+			assert.Equal(t, ctx, writer.Statement.Context)
+			return errors.New("connection refused")
+		})
+
+		assert.EqualError(t, check.Run(ctx), "connection refused")
+	})
+
+	t.Run("Fails without pinging while writes fail", func(t *testing.T) {
+		since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockDB.EXPECT().Health().Return(db.Health{Since: since, LastError: "connection refused", Failures: 3})
+
+		err := check.Run(context.Background())
+
+		assert.ErrorIs(t, err, db.ErrUnavailable)
+		assert.EqualError(t, err, "database is unavailable since 2026-01-01T00:00:00Z: connection refused")
+	})
+}
+
+type fakeMigrator struct {
+	db.Migrate
+	statuses []db.MigrationStatus
+	calls    int
+}
+
+func (f *fakeMigrator) Status() ([]db.MigrationStatus, error) {
+	f.calls++
+	return f.statuses, nil
+}
+
+func TestMigrationsCheck(t *testing.T) {
+	migrator := &fakeMigrator{statuses: []db.MigrationStatus{{ID: "0001", Applied: true}, {ID: "0002"}}}
+	check := health.MigrationsCheck(migrator, health.Critical)
+
+	assert.EqualError(t, check.Run(context.Background()), "pending migrations: 0002")
+
+	migrator.statuses[1].Applied = true
+	assert.NoError(t, check.Run(context.Background()))
+	assert.NoError(t, check.Run(context.Background()))
+	assert.Equal(t, 2, migrator.calls)
+}
+
+func TestServiceCheck(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	assert.NoError(t, health.ServiceCheck("vm-monitor", server.URL, health.Optional).Run(context.Background()))
+	assert.NoError(t, health.ServiceCheck("vm-monitor", server.Listener.Addr().String(), health.Optional).Run(context.Background()))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closed := listener.Addr().String()
+	listener.Close()
+	assert.Error(t, health.ServiceCheck("vm-monitor", closed, health.Optional).Run(context.Background()))
+
+	assert.Error(t, health.ServiceCheck("vm-monitor", "vm-monitor", health.Optional).Run(context.Background()))
+}
@@ -52,6 +52,22 @@ func (l *zeroLogger) getLogLevel() zerolog.Level {
 	return level
 }
 
+// ValidLevel reports whether level names a log level.
+func ValidLevel(level string) bool {
+	_, exists := zeroLogLevelMapping[level]
+	return exists
+}
+
+// SetLevel changes the level of every logger while the process runs.
+func SetLevel(level string) error {
+	zeroLevel, exists := zeroLogLevelMapping[level]
+	if !exists {
+		return fmt.Errorf("unknown log level %q", level)
+	}
+	zerolog.SetGlobalLevel(zeroLevel)
+	return nil
+}
+
 func (l *zeroLogger) Init() {
 
 	once.Do(func() {